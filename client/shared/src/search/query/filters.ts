@@ -10,6 +10,7 @@ import type { Filter, Literal } from './token'
 export enum FilterType {
     after = 'after',
     archived = 'archived',
+    archives = 'archives',
     author = 'author',
     before = 'before',
     case = 'case',
@@ -17,10 +18,12 @@ export enum FilterType {
     content = 'content',
     context = 'context',
     count = 'count',
+    dedupe = 'dedupe',
     file = 'file',
     fork = 'fork',
     lang = 'lang',
     message = 'message',
+    package = 'package',
     patterntype = 'patterntype',
     repo = 'repo',
     repohascommitafter = 'repohascommitafter',
@@ -28,6 +31,7 @@ export enum FilterType {
 
     rev = 'rev',
     select = 'select',
+    submodules = 'submodules',
     timeout = 'timeout',
     type = 'type',
     visibility = 'visibility',
@@ -191,6 +195,12 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         discreteValues: () => ['yes', 'only', 'no'].map(value => ({ label: value })),
         singular: true,
     },
+    [FilterType.archives]: {
+        description: 'Also search the files inside of zip, jar and tar archives in repositories.',
+        discreteValues: () => ['yes', 'no'].map(value => ({ label: value })),
+        default: 'no',
+        singular: true,
+    },
     [FilterType.author]: {
         negatable: true,
         description: negated => `${negated ? 'Exclude' : 'Include only'} commits or diffs authored by a user.`,
@@ -230,6 +240,16 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         placeholder: 'number',
         singular: true,
     },
+    [FilterType.dedupe]: {
+        discreteValues: () => [
+            {
+                label: 'content',
+                description: 'Show files with identical content, e.g. in forks and mirrors, as a single result',
+            },
+        ],
+        description: 'Group results with identical content.',
+        singular: true,
+    },
     [FilterType.file]: {
         alias: 'f',
         negatable: true,
@@ -270,6 +290,11 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
             `${negated ? 'Exclude' : 'Include only'} Commits with messages matching a certain string`,
         placeholder: '"content"',
     },
+    [FilterType.package]: {
+        description: 'Include only symbols defined in a package matching the given regular expression. Requires precise code intelligence data.',
+        placeholder: 'regex',
+        singular: true,
+    },
     [FilterType.patterntype]: {
         discreteValues: () => ['regexp', 'structural', 'literal', 'standard'].map(value => ({ label: value })),
         description: 'The pattern type (standard, regexp, literal, structural) in use',
@@ -307,6 +332,12 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         description: 'Select repo, file, symbol, content, or commit result types.',
         singular: true,
     },
+    [FilterType.submodules]: {
+        description: 'Also search the commits that git submodules of the repositories pin.',
+        discreteValues: () => ['yes', 'no'].map(value => ({ label: value })),
+        default: 'no',
+        singular: true,
+    },
     [FilterType.timeout]: {
         description: 'Duration before timeout, e.g. 30s, 1m, 2h, 3d, 4w, 5y.',
         placeholder: 'duration-value',
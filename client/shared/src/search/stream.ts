@@ -48,6 +48,12 @@ export interface PathMatch {
     repoLastFetched?: string
     branches?: string[]
     commit?: string
+    /**
+     * The location of an earlier match with identical content. Only set for
+     * queries with dedupe:content, in which case this match is a duplicate
+     * of it rather than a separate result.
+     */
+    duplicateOf?: FileLocation
     debug?: string
 }
 
@@ -63,9 +69,22 @@ export interface ContentMatch {
     lineMatches?: LineMatch[]
     chunkMatches?: ChunkMatch[]
     hunks?: DecoratedHunk[]
+    /** A unified diff of the file, for structural searches with `rewrite:`. */
+    diff?: string
     debug?: string
 }
 
+/**
+ * The location of a file with identical content to a match. Only set for
+ * queries with dedupe:content.
+ */
+export interface FileLocation {
+    path: string
+    repository: string
+    branches?: string[]
+    commit?: string
+}
+
 export interface DecoratedHunk {
     content: DecoratedContent
     lineStart: number
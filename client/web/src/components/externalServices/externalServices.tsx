@@ -6,13 +6,17 @@ import BitbucketIcon from 'mdi-react/BitbucketIcon'
 import GithubIcon from 'mdi-react/GithubIcon'
 import GitIcon from 'mdi-react/GitIcon'
 import GitLabIcon from 'mdi-react/GitlabIcon'
+import LanguageCsharpIcon from 'mdi-react/LanguageCsharpIcon'
 import LanguageGoIcon from 'mdi-react/LanguageGoIcon'
 import LanguageJavaIcon from 'mdi-react/LanguageJavaIcon'
+import LanguagePhpIcon from 'mdi-react/LanguagePhpIcon'
 import LanguagePythonIcon from 'mdi-react/LanguagePythonIcon'
 import LanguageRubyIcon from 'mdi-react/LanguageRubyIcon'
 import LanguageRustIcon from 'mdi-react/LanguageRustIcon'
 import AzureDevOpsIcon from 'mdi-react/MicrosoftAzureDevopsIcon'
 import NpmIcon from 'mdi-react/NpmIcon'
+import PackageVariantClosedIcon from 'mdi-react/PackageVariantClosedIcon'
+import SourceRepositoryIcon from 'mdi-react/SourceRepositoryIcon'
 
 import { PerforceIcon, PhabricatorIcon } from '@sourcegraph/shared/src/components/icons'
 import { Link, Code, Text } from '@sourcegraph/wildcard'
@@ -21,6 +25,9 @@ import awsCodeCommitSchemaJSON from '../../../../../schema/aws_codecommit.schema
 import azureDevOpsSchemaJSON from '../../../../../schema/azuredevops.schema.json'
 import bitbucketCloudSchemaJSON from '../../../../../schema/bitbucket_cloud.schema.json'
 import bitbucketServerSchemaJSON from '../../../../../schema/bitbucket_server.schema.json'
+import dartPackagesSchemaJSON from '../../../../../schema/dart-packages.schema.json'
+import dotnetPackagesSchemaJSON from '../../../../../schema/dotnet-packages.schema.json'
+import elixirPackagesSchemaJSON from '../../../../../schema/elixir-packages.schema.json'
 import gerritSchemaJSON from '../../../../../schema/gerrit.schema.json'
 import githubSchemaJSON from '../../../../../schema/github.schema.json'
 import gitlabSchemaJSON from '../../../../../schema/gitlab.schema.json'
@@ -28,14 +35,17 @@ import gitoliteSchemaJSON from '../../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../../schema/go-modules.schema.json'
 import jvmPackagesSchemaJSON from '../../../../../schema/jvm-packages.schema.json'
 import localGitSchemaJSON from '../../../../../schema/localgit.schema.json'
+import mercurialSchemaJSON from '../../../../../schema/mercurial.schema.json'
 import npmPackagesSchemaJSON from '../../../../../schema/npm-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../../schema/pagure.schema.json'
 import perforceSchemaJSON from '../../../../../schema/perforce.schema.json'
 import phabricatorSchemaJSON from '../../../../../schema/phabricator.schema.json'
+import phpPackagesSchemaJSON from '../../../../../schema/php-packages.schema.json'
 import pythonPackagesJSON from '../../../../../schema/python-packages.schema.json'
 import rubyPackagesSchemaJSON from '../../../../../schema/ruby-packages.schema.json'
 import rustPackagesJSON from '../../../../../schema/rust-packages.schema.json'
+import subversionSchemaJSON from '../../../../../schema/subversion.schema.json'
 import {
     type ExternalRepositoryFields,
     ExternalServiceKind,
@@ -1357,6 +1367,83 @@ const PAGURE: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const MERCURIAL: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.MERCURIAL,
+    title: 'Mercurial',
+    icon: SourceRepositoryIcon,
+    jsonSchema: mercurialSchemaJSON,
+    defaultDisplayName: 'Mercurial',
+    defaultConfig: `{
+  "url": "https://hg.example.com",
+  "username": "<fill in>",
+  "password": "<fill in>",
+  "repos": []
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to the URL of the Mercurial server.
+                </li>
+                <li>
+                    Provide <Field>username</Field> and <Field>password</Field> of a user account that has access to all
+                    the repositories you want to sync.
+                </li>
+                <li>
+                    Set <Field>repos</Field> to the paths of the repositories to sync, relative to <Field>url</Field>.
+                </li>
+            </ol>
+            <Text>
+                Mercurial repositories are converted to Git repositories when they are synced. See{' '}
+                <Link rel="noopener noreferrer" target="_blank" to="/help/admin/repo/mercurial">
+                    the docs
+                </Link>{' '}
+                for more information.
+            </Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const SUBVERSION: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.SUBVERSION,
+    title: 'Subversion',
+    icon: SourceRepositoryIcon,
+    jsonSchema: subversionSchemaJSON,
+    defaultDisplayName: 'Subversion',
+    defaultConfig: `{
+  "url": "https://svn.example.com/repos",
+  "username": "<fill in>",
+  "password": "<fill in>",
+  "layout": "standard",
+  "repos": []
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    In the configuration below, set <Field>url</Field> to the URL of the Subversion server.
+                </li>
+                <li>
+                    Provide <Field>username</Field> and <Field>password</Field> of a user account that has access to all
+                    the repositories you want to sync.
+                </li>
+                <li>
+                    Set <Field>repos</Field> to the paths of the repositories to sync, relative to <Field>url</Field>.
+                </li>
+            </ol>
+            <Text>
+                Subversion repositories are converted to Git repositories when they are synced. See{' '}
+                <Link rel="noopener noreferrer" target="_blank" to="/help/admin/repo/subversion">
+                    the docs
+                </Link>{' '}
+                for more information.
+            </Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
 const GERRIT: AddExternalServiceOptions = {
     kind: ExternalServiceKind.GERRIT,
     title: 'Gerrit',
@@ -1593,6 +1680,146 @@ const RUBY_PACKAGES: AddExternalServiceOptions = {
     editorActions: [],
 }
 
+const DOTNET_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.DOTNETPACKAGES,
+    title: '.NET Dependencies',
+    icon: LanguageCsharpIcon,
+    jsonSchema: dotnetPackagesSchemaJSON,
+    defaultDisplayName: '.NET Dependencies',
+    defaultConfig: `{
+  "registry": "https://api.nuget.org/v3-flatcontainer/",
+  "dependencies": ["Newtonsoft.Json@13.0.3"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://api.nuget.org/v3-flatcontainer/ is used if the field
+                    <Code>"registry"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE_ID@PACKAGE_VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"registry"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+                <li>
+                    The registry must serve the NuGet package content API, which is the{' '}
+                    <Code>PackageBaseAddress/3.0.0</Code> resource of a NuGet V3 feed.
+                </li>
+            </ol>
+            <Text>⚠️ .NET package repositories are visible by all users of the Sourcegraph instance.</Text>
+            <Text>⚠️ It is only possible to register one .NET packages code host per Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const PHP_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.PHPPACKAGES,
+    title: 'PHP Dependencies',
+    icon: LanguagePhpIcon,
+    jsonSchema: phpPackagesSchemaJSON,
+    defaultDisplayName: 'PHP Dependencies',
+    defaultConfig: `{
+  "repository": "https://repo.packagist.org/",
+  "dependencies": ["monolog/monolog@3.4.0"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://repo.packagist.org/ is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"VENDOR/PACKAGE@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+                <li>
+                    The repository must serve the Composer v2 metadata API, for example a{' '}
+                    <Link to="https://github.com/composer/satis">Satis</Link> or Private Packagist repository.
+                </li>
+            </ol>
+            <Text>⚠️ PHP package repositories are visible by all users of the Sourcegraph instance.</Text>
+            <Text>⚠️ It is only possible to register one PHP packages code host per Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const ELIXIR_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.ELIXIRPACKAGES,
+    title: 'Elixir Dependencies',
+    icon: PackageVariantClosedIcon,
+    jsonSchema: elixirPackagesSchemaJSON,
+    defaultDisplayName: 'Elixir Dependencies',
+    defaultConfig: `{
+  "repository": "https://repo.hex.pm/",
+  "dependencies": ["jason@1.4.1"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://repo.hex.pm/ is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+            </ol>
+            <Text>⚠️ Elixir package repositories are visible by all users of the Sourcegraph instance.</Text>
+            <Text>⚠️ It is only possible to register one Elixir packages code host per Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
+const DART_PACKAGES: AddExternalServiceOptions = {
+    kind: ExternalServiceKind.DARTPACKAGES,
+    title: 'Dart Dependencies',
+    icon: PackageVariantClosedIcon,
+    jsonSchema: dartPackagesSchemaJSON,
+    defaultDisplayName: 'Dart Dependencies',
+    defaultConfig: `{
+  "repository": "https://pub.dev/",
+  "dependencies": ["http@1.1.0"]
+}`,
+    Instructions: () => (
+        <div>
+            <ol>
+                <li>
+                    The URL https://pub.dev/ is used if the field
+                    <Code>"repository"</Code> is empty.
+                </li>
+                <li>
+                    Use the syntax <Code>"PACKAGE@VERSION"</Code> to list a dependency for the{' '}
+                    <Code>"dependencies"</Code> field.
+                </li>
+                <li>
+                    The field <Code>"repository"</Code> is redacted because it can include <Code>admin:password</Code>{' '}
+                    credentials.
+                </li>
+            </ol>
+            <Text>⚠️ Dart package repositories are visible by all users of the Sourcegraph instance.</Text>
+            <Text>⚠️ It is only possible to register one Dart packages code host per Sourcegraph instance.</Text>
+        </div>
+    ),
+    editorActions: [],
+}
+
 export const codeHostExternalServices: Record<string, AddExternalServiceOptions> = {
     github: GITHUB,
     ghapp: GITHUB_APP,
@@ -1606,6 +1833,8 @@ export const codeHostExternalServices: Record<string, AddExternalServiceOptions>
     gerrit: GERRIT,
     azuredevops: AZUREDEVOPS,
     phabricator: PHABRICATOR_SERVICE,
+    mercurial: MERCURIAL,
+    subversion: SUBVERSION,
     ...(window.context?.experimentalFeatures?.perforce !== 'disabled' ? { perforce: PERFORCE } : {}),
     ...(window.context?.experimentalFeatures?.pagure === 'enabled' ? { pagure: PAGURE } : {}),
 }
@@ -1614,6 +1843,10 @@ export const nonCodeHostExternalServices: Record<string, AddExternalServiceOptio
     ...(window.context?.experimentalFeatures?.pythonPackages === 'enabled' ? { pythonPackages: PYTHON_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rustPackages === 'enabled' ? { rustPackages: RUST_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.rubyPackages === 'enabled' ? { rubyPackages: RUBY_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.dotnetPackages === 'enabled' ? { dotnetPackages: DOTNET_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.phpPackages === 'enabled' ? { phpPackages: PHP_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.elixirPackages === 'enabled' ? { elixirPackages: ELIXIR_PACKAGES } : {}),
+    ...(window.context?.experimentalFeatures?.dartPackages === 'enabled' ? { dartPackages: DART_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.goPackages === 'enabled' ? { goModules: GO_MODULES } : {}),
     ...(window.context?.experimentalFeatures?.jvmPackages === 'enabled' ? { jvmPackages: JVM_PACKAGES } : {}),
     ...(window.context?.experimentalFeatures?.npmPackages === 'enabled' ? { npmPackages: NPM_PACKAGES } : {}),
@@ -1638,12 +1871,18 @@ export const defaultExternalServices: Record<ExternalServiceKind, AddExternalSer
     [ExternalServiceKind.PERFORCE]: PERFORCE,
     [ExternalServiceKind.GERRIT]: GERRIT,
     [ExternalServiceKind.PAGURE]: PAGURE,
+    [ExternalServiceKind.MERCURIAL]: MERCURIAL,
+    [ExternalServiceKind.SUBVERSION]: SUBVERSION,
     [ExternalServiceKind.GOMODULES]: GO_MODULES,
     [ExternalServiceKind.JVMPACKAGES]: JVM_PACKAGES,
     [ExternalServiceKind.NPMPACKAGES]: NPM_PACKAGES,
     [ExternalServiceKind.PYTHONPACKAGES]: PYTHON_PACKAGES,
     [ExternalServiceKind.RUSTPACKAGES]: RUST_PACKAGES,
     [ExternalServiceKind.RUBYPACKAGES]: RUBY_PACKAGES,
+    [ExternalServiceKind.DOTNETPACKAGES]: DOTNET_PACKAGES,
+    [ExternalServiceKind.PHPPACKAGES]: PHP_PACKAGES,
+    [ExternalServiceKind.ELIXIRPACKAGES]: ELIXIR_PACKAGES,
+    [ExternalServiceKind.DARTPACKAGES]: DART_PACKAGES,
 }
 
 export const externalRepoIcon = (
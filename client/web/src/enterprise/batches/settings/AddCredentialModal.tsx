@@ -67,6 +67,10 @@ const scopeRequirements: Record<ExternalServiceKind, JSX.Element> = {
     [ExternalServiceKind.PYTHONPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.RUSTPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.RUBYPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.DOTNETPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.PHPPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.ELIXIRPACKAGES]: <span>Unsupported</span>,
+    [ExternalServiceKind.DARTPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.JVMPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.NPMPACKAGES]: <span>Unsupported</span>,
     [ExternalServiceKind.PHABRICATOR]: <span>Unsupported</span>,
@@ -74,6 +78,8 @@ const scopeRequirements: Record<ExternalServiceKind, JSX.Element> = {
     [ExternalServiceKind.PAGURE]: <span>Unsupported</span>,
     [ExternalServiceKind.OTHER]: <span>Unsupported</span>,
     [ExternalServiceKind.LOCALGIT]: <span>Unsupported</span>,
+    [ExternalServiceKind.MERCURIAL]: <span>Unsupported</span>,
+    [ExternalServiceKind.SUBVERSION]: <span>Unsupported</span>,
 }
 
 type Step = 'add-token' | 'get-ssh-key'
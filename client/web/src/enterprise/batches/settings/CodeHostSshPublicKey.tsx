@@ -24,12 +24,18 @@ const configInstructionLinks: Record<ExternalServiceKind, string> = {
     [ExternalServiceKind.NPMPACKAGES]: 'unsupported',
     [ExternalServiceKind.OTHER]: 'unsupported',
     [ExternalServiceKind.LOCALGIT]: 'unsupported',
+    [ExternalServiceKind.MERCURIAL]: 'unsupported',
     [ExternalServiceKind.PERFORCE]: 'unsupported',
     [ExternalServiceKind.PAGURE]: 'unsupported',
     [ExternalServiceKind.PHABRICATOR]: 'unsupported',
     [ExternalServiceKind.PYTHONPACKAGES]: 'unsupported',
     [ExternalServiceKind.RUSTPACKAGES]: 'unsupported',
     [ExternalServiceKind.RUBYPACKAGES]: 'unsupported',
+    [ExternalServiceKind.DOTNETPACKAGES]: 'unsupported',
+    [ExternalServiceKind.PHPPACKAGES]: 'unsupported',
+    [ExternalServiceKind.ELIXIRPACKAGES]: 'unsupported',
+    [ExternalServiceKind.DARTPACKAGES]: 'unsupported',
+    [ExternalServiceKind.SUBVERSION]: 'unsupported',
 }
 
 export interface CodeHostSshPublicKeyProps {
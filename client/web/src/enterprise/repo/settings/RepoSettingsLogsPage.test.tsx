@@ -30,6 +30,7 @@ const mockRepo: SettingsAreaRepositoryFields = {
         updatedAt: '2023-07-31T10:24:00Z',
         isCorrupted: false,
         corruptionLogs: [],
+        maintenanceLogs: [],
         lastError: '',
         lastSyncOutput: '',
         updateSchedule: {
@@ -12,6 +12,10 @@ export function isPackageServiceType(serviceType?: string): boolean {
         case 'npmPackages':
         case 'pythonPackages':
         case 'rubyPackages':
+        case 'dotnetPackages':
+        case 'phpPackages':
+        case 'elixirPackages':
+        case 'dartPackages':
         case 'goModules':
         case 'rustPackages': {
             return true
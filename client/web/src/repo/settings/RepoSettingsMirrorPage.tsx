@@ -42,6 +42,7 @@ import {
     UPDATE_MIRROR_REPOSITORY,
 } from '../../site-admin/backend'
 import { eventLogger } from '../../tracking/eventLogger'
+import { formatDurationLong } from '../../util/time'
 import { DirectImportRepoAlert } from '../DirectImportRepoAlert'
 
 import { FETCH_SETTINGS_AREA_REPOSITORY_GQL } from './backend'
@@ -278,6 +279,65 @@ const CorruptionLogsContainer: FC<CorruptionLogProps> = props => {
     )
 }
 
+interface MaintenanceLogProps {
+    repo: SettingsAreaRepositoryFields
+}
+
+const MaintenanceLogsContainer: FC<MaintenanceLogProps> = props => {
+    const logEvents: JSX.Element[] = props.repo.mirrorInfo.maintenanceLogs.map(log => (
+        <li key={`${props.repo.name}#${log.timestamp}`} className="list-group-item px-2 py-1">
+            <div className="d-flex align-items-center justify-content-between">
+                <Text className="mb-0">
+                    <Code>{log.strategy}</Code> ({log.reason}) {log.success ? 'took' : 'failed after'}{' '}
+                    {formatDurationLong(log.durationMilliseconds)}
+                </Text>
+                <small className="text-muted mb-0">
+                    <Timestamp date={log.timestamp} />
+                </small>
+            </div>
+        </li>
+    ))
+
+    const [isOpened, setIsOpened] = useState(false)
+    const hasLogs = logEvents.length !== 0
+
+    return (
+        <BaseActionContainer
+            title="Git maintenance"
+            titleAs="h3"
+            description={<span>Recent git maintenance runs on this repository and how long they took.</span>}
+            className="mb-0"
+            details={
+                <div className="flex-1">
+                    {!hasLogs && <Text className="mt-3 text-muted text-center mb-0">No maintenance history</Text>}
+                    {hasLogs && (
+                        <Collapse isOpen={isOpened} onOpenChange={setIsOpened}>
+                            <CollapseHeader
+                                as={Button}
+                                outline={true}
+                                focusLocked={true}
+                                variant="secondary"
+                                className="w-100 my-2"
+                                disabled={!hasLogs}
+                            >
+                                Show maintenance history
+                                <Icon
+                                    aria-hidden={true}
+                                    svgPath={isOpened ? mdiChevronUp : mdiChevronDown}
+                                    className="mr-1"
+                                />
+                            </CollapseHeader>
+                            <CollapsePanel>
+                                <ul className="list-group">{logEvents}</ul>
+                            </CollapsePanel>
+                        </Collapse>
+                    )}
+                </div>
+            }
+        />
+    )
+}
+
 interface RepoSettingsMirrorPageProps {
     repo: SettingsAreaRepositoryFields
 }
@@ -408,6 +468,7 @@ export const RepoSettingsMirrorPage: FC<RepoSettingsMirrorPageProps> = props =>
                     </Alert>
                 )}
                 <CorruptionLogsContainer repo={repo} />
+                <MaintenanceLogsContainer repo={repo} />
             </Container>
         </>
     )
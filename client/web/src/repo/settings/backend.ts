@@ -36,6 +36,13 @@ export const settingsAreaRepositoryFragment = gql`
                 timestamp
                 reason
             }
+            maintenanceLogs {
+                timestamp
+                strategy
+                reason
+                durationMilliseconds
+                success
+            }
             lastError
             lastSyncOutput
             updateSchedule {
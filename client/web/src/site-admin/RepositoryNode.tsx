@@ -66,6 +66,8 @@ const parseRepositoryStatus = (repo: SiteAdminRepositoryFields): string => {
         status = 'cloned'
     } else if (repo.mirrorInfo.cloneInProgress) {
         status = 'cloning'
+    } else if (repo.mirrorInfo.archivedToColdStorage) {
+        status = 'archived'
     } else if (repo.mirrorInfo.lastError) {
         status = 'failed'
     }
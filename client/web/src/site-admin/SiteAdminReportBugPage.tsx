@@ -11,6 +11,9 @@ import awsCodeCommitJSON from '../../../../schema/aws_codecommit.schema.json'
 import azureDevOpsJSON from '../../../../schema/azuredevops.schema.json'
 import bitbucketCloudSchemaJSON from '../../../../schema/bitbucket_cloud.schema.json'
 import bitbucketServerSchemaJSON from '../../../../schema/bitbucket_server.schema.json'
+import dartPackagesSchemaJSON from '../../../../schema/dart-packages.schema.json'
+import dotnetPackagesSchemaJSON from '../../../../schema/dotnet-packages.schema.json'
+import elixirPackagesSchemaJSON from '../../../../schema/elixir-packages.schema.json'
 import gerritSchemaJSON from '../../../../schema/gerrit.schema.json'
 import githubSchemaJSON from '../../../../schema/github.schema.json'
 import gitlabSchemaJSON from '../../../../schema/gitlab.schema.json'
@@ -18,16 +21,19 @@ import gitoliteSchemaJSON from '../../../../schema/gitolite.schema.json'
 import goModulesSchemaJSON from '../../../../schema/go-modules.schema.json'
 import jvmPackagesSchemaJSON from '../../../../schema/jvm-packages.schema.json'
 import localGitSchemaJSON from '../../../../schema/localgit.schema.json'
+import mercurialSchemaJSON from '../../../../schema/mercurial.schema.json'
 import npmPackagesSchemaJSON from '../../../../schema/npm-packages.schema.json'
 import otherExternalServiceSchemaJSON from '../../../../schema/other_external_service.schema.json'
 import pagureSchemaJSON from '../../../../schema/pagure.schema.json'
 import perforceSchemaJSON from '../../../../schema/perforce.schema.json'
 import phabricatorSchemaJSON from '../../../../schema/phabricator.schema.json'
+import phpPackagesSchemaJSON from '../../../../schema/php-packages.schema.json'
 import pythonPackagesSchemaJSON from '../../../../schema/python-packages.schema.json'
 import rubyPackagesSchemaJSON from '../../../../schema/ruby-packages.schema.json'
 import rustPackagesSchemaJSON from '../../../../schema/rust-packages.schema.json'
 import settingsSchemaJSON from '../../../../schema/settings.schema.json'
 import siteSchemaJSON from '../../../../schema/site.schema.json'
+import subversionSchemaJSON from '../../../../schema/subversion.schema.json'
 import { PageTitle } from '../components/PageTitle'
 import { DynamicallyImportedMonacoSettingsEditor } from '../settings/DynamicallyImportedMonacoSettingsEditor'
 
@@ -57,11 +63,17 @@ const externalServices: Record<ExternalServiceKind, JSONSchema> = {
     PYTHONPACKAGES: pythonPackagesSchemaJSON,
     RUSTPACKAGES: rustPackagesSchemaJSON,
     RUBYPACKAGES: rubyPackagesSchemaJSON,
+    DOTNETPACKAGES: dotnetPackagesSchemaJSON,
+    PHPPACKAGES: phpPackagesSchemaJSON,
+    ELIXIRPACKAGES: elixirPackagesSchemaJSON,
+    DARTPACKAGES: dartPackagesSchemaJSON,
     OTHER: otherExternalServiceSchemaJSON,
     PERFORCE: perforceSchemaJSON,
     PHABRICATOR: phabricatorSchemaJSON,
     PAGURE: pagureSchemaJSON,
     LOCALGIT: localGitSchemaJSON,
+    MERCURIAL: mercurialSchemaJSON,
+    SUBVERSION: subversionSchemaJSON,
 }
 
 const allConfigSchema = {
@@ -58,6 +58,12 @@ const STATUS_FILTERS: { [label: string]: FilteredConnectionFilterValue } = {
         tooltip: 'Show only repositories that have not been cloned yet',
         args: { cloneStatus: 'NOT_CLONED' },
     },
+    Archived: {
+        label: 'Archived',
+        value: 'archived',
+        tooltip: 'Show only repositories that have been archived to cold storage',
+        args: { cloneStatus: 'ARCHIVED' },
+    },
     Indexed: {
         label: 'Indexed',
         value: 'indexed',
@@ -112,6 +112,7 @@ const mirrorRepositoryInfoFieldsFragment = gql`
     fragment MirrorRepositoryInfoFields on MirrorRepositoryInfo {
         cloned
         cloneInProgress
+        archivedToColdStorage
         cloneProgress @include(if: $displayCloneProgress)
         updatedAt
         nextSyncAt
@@ -14,6 +14,13 @@ export const RepoMirrorInfo: React.FunctionComponent<
     <>
         <Text className="mb-0 text-muted">
             <small>
+                {mirrorInfo.archivedToColdStorage && (
+                    <>
+                        <Tooltip content="The repo was not accessed for a long time and has been moved off gitserver disk. It will be restored on the next access.">
+                            <span>Archived to cold storage.</span>
+                        </Tooltip>{' '}
+                    </>
+                )}
                 {mirrorInfo.updatedAt === null ? (
                     <>Not yet synced from code host.</>
                 ) : (
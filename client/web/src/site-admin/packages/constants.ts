@@ -33,6 +33,22 @@ export const ExternalServicePackageMap: Partial<
         label: 'Rust',
         value: PackageRepoReferenceKind.RUSTPACKAGES,
     },
+    [ExternalServiceKind.DOTNETPACKAGES]: {
+        label: '.NET',
+        value: PackageRepoReferenceKind.DOTNETPACKAGES,
+    },
+    [ExternalServiceKind.PHPPACKAGES]: {
+        label: 'PHP',
+        value: PackageRepoReferenceKind.PHPPACKAGES,
+    },
+    [ExternalServiceKind.ELIXIRPACKAGES]: {
+        label: 'Elixir',
+        value: PackageRepoReferenceKind.ELIXIRPACKAGES,
+    },
+    [ExternalServiceKind.DARTPACKAGES]: {
+        label: 'Dart',
+        value: PackageRepoReferenceKind.DARTPACKAGES,
+    },
 }
 
 export const PackageExternalServiceMap: Partial<
@@ -68,4 +84,20 @@ export const PackageExternalServiceMap: Partial<
         label: 'Rust',
         value: ExternalServiceKind.RUSTPACKAGES,
     },
+    [PackageRepoReferenceKind.DOTNETPACKAGES]: {
+        label: '.NET',
+        value: ExternalServiceKind.DOTNETPACKAGES,
+    },
+    [PackageRepoReferenceKind.PHPPACKAGES]: {
+        label: 'PHP',
+        value: ExternalServiceKind.PHPPACKAGES,
+    },
+    [PackageRepoReferenceKind.ELIXIRPACKAGES]: {
+        label: 'Elixir',
+        value: ExternalServiceKind.ELIXIRPACKAGES,
+    },
+    [PackageRepoReferenceKind.DARTPACKAGES]: {
+        label: 'Dart',
+        value: ExternalServiceKind.DARTPACKAGES,
+    },
 }
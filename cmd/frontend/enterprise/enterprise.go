@@ -43,6 +43,7 @@ type Services struct {
 	// Handler for exporting search jobs data.
 	SearchJobsDataExportHandler http.Handler
 	SearchJobsLogsHandler       http.Handler
+	SearchJobsDiffHandler       http.Handler
 
 	// Handler for completions stream.
 	NewChatCompletionsStreamHandler NewChatCompletionsStreamHandler
@@ -122,6 +123,7 @@ func DefaultServices() Services {
 		NewCodeCompletionsHandler:       func() http.Handler { return makeNotFoundHandler("code completions streaming endpoint") },
 		SearchJobsDataExportHandler:     makeNotFoundHandler("search jobs data export handler"),
 		SearchJobsLogsHandler:           makeNotFoundHandler("search jobs logs handler"),
+		SearchJobsDiffHandler:           makeNotFoundHandler("search jobs diff handler"),
 	}
 }
 
@@ -32,6 +32,7 @@ type CodeMonitorsResolver interface {
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
 	TriggerTestWebhookAction(ctx context.Context, args *TriggerTestWebhookActionArgs) (*EmptyResponse, error)
 	TriggerTestSlackWebhookAction(ctx context.Context, args *TriggerTestSlackWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestChatOpsWebhookAction(ctx context.Context, args *TriggerTestChatOpsWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -90,6 +91,7 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorChatOpsWebhook() (MonitorChatOpsWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -118,6 +120,15 @@ type MonitorSlackWebhookResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorChatOpsWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	Provider() string
+	URL() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -172,9 +183,10 @@ type CreateTriggerArgs struct {
 }
 
 type CreateActionArgs struct {
-	Email        *CreateActionEmailArgs
-	Webhook      *CreateActionWebhookArgs
-	SlackWebhook *CreateActionSlackWebhookArgs
+	Email          *CreateActionEmailArgs
+	Webhook        *CreateActionWebhookArgs
+	SlackWebhook   *CreateActionSlackWebhookArgs
+	ChatOpsWebhook *CreateActionChatOpsWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -197,6 +209,13 @@ type CreateActionSlackWebhookArgs struct {
 	URL            string
 }
 
+type CreateActionChatOpsWebhookArgs struct {
+	Enabled        bool
+	IncludeResults bool
+	Provider       string
+	URL            string
+}
+
 type ToggleCodeMonitorArgs struct {
 	Id      graphql.ID
 	Enabled bool
@@ -228,6 +247,12 @@ type TriggerTestSlackWebhookActionArgs struct {
 	SlackWebhook *CreateActionSlackWebhookArgs
 }
 
+type TriggerTestChatOpsWebhookActionArgs struct {
+	Namespace      graphql.ID
+	Description    string
+	ChatOpsWebhook *CreateActionChatOpsWebhookArgs
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
@@ -249,10 +274,16 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionChatOpsWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionChatOpsWebhookArgs
+}
+
 type EditActionArgs struct {
-	Email        *EditActionEmailArgs
-	Webhook      *EditActionWebhookArgs
-	SlackWebhook *EditActionSlackWebhookArgs
+	Email          *EditActionEmailArgs
+	Webhook        *EditActionWebhookArgs
+	SlackWebhook   *EditActionSlackWebhookArgs
+	ChatOpsWebhook *EditActionChatOpsWebhookArgs
 }
 
 type EditTriggerArgs struct {
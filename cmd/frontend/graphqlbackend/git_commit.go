@@ -63,6 +63,12 @@ type GitCommitResolver struct {
 	commit     *gitdomain.Commit
 	commitOnce sync.Once
 	commitErr  error
+
+	// submodules should not be accessed directly, use resolveSubmodules
+	// instead.
+	submodules     []gitdomain.Submodule
+	submodulesOnce sync.Once
+	submodulesErr  error
 }
 
 // NewGitCommitResolver returns a new CommitResolver. When commit is set to nil,
@@ -97,6 +103,15 @@ func (r *GitCommitResolver) resolveCommit(ctx context.Context) (*gitdomain.Commi
 	return r.commit, r.commitErr
 }
 
+// resolveSubmodules returns the submodules of the commit, with the
+// repositories that their URLs map to.
+func (r *GitCommitResolver) resolveSubmodules(ctx context.Context) ([]gitdomain.Submodule, error) {
+	r.submodulesOnce.Do(func() {
+		r.submodules, r.submodulesErr = r.gitserverClient.Submodules(ctx, r.gitRepo, api.CommitID(r.oid))
+	})
+	return r.submodules, r.submodulesErr
+}
+
 // gitCommitGQLID is a type used for marshaling and unmarshalling a Git commit's
 // GraphQL ID.
 type gitCommitGQLID struct {
@@ -259,6 +259,13 @@ func (r *GitTreeEntryResolver) url(ctx context.Context) *url.URL {
 
 	tr, ctx := trace.New(ctx, "GitTreeEntryResolver.url", attribute.Bool("submodule", true))
 	defer tr.End()
+
+	// Prefer the repository gitserver resolved the submodule to, it knows the
+	// remote URL that relative submodule URLs are relative to.
+	if repoName, err := submodule.repoName(ctx); err == nil && repoName != "" {
+		return &url.URL{Path: "/" + string(repoName) + "@" + submodule.Commit()}
+	}
+
 	submoduleURL := submodule.URL()
 	if strings.HasPrefix(submoduleURL, "../") {
 		submoduleURL = path.Join(r.Repository().Name(), submoduleURL)
@@ -343,7 +350,12 @@ func (r *GitTreeEntryResolver) RawZipArchiveURL() string {
 
 func (r *GitTreeEntryResolver) Submodule() *gitSubmoduleResolver {
 	if submoduleInfo, ok := r.stat.Sys().(gitdomain.Submodule); ok {
-		return &gitSubmoduleResolver{submodule: submoduleInfo}
+		return &gitSubmoduleResolver{
+			db:              r.db,
+			gitserverClient: r.gitserverClient,
+			commit:          r.commit,
+			submodule:       submoduleInfo,
+		}
 	}
 	return nil
 }
@@ -9,7 +9,9 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbmocks"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/pointers"
 )
@@ -295,3 +297,46 @@ func TestPageContent(t *testing.T) {
 		assert.Equal(t, string(testContent), string(have))
 	})
 }
+
+func TestGitTreeEntry_Submodule(t *testing.T) {
+	db := dbmocks.NewMockDB()
+	repos := dbmocks.NewMockRepoStore()
+	repos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*types.Repo, error) {
+		return &types.Repo{ID: 2, Name: name}, nil
+	})
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.SubmodulesFunc.SetDefaultReturn([]gitdomain.Submodule{{
+		Path:     "lib",
+		URL:      "../lib",
+		CommitID: "94aa9078934ce2776ccbb589569eca5ef575f12e",
+		Repo:     "github.com/my/lib",
+	}}, nil)
+
+	opts := GitTreeEntryResolverOpts{
+		Commit: NewGitCommitResolver(db, gitserverClient, NewRepositoryResolver(db, gitserverClient, &types.Repo{Name: "github.com/my/repo"}), "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", nil),
+		Stat: &fileutil.FileInfo{
+			Name_: "lib",
+			Mode_: gitdomain.ModeSubmodule,
+			Sys_: gitdomain.Submodule{
+				Path:     "lib",
+				URL:      "../lib",
+				CommitID: "94aa9078934ce2776ccbb589569eca5ef575f12e",
+			},
+		},
+	}
+	entry := NewGitTreeEntryResolver(db, gitserverClient, opts)
+
+	url, err := entry.URL(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "/github.com/my/lib@94aa9078934ce2776ccbb589569eca5ef575f12e", url)
+
+	repo, err := entry.Submodule().Repository(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "github.com/my/lib", repo.Name())
+}
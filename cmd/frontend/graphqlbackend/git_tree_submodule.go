@@ -1,10 +1,20 @@
 package graphqlbackend
 
 import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 )
 
 type gitSubmoduleResolver struct {
+	db              database.DB
+	gitserverClient gitserver.Client
+	// commit is the commit of the superproject the submodule belongs to.
+	commit    *GitCommitResolver
 	submodule gitdomain.Submodule
 }
 
@@ -19,3 +29,36 @@ func (r *gitSubmoduleResolver) Commit() string {
 func (r *gitSubmoduleResolver) Path() string {
 	return r.submodule.Path
 }
+
+func (r *gitSubmoduleResolver) Repository(ctx context.Context) (*RepositoryResolver, error) {
+	repoName, err := r.repoName(ctx)
+	if err != nil || repoName == "" {
+		return nil, err
+	}
+
+	// Looking the repository up through the database enforces that the user
+	// has access to it.
+	repo, err := r.db.Repos().GetByName(ctx, repoName)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return NewRepositoryResolver(r.db, r.gitserverClient, repo), nil
+}
+
+// repoName returns the name of the repository that gitserver resolved the
+// submodule URL to. It is empty if the URL doesn't map to a repository.
+func (r *gitSubmoduleResolver) repoName(ctx context.Context) (api.RepoName, error) {
+	submodules, err := r.commit.resolveSubmodules(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, s := range submodules {
+		if s.Path == r.submodule.Path {
+			return s.Repo, nil
+		}
+	}
+	return "", nil
+}
@@ -27,12 +27,16 @@ type PackageRepoReferenceConnectionArgs struct {
 }
 
 var externalServiceToPackageSchemeMap = map[string]string{
-	extsvc.KindJVMPackages:    dependencies.JVMPackagesScheme,
-	extsvc.KindNpmPackages:    dependencies.NpmPackagesScheme,
-	extsvc.KindGoPackages:     dependencies.GoPackagesScheme,
-	extsvc.KindPythonPackages: dependencies.PythonPackagesScheme,
-	extsvc.KindRustPackages:   dependencies.RustPackagesScheme,
-	extsvc.KindRubyPackages:   dependencies.RubyPackagesScheme,
+	extsvc.KindJVMPackages:       dependencies.JVMPackagesScheme,
+	extsvc.KindNpmPackages:       dependencies.NpmPackagesScheme,
+	extsvc.KindGoPackages:        dependencies.GoPackagesScheme,
+	extsvc.KindPythonPackages:    dependencies.PythonPackagesScheme,
+	extsvc.KindRustPackages:      dependencies.RustPackagesScheme,
+	extsvc.KindRubyPackages:      dependencies.RubyPackagesScheme,
+	extsvc.KindNugetPackages:     dependencies.DotnetPackagesScheme,
+	extsvc.KindPackagistPackages: dependencies.PhpPackagesScheme,
+	extsvc.KindHexPackages:       dependencies.ElixirPackagesScheme,
+	extsvc.KindPubPackages:       dependencies.DartPackagesScheme,
 }
 
 var packageSchemeToExternalServiceMap = map[string]string{
@@ -42,6 +46,10 @@ var packageSchemeToExternalServiceMap = map[string]string{
 	dependencies.PythonPackagesScheme: extsvc.KindPythonPackages,
 	dependencies.RustPackagesScheme:   extsvc.KindRustPackages,
 	dependencies.RubyPackagesScheme:   extsvc.KindRubyPackages,
+	dependencies.DotnetPackagesScheme: extsvc.KindNugetPackages,
+	dependencies.PhpPackagesScheme:    extsvc.KindPackagistPackages,
+	dependencies.ElixirPackagesScheme: extsvc.KindHexPackages,
+	dependencies.DartPackagesScheme:   extsvc.KindPubPackages,
 }
 
 func (r *schemaResolver) PackageRepoReferences(ctx context.Context, args *PackageRepoReferenceConnectionArgs) (_ *packageRepoReferenceConnectionResolver, err error) {
@@ -232,6 +240,14 @@ func dependencyRepoToRepoName(dep dependencies.PackageRepoReference) (repoName a
 		repoName = reposource.ParsePythonPackageFromName(dep.Name).RepoName()
 	case "scip-ruby":
 		repoName = reposource.ParseRubyPackageFromName(dep.Name).RepoName()
+	case "scip-dotnet":
+		repoName = reposource.ParseDotnetPackageFromName(dep.Name).RepoName()
+	case "scip-php":
+		repoName = reposource.ParsePhpPackageFromName(dep.Name).RepoName()
+	case "hex":
+		repoName = reposource.ParseElixirPackageFromName(dep.Name).RepoName()
+	case "scip-dart":
+		repoName = reposource.ParseDartPackageFromName(dep.Name).RepoName()
 	case "semanticdb":
 		pkg, err := reposource.ParseMavenPackageFromName(dep.Name)
 		if err != nil {
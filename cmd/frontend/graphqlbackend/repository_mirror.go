@@ -125,6 +125,15 @@ func (r *repositoryMirrorInfoResolver) CloneInProgress(ctx context.Context) (boo
 	return info.CloneStatus == types.CloneStatusCloning, nil
 }
 
+func (r *repositoryMirrorInfoResolver) ArchivedToColdStorage(ctx context.Context) (bool, error) {
+	info, err := r.computeGitserverRepo(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return info.CloneStatus == types.CloneStatusArchived, nil
+}
+
 func (r *repositoryMirrorInfoResolver) CloneProgress(ctx context.Context) (*string, error) {
 	if featureflag.FromContext(ctx).GetBoolOr("clone-progress-logging", false) {
 		info, err := r.computeGitserverRepo(ctx)
@@ -233,6 +242,44 @@ func (r *corruptionLogResolver) Reason() (string, error) {
 	return r.log.Reason, nil
 }
 
+func (r *repositoryMirrorInfoResolver) MaintenanceLogs(ctx context.Context) ([]*maintenanceLogResolver, error) {
+	info, err := r.computeGitserverRepo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*maintenanceLogResolver, 0, len(info.MaintenanceLogs))
+	for _, l := range info.MaintenanceLogs {
+		logs = append(logs, &maintenanceLogResolver{log: l})
+	}
+
+	return logs, nil
+}
+
+type maintenanceLogResolver struct {
+	log types.RepoMaintenanceLog
+}
+
+func (r *maintenanceLogResolver) Timestamp() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.log.Timestamp}
+}
+
+func (r *maintenanceLogResolver) Strategy() string {
+	return r.log.Strategy
+}
+
+func (r *maintenanceLogResolver) Reason() string {
+	return r.log.Reason
+}
+
+func (r *maintenanceLogResolver) DurationMilliseconds() int32 {
+	return int32(r.log.Duration.Milliseconds())
+}
+
+func (r *maintenanceLogResolver) Success() bool {
+	return r.log.Success
+}
+
 func (r *repositoryMirrorInfoResolver) ByteSize(ctx context.Context) (BigInt, error) {
 	info, err := r.computeGitserverRepo(ctx)
 	if err != nil {
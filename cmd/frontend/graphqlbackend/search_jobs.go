@@ -14,6 +14,7 @@ type SearchJobsResolver interface {
 	CreateSearchJob(ctx context.Context, args *CreateSearchJobArgs) (SearchJobResolver, error)
 	CancelSearchJob(ctx context.Context, args *CancelSearchJobArgs) (*EmptyResponse, error)
 	DeleteSearchJob(ctx context.Context, args *DeleteSearchJobArgs) (*EmptyResponse, error)
+	RerunSearchJob(ctx context.Context, args *RerunSearchJobArgs) (SearchJobResolver, error)
 
 	// Queries
 	SearchJobs(ctx context.Context, args *SearchJobsArgs) (*graphqlutil.ConnectionResolver[SearchJobResolver], error)
@@ -32,7 +33,8 @@ type ValidateSearchJobQueryResolver interface {
 }
 
 type CreateSearchJobArgs struct {
-	Query string
+	Query        string
+	ResultFormat string
 }
 
 type SearchJobResolver interface {
@@ -43,8 +45,11 @@ type SearchJobResolver interface {
 	CreatedAt() gqlutil.DateTime
 	StartedAt(ctx context.Context) *gqlutil.DateTime
 	FinishedAt(ctx context.Context) *gqlutil.DateTime
+	ResultFormat() string
 	URL(ctx context.Context) (*string, error)
 	LogURL(ctx context.Context) (*string, error)
+	PreviousSearchJob(ctx context.Context) (SearchJobResolver, error)
+	DiffURL(ctx context.Context) (*string, error)
 	RepoStats(ctx context.Context) (SearchJobStatsResolver, error)
 }
 
@@ -73,6 +78,10 @@ type DeleteSearchJobArgs struct {
 	ID graphql.ID
 }
 
+type RerunSearchJobArgs struct {
+	ID graphql.ID
+}
+
 type RetrySearchJobArgs struct {
 	ID graphql.ID
 }
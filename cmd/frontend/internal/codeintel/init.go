@@ -23,6 +23,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
 )
 
 func LoadConfig() {
@@ -134,6 +135,9 @@ func Init(
 	))
 	enterpriseServices.NewCodeIntelUploadHandler = newUploadHandler
 	enterpriseServices.RankingService = codeIntelServices.RankingService
+
+	// Serve symbol searches from precise indexes where they exist.
+	searcher.DefaultPreciseSymbolSource = &preciseSymbolSource{codenavSvc: codeIntelServices.CodenavService}
 	return nil
 }
 
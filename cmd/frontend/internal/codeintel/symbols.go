@@ -0,0 +1,163 @@
+package codeintel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-enry/go-enry/v2"
+	"github.com/sourcegraph/scip/bindings/go/scip"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+)
+
+// preciseSymbolSource serves symbol searches with the symbols defined by the
+// precise indexes of a commit.
+type preciseSymbolSource struct {
+	codenavSvc *codenav.Service
+}
+
+var _ searcher.PreciseSymbolSource = &preciseSymbolSource{}
+
+// symbolDefinitionsPageSize is the number of definitions read from the
+// database at a time while looking for symbols matching a query.
+const symbolDefinitionsPageSize = 1000
+
+func (s *preciseSymbolSource) SymbolDefinitions(ctx context.Context, repoID api.RepoID, commit api.CommitID, q searcher.PreciseSymbolQuery) ([]searcher.PreciseSymbol, bool, error) {
+	opts := shared.SymbolDefinitionsOptions{
+		Name:  q.Name,
+		Paths: q.Paths,
+		Limit: symbolDefinitionsPageSize,
+	}
+
+	var symbols []searcher.PreciseSymbol
+	for {
+		definitions, ok, err := s.codenavSvc.GetSymbolDefinitions(ctx, int(repoID), string(commit), opts)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+
+		for _, definition := range definitions {
+			symbol, ok := preciseSymbolFromDefinition(definition)
+			if !ok || !q.Match(symbol) {
+				continue
+			}
+			symbols = append(symbols, symbol)
+			if len(symbols) == q.Limit {
+				return symbols, true, nil
+			}
+		}
+
+		if len(definitions) < opts.Limit {
+			return symbols, true, nil
+		}
+		opts.Offset += len(definitions)
+	}
+}
+
+// preciseSymbolFromDefinition converts the definition of a SCIP symbol into a
+// search symbol. The kind of the symbol is derived from the suffix of its last
+// descriptor, and its parent is the fully qualified name of its container. A
+// false-valued flag is returned for symbols which ctags wouldn't list either,
+// like parameters.
+func preciseSymbolFromDefinition(definition shared.SymbolDefinition) (searcher.PreciseSymbol, bool) {
+	if scip.IsLocalSymbol(definition.Symbol) {
+		return searcher.PreciseSymbol{}, false
+	}
+	parsed, err := scip.ParseSymbol(definition.Symbol)
+	if err != nil || len(parsed.Descriptors) == 0 {
+		return searcher.PreciseSymbol{}, false
+	}
+
+	descriptors := parsed.Descriptors
+	last := len(descriptors) - 1
+	kind := descriptorKind(descriptors, last)
+	if kind == "" {
+		return searcher.PreciseSymbol{}, false
+	}
+
+	var parentKind string
+	if last > 0 {
+		parentKind = descriptorKind(descriptors, last-1)
+	}
+
+	var namespaces []string
+	for _, descriptor := range descriptors[:last] {
+		if descriptor.Suffix != scip.Descriptor_Namespace {
+			break
+		}
+		namespaces = append(namespaces, descriptor.Name)
+	}
+
+	var packageName string
+	if parsed.Package != nil {
+		packageName = parsed.Package.Name
+	}
+
+	language, _ := enry.GetLanguageByExtension(definition.Path)
+
+	return searcher.PreciseSymbol{
+		Symbol: result.Symbol{
+			Name:       descriptors[last].Name,
+			Path:       definition.Path,
+			Line:       definition.Range.Start.Line,
+			Character:  definition.Range.Start.Character,
+			Kind:       kind,
+			Language:   language,
+			Parent:     qualifiedName(descriptors[:last]),
+			ParentKind: parentKind,
+		},
+		Package:   packageName,
+		Namespace: strings.Join(namespaces, "/"),
+	}, true
+}
+
+// descriptorKind returns the symbol kind of the i-th descriptor, using the
+// names of ctags kinds so that select:symbol.<kind> works the same way for
+// precise and ctags symbols.
+func descriptorKind(descriptors []*scip.Descriptor, i int) string {
+	parentIsType := i > 0 && descriptors[i-1].Suffix == scip.Descriptor_Type
+
+	switch descriptors[i].Suffix {
+	case scip.Descriptor_Namespace:
+		return "namespace"
+	case scip.Descriptor_Type:
+		return "type"
+	case scip.Descriptor_Method:
+		if parentIsType {
+			return "method"
+		}
+		return "function"
+	case scip.Descriptor_Term:
+		if parentIsType {
+			return "field"
+		}
+		return "variable"
+	case scip.Descriptor_TypeParameter:
+		return "type parameter"
+	case scip.Descriptor_Macro:
+		return "macro"
+	}
+	return ""
+}
+
+// qualifiedName joins the names of the given descriptors, separating
+// namespaces with slashes and other descriptors with dots, like
+// github.com/foo/bar/Client.Do.
+func qualifiedName(descriptors []*scip.Descriptor) string {
+	var b strings.Builder
+	for i, descriptor := range descriptors {
+		if i > 0 {
+			if descriptors[i-1].Suffix == scip.Descriptor_Namespace {
+				b.WriteByte('/')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteString(descriptor.Name)
+	}
+	return b.String()
+}
@@ -0,0 +1,100 @@
+package codeintel
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+)
+
+func TestPreciseSymbolFromDefinition(t *testing.T) {
+	definition := func(symbol string) shared.SymbolDefinition {
+		return shared.SymbolDefinition{
+			Symbol: symbol,
+			Location: shared.Location{
+				Path:  "client/client.go",
+				Range: shared.Range{Start: shared.Position{Line: 9, Character: 5}, End: shared.Position{Line: 9, Character: 11}},
+			},
+		}
+	}
+
+	cases := []struct {
+		symbol string
+		want   *searcher.PreciseSymbol
+	}{
+		{
+			symbol: "scip-go gomod example.com/mod v1.2.3 `example.com/mod/client`/Client#",
+			want: &searcher.PreciseSymbol{
+				Symbol:    result.Symbol{Name: "Client", Kind: "type", Parent: "example.com/mod/client", ParentKind: "namespace"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+		},
+		{
+			symbol: "scip-go gomod example.com/mod v1.2.3 `example.com/mod/client`/Client#Do().",
+			want: &searcher.PreciseSymbol{
+				Symbol:    result.Symbol{Name: "Do", Kind: "method", Parent: "example.com/mod/client/Client", ParentKind: "type"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+		},
+		{
+			symbol: "scip-go gomod example.com/mod v1.2.3 `example.com/mod/client`/Client#timeout.",
+			want: &searcher.PreciseSymbol{
+				Symbol:    result.Symbol{Name: "timeout", Kind: "field", Parent: "example.com/mod/client/Client", ParentKind: "type"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+		},
+		{
+			symbol: "scip-go gomod example.com/mod v1.2.3 `example.com/mod/client`/NewClient().",
+			want: &searcher.PreciseSymbol{
+				Symbol:    result.Symbol{Name: "NewClient", Kind: "function", Parent: "example.com/mod/client", ParentKind: "namespace"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+		},
+		{
+			symbol: "scip-typescript npm template 0.0.0 src/util/`helpers.ts`/defaultTimeout.",
+			want: &searcher.PreciseSymbol{
+				Symbol:    result.Symbol{Name: "defaultTimeout", Kind: "variable", Parent: "src/util/helpers.ts", ParentKind: "namespace"},
+				Package:   "template",
+				Namespace: "src/util/helpers.ts",
+			},
+		},
+		{
+			// Parameters aren't listed by ctags either
+			symbol: "scip-go gomod example.com/mod v1.2.3 `example.com/mod/client`/NewClient().(timeout)",
+		},
+		{
+			symbol: "local 42",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.symbol, func(t *testing.T) {
+			got, ok := preciseSymbolFromDefinition(definition(tc.symbol))
+			if tc.want == nil {
+				if ok {
+					t.Fatalf("unexpected symbol %+v", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("expected a symbol")
+			}
+
+			want := *tc.want
+			want.Path = "client/client.go"
+			want.Line = 9
+			want.Character = 5
+			want.Language = "Go"
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("unexpected symbol (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
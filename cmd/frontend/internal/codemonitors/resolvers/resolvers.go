@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -298,15 +299,24 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err != nil {
 				return err
 			}
+		case a.ChatOpsWebhook != nil:
+			provider, err := validateChatOpsWebhook(a.ChatOpsWebhook)
+			if err != nil {
+				return err
+			}
+			_, err = r.db.CodeMonitors().CreateChatOpsWebhookAction(ctx, monitorID, a.ChatOpsWebhook.Enabled, a.ChatOpsWebhook.IncludeResults, provider, a.ChatOpsWebhook.URL)
+			if err != nil {
+				return err
+			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, or ChatOpsWebhook must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, chatOpsWebhook []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -321,8 +331,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionChatOpsWebhookKind:
+			chatOpsWebhook = append(chatOpsWebhook, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, or chat-ops webhook")
 		}
 	}
 
@@ -338,6 +350,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteChatOpsWebhookActions(ctx, monitorID, chatOpsWebhook...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -418,6 +434,24 @@ func (r *Resolver) TriggerTestSlackWebhookAction(ctx context.Context, args *grap
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *Resolver) TriggerTestChatOpsWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestChatOpsWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := validateChatOpsWebhook(args.ChatOpsWebhook)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := background.SendTestChatOpsWebhook(ctx, httpcli.ExternalDoer, provider, args.Description, args.ChatOpsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func sendTestEmail(ctx context.Context, db database.DB, recipient graphql.ID, description string) error {
 	var (
 		userID int32
@@ -449,7 +483,11 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	chatOpsWebhookActions, err := r.db.CodeMonitors().ListChatOpsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(chatOpsWebhookActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -459,6 +497,9 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, chatOpsWebhookAction := range chatOpsWebhookActions {
+		ids = append(ids, (&monitorChatOpsWebhook{ChatOpsWebhookAction: chatOpsWebhookAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -503,6 +544,16 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.ChatOpsWebhook != nil:
+			if a.ChatOpsWebhook.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{ChatOpsWebhook: a.ChatOpsWebhook.Update})
+				continue
+			}
+			if _, ok := aMap[*a.ChatOpsWebhook.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.ChatOpsWebhook.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.ChatOpsWebhook.Id)
 		}
 	}
 
@@ -589,8 +640,10 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, rawDB database.DB, arg
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.ChatOpsWebhook != nil:
+			err = r.updateChatOpsWebhookAction(ctx, *action.ChatOpsWebhook)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, or chat-ops webhook")
 		}
 		if err != nil {
 			return nil, err
@@ -646,6 +699,22 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 	return err
 }
 
+func (r *Resolver) updateChatOpsWebhookAction(ctx context.Context, args graphqlbackend.EditActionChatOpsWebhookArgs) error {
+	provider, err := validateChatOpsWebhook(args.Update)
+	if err != nil {
+		return err
+	}
+
+	var id int64
+	err = relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.CodeMonitors().UpdateChatOpsWebhookAction(ctx, id, args.Update.Enabled, args.Update.IncludeResults, provider, args.Update.URL)
+	return err
+}
+
 func (r *Resolver) withTransact(ctx context.Context, f func(*Resolver) error) error {
 	return r.db.WithTransact(ctx, func(tx database.DB) error {
 		return f(&Resolver{
@@ -723,16 +792,18 @@ func (m *monitorConnection) PageInfo() *graphqlutil.PageInfo {
 }
 
 const (
-	MonitorKind                        = "CodeMonitor"
-	monitorTriggerQueryKind            = "CodeMonitorTriggerQuery"
-	monitorTriggerEventKind            = "CodeMonitorTriggerEvent"
-	monitorActionEmailKind             = "CodeMonitorActionEmail"
-	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
-	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
-	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
-	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
-	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
-	monitorActionEmailRecipientKind    = "CodeMonitorActionEmailRecipient"
+	MonitorKind                          = "CodeMonitor"
+	monitorTriggerQueryKind              = "CodeMonitorTriggerQuery"
+	monitorTriggerEventKind              = "CodeMonitorTriggerEvent"
+	monitorActionEmailKind               = "CodeMonitorActionEmail"
+	monitorActionWebhookKind             = "CodeMonitorActionWebhook"
+	monitorActionSlackWebhookKind        = "CodeMonitorActionSlackWebhook"
+	monitorActionChatOpsWebhookKind      = "CodeMonitorActionChatOpsWebhook"
+	monitorActionEmailEventKind          = "CodeMonitorActionEmailEvent"
+	monitorActionWebhookEventKind        = "CodeMonitorActionWebhookEvent"
+	monitorActionSlackWebhookEventKind   = "CodeMonitorActionSlackWebhookEvent"
+	monitorActionChatOpsWebhookEventKind = "CodeMonitorActionChatOpsWebhookEvent"
+	monitorActionEmailRecipientKind      = "CodeMonitorActionEmailRecipient"
 )
 
 func unmarshalMonitorID(id graphql.ID) (int64, error) {
@@ -824,7 +895,12 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	cws, err := r.db.CodeMonitors().ListChatOpsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(cws))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -852,6 +928,15 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, cw := range cws {
+		actions = append(actions, &action{
+			chatOpsWebhook: &monitorChatOpsWebhook{
+				Resolver:             r,
+				ChatOpsWebhookAction: cw,
+				triggerEventID:       triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -1025,9 +1110,10 @@ func (a *monitorActionConnection) PageInfo() *graphqlutil.PageInfo {
 
 // Action <<UNION>>
 type action struct {
-	email        graphqlbackend.MonitorEmailResolver
-	webhook      graphqlbackend.MonitorWebhookResolver
-	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	email          graphqlbackend.MonitorEmailResolver
+	webhook        graphqlbackend.MonitorWebhookResolver
+	slackWebhook   graphqlbackend.MonitorSlackWebhookResolver
+	chatOpsWebhook graphqlbackend.MonitorChatOpsWebhookResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1038,6 +1124,8 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.chatOpsWebhook != nil:
+		return a.chatOpsWebhook.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1055,6 +1143,10 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorChatOpsWebhook() (graphqlbackend.MonitorChatOpsWebhookResolver, bool) {
+	return a.chatOpsWebhook, a.chatOpsWebhook != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1270,6 +1362,71 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorChatOpsWebhook struct {
+	*Resolver
+	*database.ChatOpsWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorChatOpsWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionChatOpsWebhookKind, m.ChatOpsWebhookAction.ID)
+}
+
+func (m *monitorChatOpsWebhook) Enabled() bool {
+	return m.ChatOpsWebhookAction.Enabled
+}
+
+func (m *monitorChatOpsWebhook) IncludeResults() bool {
+	return m.ChatOpsWebhookAction.IncludeResults
+}
+
+func (m *monitorChatOpsWebhook) Provider() string {
+	for gqlProvider, provider := range chatOpsProviders {
+		if provider == m.ChatOpsWebhookAction.Provider {
+			return gqlProvider
+		}
+	}
+	return strings.ToUpper(string(m.ChatOpsWebhookAction.Provider))
+}
+
+func (m *monitorChatOpsWebhook) URL() string {
+	return m.ChatOpsWebhookAction.URL
+}
+
+func (m *monitorChatOpsWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, database.ListActionJobsOpts{
+		ChatOpsWebhookID: pointers.Ptr(int(m.ChatOpsWebhookAction.ID)),
+		TriggerEventID:   m.triggerEventID,
+		First:            pointers.Ptr(int(args.First)),
+		After:            after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, database.ListActionJobsOpts{
+		ChatOpsWebhookID: pointers.Ptr(int(m.ChatOpsWebhookAction.ID)),
+		TriggerEventID:   m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
 		return nil
@@ -1362,3 +1519,28 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+// chatOpsProviders maps the values of the GraphQL enum MonitorChatOpsProvider
+// to the providers we store.
+var chatOpsProviders = map[string]database.ChatOpsProvider{
+	"MICROSOFT_TEAMS": database.ChatOpsProviderTeams,
+	"MATTERMOST":      database.ChatOpsProviderMattermost,
+}
+
+// validateChatOpsWebhook returns the provider of args. Teams and Mattermost can
+// be self-hosted, so unlike Slack we can't restrict the host of the URL.
+func validateChatOpsWebhook(args *graphqlbackend.CreateActionChatOpsWebhookArgs) (database.ChatOpsProvider, error) {
+	provider, ok := chatOpsProviders[args.Provider]
+	if !ok {
+		return "", errors.Errorf("unknown chat-ops provider %q", args.Provider)
+	}
+
+	u, err := url.Parse(args.URL)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return "", errors.New("chat-ops webhook URL must begin with 'https://' or 'http://'")
+	}
+	return provider, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 func NewResolver(logger log.Logger, db database.DB) gql.ComputeResolver {
@@ -229,6 +230,9 @@ func NewBatchComputeImplementer(ctx context.Context, logger log.Logger, db datab
 	if err != nil {
 		return nil, err
 	}
+	if _, ok := computeQuery.Command.(*compute.Aggregate); ok {
+		return nil, errors.New("the aggregate command is only supported by the streaming compute API")
+	}
 
 	searchQuery, err := computeQuery.ToSearchQuery()
 	if err != nil {
@@ -2,6 +2,7 @@ package streaming
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,12 +14,19 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/compute"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	streamclient "github.com/sourcegraph/sourcegraph/internal/search/streaming/client"
 	streamhttp "github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// maxAggregateGroups bounds the number of distinct groups the aggregate
+// command tracks per request.
+const maxAggregateGroups = 10000
+
 // maxRequestDuration clamps any compute queries to run for at most 1 minute.
 // It's possible to trigger longer-running queries with expensive operations,
 // and this is best avoided on large instances like Sourcegraph.com
@@ -67,6 +75,27 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// When changeset specs are requested, the replace command only previews
+	// its changes as diffs, which we collect to build the specs at the end.
+	var changesetTemplate *compute.ChangesetTemplate
+	var fileDiffs []*compute.FileDiff
+	if args.ChangesetSpecs {
+		replace, ok := computeQuery.Command.(*compute.Replace)
+		if !ok {
+			http.Error(w, "changeset specs can only be created for the replace command", http.StatusBadRequest)
+			return
+		}
+		replace.Diff = true
+		tmpl := replace.DefaultChangesetTemplate()
+		if args.Branch != "" {
+			tmpl.Branch = args.Branch
+		}
+		if args.Title != "" {
+			tmpl.Title = args.Title
+		}
+		changesetTemplate = &tmpl
+	}
+
 	searchQuery, err := computeQuery.ToSearchQuery()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -99,7 +128,19 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	matchesBuf := streamhttp.NewJSONArrayBuf(32*1024, func(data []byte) error {
 		return eventWriter.EventBytes("results", data)
 	})
+
+	// The aggregate command reduces results on the server. Instead of
+	// streaming every result, we send a snapshot of the counts so far on
+	// every flush. Each snapshot supersedes the previous one.
+	var aggregator *compute.Aggregator
+	if _, ok := computeQuery.Command.(*compute.Aggregate); ok {
+		aggregator = compute.NewAggregator(maxAggregateGroups)
+	}
+
 	matchesFlush := func() {
+		if aggregator != nil && aggregator.Dirty() {
+			_ = matchesBuf.Append(aggregator.Snapshot())
+		}
 		if err := matchesBuf.Flush(); err != nil {
 			// EOF
 			return
@@ -121,11 +162,18 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		progress.Stats.Update(&event.Stats)
 
 		for _, result := range event.Results {
+			if agg, ok := result.(*compute.Aggregation); ok && aggregator != nil {
+				aggregator.Add(agg)
+				continue
+			}
+			if d, ok := result.(*compute.FileDiff); ok {
+				fileDiffs = append(fileDiffs, d)
+			}
 			_ = matchesBuf.Append(result)
 		}
 
 		// Instantly send results if we have not sent any yet.
-		if first && matchesBuf.Len() > 0 {
+		if first && (matchesBuf.Len() > 0 || (aggregator != nil && aggregator.Dirty())) {
 			first = false
 			matchesFlush()
 		}
@@ -148,12 +196,36 @@ LOOP:
 
 	matchesFlush()
 
+	if aggregator != nil && aggregator.OtherCount > 0 {
+		_ = eventWriter.Event("alert", streamhttp.EventAlert{
+			Title:       "Too many groups",
+			Description: fmt.Sprintf("Only the first %d groups are counted. %d matches belong to other groups.", maxAggregateGroups, aggregator.OtherCount),
+		})
+	}
+
 	alert, err := getResults()
 	if err != nil {
 		_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
 		return
 	}
 
+	// Specs built from incomplete results would silently miss changes, so we
+	// only send them if all results were searched.
+	if changesetTemplate != nil {
+		if reason := incompleteResultsReason(ctx, &progress.Stats); reason != "" {
+			_ = eventWriter.Event("error", streamhttp.EventError{
+				Message: fmt.Sprintf("Changeset specs can only be created from complete results, but %s. Add count:all to the query or narrow it down.", reason),
+			})
+			return
+		}
+		specs, err := compute.NewChangesetSpecs(ctx, gitserver.NewClient("http.computestream.changesetspecs"), fileDiffs, *changesetTemplate)
+		if err != nil {
+			_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
+			return
+		}
+		_ = eventWriter.Event("changesetSpecs", specs)
+	}
+
 	if err := ctx.Err(); errors.Is(err, context.DeadlineExceeded) {
 		_ = eventWriter.Event("alert", streamhttp.EventAlert{
 			Title:       "Incomplete data",
@@ -178,9 +250,32 @@ LOOP:
 	_ = eventWriter.Event("progress", progress.Final())
 }
 
+// incompleteResultsReason returns why the results of a search are incomplete,
+// or "" if all matches were found.
+func incompleteResultsReason(ctx context.Context, stats *streaming.Stats) string {
+	switch {
+	case ctx.Err() != nil:
+		return "the search did not finish in time"
+	case stats.IsLimitHit || stats.Status.Any(search.RepoStatusLimitHit):
+		return "the search hit its result limit"
+	case stats.Status.Any(search.RepoStatusCloning | search.RepoStatusMissing | search.RepoStatusTimedout):
+		return "some repositories could not be searched"
+	case stats.BackendsMissing > 0:
+		return "some search backends could not be reached"
+	}
+	return ""
+}
+
 type args struct {
 	Query   string
 	Display int
+
+	// ChangesetSpecs, if set, requests changeset specs for the changes of a
+	// replace command. Branch and Title optionally override the defaults of
+	// those specs.
+	ChangesetSpecs bool
+	Branch         string
+	Title          string
 }
 
 func parseURLQuery(q url.Values) (*args, error) {
@@ -206,6 +301,13 @@ func parseURLQuery(q url.Values) (*args, error) {
 		return nil, errors.Errorf("display must be an integer, got %q: %w", display, err)
 	}
 
+	changesetSpecs := get("changesetSpecs", "false")
+	if a.ChangesetSpecs, err = strconv.ParseBool(changesetSpecs); err != nil {
+		return nil, errors.Errorf("changesetSpecs must be a boolean, got %q: %w", changesetSpecs, err)
+	}
+	a.Branch = get("branch", "")
+	a.Title = get("title", "")
+
 	return &a, nil
 }
 
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/auth"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -33,14 +34,25 @@ func ServeSearchJobDownload(logger log.Logger, svc *service.Service) http.Handle
 			return
 		}
 
-		csvWriterTo, err := svc.GetSearchJobCSVWriterTo(r.Context(), int64(jobID))
+		// The format is optional. If it is missing we use the format the
+		// job was created with.
+		var format types.ResultFormat
+		if v := mux.Vars(r)["format"]; v != "" {
+			format, err = types.ParseResultFormat(v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+
+		writerTo, format, err := svc.GetSearchJobResultsWriterTo(r.Context(), int64(jobID), format)
 		if err != nil {
 			httpError(w, err)
 			return
 		}
 
-		filename := filenamePrefix(jobID) + ".csv"
-		writeCSV(logger.With(log.Int("jobID", jobID)), w, filename, csvWriterTo)
+		filename := filenamePrefix(jobID) + "." + format.FileExtension()
+		writeResults(logger.With(log.Int("jobID", jobID)), w, filename, format.ContentType(), writerTo)
 	}
 }
 
@@ -66,13 +78,41 @@ func ServeSearchJobLogs(logger log.Logger, svc *service.Service) http.HandlerFun
 	}
 }
 
+// ServeSearchJobDiff serves a CSV of the matches which were added or removed
+// in a re-run search job compared to the job it re-ran.
+func ServeSearchJobDiff(logger log.Logger, svc *service.Service) http.HandlerFunc {
+	logger = logger.With(log.String("handler", "ServeSearchJobDiff"))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobIDStr := mux.Vars(r)["id"]
+		jobID, err := strconv.Atoi(jobIDStr)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		csvWriterTo, err := svc.GetSearchJobDiffWriterTo(r.Context(), int64(jobID))
+		if err != nil {
+			httpError(w, err)
+			return
+		}
+
+		filename := filenamePrefix(jobID) + ".diff.csv"
+		writeCSV(logger.With(log.Int("jobID", jobID)), w, filename, csvWriterTo)
+	}
+}
+
 func writeCSV(logger log.Logger, w http.ResponseWriter, filenameNoQuotes string, writerTo io.WriterTo) {
-	w.Header().Set("Content-Type", "text/csv")
+	writeResults(logger, w, filenameNoQuotes, "text/csv", writerTo)
+}
+
+func writeResults(logger log.Logger, w http.ResponseWriter, filenameNoQuotes, contentType string, writerTo io.WriterTo) {
+	w.Header().Set("Content-Type", contentType)
 	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", filenameNoQuotes))
 	w.WriteHeader(200)
 	n, err := writerTo.WriteTo(w)
 	if err != nil {
-		logger.Warn("failed while writing search job csv response", log.String("filename", filenameNoQuotes), log.Int64("bytesWritten", n), log.Error(err))
+		logger.Warn("failed while writing search job response", log.String("filename", filenameNoQuotes), log.Int64("bytesWritten", n), log.Error(err))
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 	"github.com/sourcegraph/sourcegraph/schema"
@@ -46,7 +47,7 @@ func TestServeSearchJobDownload(t *testing.T) {
 	svc := service.New(observationCtx, s, mockUploadStore, service.NewSearcherFake())
 
 	router := mux.NewRouter()
-	router.HandleFunc("/{id}.csv", ServeSearchJobDownload(logger, svc))
+	router.HandleFunc("/{id}.{format:csv|jsonl|parquet}", ServeSearchJobDownload(logger, svc))
 
 	// no job
 	{
@@ -67,19 +68,21 @@ func TestServeSearchJobDownload(t *testing.T) {
 		userCtx := actor.WithActor(context.Background(), &actor.Actor{
 			UID: userID,
 		})
-		_, err = svc.CreateSearchJob(userCtx, "1@rev1")
+		_, err = svc.CreateSearchJob(userCtx, "1@rev1", types.ResultFormatCSV)
 		require.NoError(t, err)
 
-		req, err := http.NewRequest(http.MethodGet, "/1.csv", nil)
-		require.NoError(t, err)
+		for _, path := range []string{"/1.csv", "/1.jsonl", "/1.parquet"} {
+			req, err := http.NewRequest(http.MethodGet, path, nil)
+			require.NoError(t, err)
 
-		req = req.WithContext(actor.WithActor(context.Background(), &actor.Actor{UID: userID}))
-		w := httptest.NewRecorder()
-		w.Body = &bytes.Buffer{}
-		router.ServeHTTP(w, req)
+			req = req.WithContext(actor.WithActor(context.Background(), &actor.Actor{UID: userID}))
+			w := httptest.NewRecorder()
+			w.Body = &bytes.Buffer{}
+			router.ServeHTTP(w, req)
 
-		require.Equal(t, http.StatusOK, w.Code)
-		require.Equal(t, "", w.Body.String())
+			require.Equal(t, http.StatusOK, w.Code, path)
+			require.Equal(t, "", w.Body.String(), path)
+		}
 	}
 
 	// wrong user
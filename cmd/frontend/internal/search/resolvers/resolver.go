@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/service"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
+	searchjobtypes "github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -35,7 +36,12 @@ func New(logger log.Logger, db database.DB, svc *service.Service) graphqlbackend
 var _ graphqlbackend.SearchJobsResolver = &Resolver{}
 
 func (r *Resolver) CreateSearchJob(ctx context.Context, args *graphqlbackend.CreateSearchJobArgs) (graphqlbackend.SearchJobResolver, error) {
-	job, err := r.svc.CreateSearchJob(ctx, args.Query)
+	format, err := searchjobtypes.ParseResultFormat(args.ResultFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.svc.CreateSearchJob(ctx, args.Query, format)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +66,19 @@ func (r *Resolver) DeleteSearchJob(ctx context.Context, args *graphqlbackend.Del
 	return &graphqlbackend.EmptyResponse{}, r.svc.DeleteSearchJob(ctx, jobID)
 }
 
+func (r *Resolver) RerunSearchJob(ctx context.Context, args *graphqlbackend.RerunSearchJobArgs) (graphqlbackend.SearchJobResolver, error) {
+	jobID, err := UnmarshalSearchJobID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := r.svc.RerunSearchJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
 func newSearchJobConnectionResolver(ctx context.Context, db database.DB, service *service.Service, args *graphqlbackend.SearchJobsArgs) (*graphqlutil.ConnectionResolver[graphqlbackend.SearchJobResolver], error) {
 	var states []string
 	if args.States != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -70,9 +71,13 @@ func (r *searchJobResolver) FinishedAt(ctx context.Context) *gqlutil.DateTime {
 	return gqlutil.FromTime(r.Job.FinishedAt)
 }
 
+func (r *searchJobResolver) ResultFormat() string {
+	return strings.ToUpper(string(r.Job.ResultFormat))
+}
+
 func (r *searchJobResolver) URL(ctx context.Context) (*string, error) {
 	if r.Job.State == types.JobStateCompleted {
-		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.csv", r.Job.ID))
+		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.%s", r.Job.ID, r.Job.ResultFormat.FileExtension()))
 		if err != nil {
 			return nil, err
 		}
@@ -92,6 +97,28 @@ func (r *searchJobResolver) LogURL(ctx context.Context) (*string, error) {
 	return nil, nil
 }
 
+func (r *searchJobResolver) PreviousSearchJob(ctx context.Context) (graphqlbackend.SearchJobResolver, error) {
+	if r.Job.PreviousJobID == 0 {
+		return nil, nil
+	}
+	job, err := r.svc.GetSearchJob(ctx, r.Job.PreviousJobID)
+	if err != nil {
+		return nil, err
+	}
+	return newSearchJobResolver(r.db, r.svc, job), nil
+}
+
+func (r *searchJobResolver) DiffURL(ctx context.Context) (*string, error) {
+	if r.Job.PreviousJobID != 0 && r.Job.State == types.JobStateCompleted {
+		exportPath, err := url.JoinPath(conf.Get().ExternalURL, fmt.Sprintf("/.api/search/export/%d.diff", r.Job.ID))
+		if err != nil {
+			return nil, err
+		}
+		return pointers.Ptr(exportPath), nil
+	}
+	return nil, nil
+}
+
 func (r *searchJobResolver) RepoStats(ctx context.Context) (graphqlbackend.SearchJobStatsResolver, error) {
 	repoRevStats, err := r.svc.GetAggregateRepoRevState(ctx, r.Job.ID)
 	if err != nil {
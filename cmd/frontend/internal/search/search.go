@@ -298,6 +298,7 @@ func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searche
 		Repository:   string(fm.Repo.Name),
 		RepositoryID: int32(fm.Repo.ID),
 		Commit:       string(fm.CommitID),
+		DuplicateOf:  fromDuplicateOf(fm.DuplicateOf),
 	}
 
 	if r, ok := repoCache[fm.Repo.ID]; ok {
@@ -316,6 +317,22 @@ func fromPathMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Searche
 	return pathEvent
 }
 
+func fromDuplicateOf(f *result.File) *streamhttp.FileLocation {
+	if f == nil {
+		return nil
+	}
+	loc := &streamhttp.FileLocation{
+		Path:         f.Path,
+		RepositoryID: int32(f.Repo.ID),
+		Repository:   string(f.Repo.Name),
+		Commit:       string(f.CommitID),
+	}
+	if f.InputRev != nil {
+		loc.Branches = []string{*f.InputRev}
+	}
+	return loc
+}
+
 func fromChunkMatches(cms result.ChunkMatches) []streamhttp.ChunkMatch {
 	res := make([]streamhttp.ChunkMatch, 0, len(cms))
 	for _, cm := range cms {
@@ -381,6 +398,7 @@ func fromContentMatch(fm *result.FileMatch, repoCache map[api.RepoID]*types.Sear
 		Commit:       string(fm.CommitID),
 		LineMatches:  eventLineMatches,
 		ChunkMatches: eventChunkMatches,
+		Diff:         fm.Diff,
 	}
 
 	if fm.InputRev != nil {
@@ -21,6 +21,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
@@ -36,6 +37,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/hostname"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -46,12 +48,23 @@ type JanitorConfig struct {
 	ReposDir        string
 
 	DesiredPercentFree int
+
+	// ColdStorage, if set, is used to archive repos that have not been
+	// accessed for ColdStorageIdleAfter, and to archive rather than delete
+	// repos when freeing up disk space.
+	ColdStorage          *coldstorage.Store
+	ColdStorageIdleAfter time.Duration
 }
 
-func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, rcf *wrexec.RecordingCommandFactory, cloneRepo cloneRepoFunc, logger log.Logger) goroutine.BackgroundRoutine {
+func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, rcf *wrexec.RecordingCommandFactory, cloneRepo cloneRepoFunc, lockRepo lockRepoFunc, logger log.Logger) goroutine.BackgroundRoutine {
 	return goroutine.NewPeriodicGoroutine(
 		actor.WithInternalActor(ctx),
 		goroutine.HandlerFunc(func(ctx context.Context) error {
+			gitserverAddrs := gitserver.NewGitserverAddresses(conf.Get())
+			isReplica := func(dir common.GitDir) bool {
+				return isReplicaShard(ctx, cfg.ShardID, gitserverfs.RepoNameFromDir(cfg.ReposDir, dir), gitserverAddrs)
+			}
+
 			// On Sourcegraph.com, we clone repos lazily, meaning whatever github.com
 			// repo is visited will be cloned eventually. So over time, we would always
 			// accumulate terabytes of repos, of which many are probably not visited
@@ -67,12 +80,18 @@ func NewJanitor(ctx context.Context, cfg JanitorConfig, db database.DB, rcf *wre
 				toFree, err := howManyBytesToFree(logger, cfg.ReposDir, diskSizer, cfg.DesiredPercentFree)
 				if err != nil {
 					logger.Error("ensuring free disk space", log.Error(err))
-				} else if err := freeUpSpace(ctx, logger, db, cfg.ShardID, cfg.ReposDir, diskSizer, cfg.DesiredPercentFree, toFree); err != nil {
+				} else if err := freeUpSpace(ctx, logger, db, cfg.ColdStorage, lockRepo, cfg.ShardID, cfg.ReposDir, isReplica, diskSizer, cfg.DesiredPercentFree, toFree); err != nil {
 					logger.Error("error freeing up space", log.Error(err))
 				}
 			}
 
-			gitserverAddrs := gitserver.NewGitserverAddresses(conf.Get())
+			if cfg.ColdStorage != nil {
+				logger := logger.Scoped("cold-storage")
+				if err := archiveIdleRepos(ctx, logger, db, cfg.ColdStorage, lockRepo, cfg.ShardID, cfg.ReposDir, isReplica, cfg.ColdStorageIdleAfter); err != nil {
+					logger.Error("error archiving idle repos", log.Error(err))
+				}
+			}
+
 			// TODO: Should this return an error?
 			cleanupRepos(ctx, logger, db, rcf, cfg.ShardID, cfg.ReposDir, cloneRepo, gitserverAddrs)
 
@@ -194,6 +213,10 @@ var (
 		Name: "src_gitserver_repos_removed_disk_pressure",
 		Help: "number of repos removed due to not enough disk space",
 	})
+	reposArchived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_repos_archived",
+		Help: "number of repos moved to cold storage",
+	}, []string{"reason"})
 	janitorRunning = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "src_gitserver_janitor_running",
 		Help: "set to 1 when the gitserver janitor background job is running",
@@ -206,6 +229,11 @@ var (
 		Name: "src_gitserver_maintenance_status",
 		Help: "whether the maintenance run was a success (true/false) and the reason why a cleanup was needed",
 	}, []string{"success", "reason"})
+	maintenanceDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "src_gitserver_maintenance_duration_seconds",
+		Help:    "Duration of the git commands of a maintenance run by strategy and whether it was a success (true/false)",
+		Buckets: []float64{0.1, 1, 10, 60, 300, 1800, 3600},
+	}, []string{"strategy", "success"})
 	pruneStatus = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "src_gitserver_prune_status",
 		Help: "whether git prune was a success (true/false) and whether it was skipped (true/false)",
@@ -223,6 +251,10 @@ var (
 
 type cloneRepoFunc func(ctx context.Context, repo api.RepoName, opts CloneOptions) (cloneProgress string, err error)
 
+// lockRepoFunc locks repo so that it is neither cloned nor fetched while it is
+// removed from disk. If another operation is already in progress, ok is false.
+type lockRepoFunc func(repo api.RepoName, status string) (unlock func(), ok bool)
+
 // cleanupRepos walks the repos directory and performs maintenance tasks:
 //
 // 1. Compute the amount of space used by the repo
@@ -267,6 +299,12 @@ func cleanupRepos(
 		logger.Warn("current shard is not included in the list of known gitserver shards, will not delete repos", log.String("current-hostname", shardID), log.Strings("all-shards", gitServerAddrs.Addresses))
 	}
 
+	// Replicas are synced from their primary by the replica syncer. The primary
+	// owns the state of the repo in the database, so we must not touch it.
+	isReplica := func(dir common.GitDir) bool {
+		return isReplicaShard(ctx, shardID, gitserverfs.RepoNameFromDir(reposDir, dir), gitServerAddrs)
+	}
+
 	repoToSize := make(map[api.RepoName]int64)
 	var wrongShardRepoCount int64
 	var wrongShardRepoSize int64
@@ -285,6 +323,10 @@ func cleanupRepos(
 	}()
 
 	collectSizeAndMaybeDeleteWrongShardRepos := func(dir common.GitDir) (done bool, err error) {
+		if isReplica(dir) {
+			return false, nil
+		}
+
 		size := gitserverfs.DirSize(dir.Path("."))
 		name := gitserverfs.RepoNameFromDir(reposDir, dir)
 		repoToSize[name] = size
@@ -320,6 +362,16 @@ func cleanupRepos(
 			return false, err
 		}
 
+		if isReplica(dir) {
+			// The replica syncer will clone it again from the primary.
+			logger.Info("removing corrupt replica", log.String("repo", string(dir)), log.String("reason", reason))
+			if err := gitserverfs.RemoveRepoDirectory(ctx, logger, db, shardID, reposDir, dir, false); err != nil {
+				return true, err
+			}
+			reposRemoved.WithLabelValues(reason).Inc()
+			return true, nil
+		}
+
 		repoName := gitserverfs.RepoNameFromDir(reposDir, dir)
 		err = db.GitserverRepos().LogCorruption(ctx, repoName, fmt.Sprintf("sourcegraph detected corrupt repo: %s", reason), shardID)
 		if err != nil {
@@ -368,6 +420,11 @@ func cleanupRepos(
 	}
 
 	maybeReclone := func(dir common.GitDir) (done bool, err error) {
+		if isReplica(dir) {
+			// Replicas can't be cloned from the code host.
+			return false, nil
+		}
+
 		repoType, err := git.GetRepositoryType(rcf, reposDir, dir)
 		if err != nil {
 			return false, err
@@ -407,10 +464,11 @@ func cleanupRepos(
 			}
 		}
 
-		// We believe converting a Perforce depot to a Git repository is generally a
-		// very expensive operation, therefore we do not try to re-clone/redo the
-		// conversion only because it is old or slow to do "git gc".
-		if repoType == "perforce" && reason != maybeCorrupt {
+		// We believe converting a Perforce depot, Subversion or Mercurial
+		// repository to a Git repository is generally a very expensive operation,
+		// therefore we do not try to re-clone/redo the conversion only because it
+		// is old or slow to do "git gc".
+		if (repoType == "perforce" || repoType == "subversion" || repoType == "mercurial") && reason != maybeCorrupt {
 			reason = ""
 		}
 
@@ -501,7 +559,28 @@ func cleanupRepos(
 	}
 
 	performSGMaintenance := func(dir common.GitDir) (done bool, err error) {
-		return false, sgMaintenance(logger, dir)
+		name := gitserverfs.RepoNameFromDir(reposDir, dir)
+		strategy := maintenanceStrategyForRepo(ctx, logger, db, name)
+
+		start := time.Now()
+		ran, reason, err := runMaintenance(logger, dir, strategy)
+		if !ran {
+			return false, err
+		}
+
+		// Record the run, so that admins can see what maintenance costs for the
+		// repository.
+		logErr := db.GitserverRepos().LogMaintenance(ctx, name, types.RepoMaintenanceLog{
+			Timestamp: start,
+			Strategy:  string(strategy),
+			Reason:    reason,
+			Duration:  time.Since(start),
+			Success:   err == nil,
+		})
+		if logErr != nil {
+			logger.Warn("failed to record maintenance run", log.String("repo", string(name)), log.Error(logErr))
+		}
+		return false, err
 	}
 
 	performGitPrune := func(reposDir string, dir common.GitDir) (done bool, err error) {
@@ -677,8 +756,11 @@ func (s *StatDiskSizer) DiskSizeBytes(mountPoint string) (uint64, error) {
 }
 
 // freeUpSpace removes git directories under ReposDir, in order from least
-// recently to most recently used, until it has freed howManyBytesToFree.
-func freeUpSpace(ctx context.Context, logger log.Logger, db database.DB, shardID string, reposDir string, diskSizer DiskSizer, desiredPercentFree int, howManyBytesToFree int64) error {
+// recently to most recently used, until it has freed howManyBytesToFree. If
+// cold is set, repos are archived to cold storage before they are removed.
+// Replicas, as reported by isReplica if set, are removed without touching
+// their state in the database.
+func freeUpSpace(ctx context.Context, logger log.Logger, db database.DB, cold *coldstorage.Store, lockRepo lockRepoFunc, shardID string, reposDir string, isReplica func(common.GitDir) bool, diskSizer DiskSizer, desiredPercentFree int, howManyBytesToFree int64) error {
 	if howManyBytesToFree <= 0 {
 		return nil
 	}
@@ -692,9 +774,9 @@ func freeUpSpace(ctx context.Context, logger log.Logger, db database.DB, shardID
 	}
 	dirModTimes := make(map[common.GitDir]time.Time, len(gitDirs))
 	for _, d := range gitDirs {
-		mt, err := gitDirModTime(d)
+		mt, err := coldstorage.LastAccessed(d)
 		if err != nil {
-			return errors.Wrap(err, "computing mod time of git dir")
+			return errors.Wrap(err, "computing last access time of git dir")
 		}
 		dirModTimes[d] = mt
 	}
@@ -723,7 +805,32 @@ func freeUpSpace(ctx context.Context, logger log.Logger, db database.DB, shardID
 		}
 
 		delta := gitserverfs.DirSize(d.Path("."))
-		if err := gitserverfs.RemoveRepoDirectory(ctx, logger, db, shardID, reposDir, d, true); err != nil {
+		replica := isReplica != nil && isReplica(d)
+		archived := false
+		if cold != nil && !replica {
+			unlock, ok := lockRepo(gitserverfs.RepoNameFromDir(reposDir, d), "archiving")
+			if !ok {
+				// The repo is being cloned or fetched, so it is not least
+				// recently used anymore.
+				continue
+			}
+			if err := archiveRepo(ctx, db, cold, shardID, reposDir, d); err != nil {
+				// Fall back to removing the repo, freeing up space takes
+				// precedence over avoiding a reclone. Repos without refs are
+				// cheap to clone again, so they are removed silently.
+				if !errors.Is(err, coldstorage.ErrNoRefs) {
+					logger.Warn("failed to archive repo to cold storage", log.String("repo", string(d)), log.Error(err))
+				}
+			} else {
+				archived = true
+				reposArchived.WithLabelValues("disk_pressure").Inc()
+			}
+			err := gitserverfs.RemoveRepoDirectory(ctx, logger, db, shardID, reposDir, d, !archived)
+			unlock()
+			if err != nil {
+				return errors.Wrap(err, "removing repo directory")
+			}
+		} else if err := gitserverfs.RemoveRepoDirectory(ctx, logger, db, shardID, reposDir, d, !replica); err != nil {
 			return errors.Wrap(err, "removing repo directory")
 		}
 		spaceFreed += delta
@@ -753,12 +860,87 @@ func freeUpSpace(ctx context.Context, logger log.Logger, db database.DB, shardID
 	return nil
 }
 
-func gitDirModTime(d common.GitDir) (time.Time, error) {
-	head, err := os.Stat(d.Path("HEAD"))
+// archiveIdleRepos moves all repos under reposDir that have not been accessed
+// for idleAfter to cold storage. Replicas, as reported by isReplica if set, are
+// left alone.
+func archiveIdleRepos(ctx context.Context, logger log.Logger, db database.DB, cold *coldstorage.Store, lockRepo lockRepoFunc, shardID string, reposDir string, isReplica func(common.GitDir) bool, idleAfter time.Duration) error {
+	gitDirs, err := findGitDirs(reposDir)
 	if err != nil {
-		return time.Time{}, errors.Wrap(err, "getting repository modification time")
+		return errors.Wrap(err, "finding git dirs")
 	}
-	return head.ModTime(), nil
+
+	for _, d := range gitDirs {
+		// Fast-exit if the context has been canceled.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		if isReplica != nil && isReplica(d) {
+			continue
+		}
+
+		lastAccess, err := coldstorage.LastAccessed(d)
+		if err != nil {
+			logger.Warn("failed to get last access time", log.String("repo", string(d)), log.Error(err))
+			continue
+		}
+		if time.Since(lastAccess) < idleAfter {
+			continue
+		}
+
+		archived, err := archiveIdleRepo(ctx, logger, db, cold, lockRepo, shardID, reposDir, d)
+		if err != nil {
+			return err
+		}
+		if !archived {
+			continue
+		}
+		reposArchived.WithLabelValues("idle").Inc()
+
+		logger.Info("archived idle repo", log.String("repo", string(d)), log.Duration("idle for", time.Since(lastAccess)))
+	}
+
+	return nil
+}
+
+// archiveIdleRepo archives the repo in dir and removes it from disk while
+// holding its lock. It returns false if the repo is busy or could not be
+// archived, in which case it is left on disk.
+func archiveIdleRepo(ctx context.Context, logger log.Logger, db database.DB, cold *coldstorage.Store, lockRepo lockRepoFunc, shardID string, reposDir string, dir common.GitDir) (bool, error) {
+	unlock, ok := lockRepo(gitserverfs.RepoNameFromDir(reposDir, dir), "archiving")
+	if !ok {
+		// A clone or fetch is running, so the repo is not idle anymore.
+		return false, nil
+	}
+	defer unlock()
+
+	if err := archiveRepo(ctx, db, cold, shardID, reposDir, dir); err != nil {
+		if !errors.Is(err, coldstorage.ErrNoRefs) {
+			logger.Warn("failed to archive repo to cold storage", log.String("repo", string(dir)), log.Error(err))
+		}
+		return false, nil
+	}
+	if err := gitserverfs.RemoveRepoDirectory(ctx, logger, db, shardID, reposDir, dir, false); err != nil {
+		return false, errors.Wrap(err, "removing repo directory")
+	}
+	return true, nil
+}
+
+// archiveRepo uploads the repo in dir to cold storage and marks it as archived
+// in the database. The caller is responsible for holding the repo's lock and
+// for removing it from disk.
+func archiveRepo(ctx context.Context, db database.DB, cold *coldstorage.Store, shardID string, reposDir string, dir common.GitDir) error {
+	repo := gitserverfs.RepoNameFromDir(reposDir, dir)
+	gr, err := db.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		return errors.Wrap(err, "looking up repo")
+	}
+	if _, err := cold.Archive(ctx, gr.RepoID, dir); err != nil {
+		return err
+	}
+	return db.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusArchived, shardID)
 }
 
 // iterateGitDirs walks over the reposDir on disk and calls walkFn for each of the
@@ -925,30 +1107,37 @@ func bestEffortParseFailed(b []byte) int {
 // concurrently with git gc. sgMaintenance will check the state of the repository
 // to avoid running the cleanup tasks if possible. If a sgmLog file is present in
 // dir, sgMaintenance will not run unless the file is old.
-func sgMaintenance(logger log.Logger, dir common.GitDir) (err error) {
+func sgMaintenance(logger log.Logger, dir common.GitDir) error {
+	_, _, err := runMaintenance(logger, dir, maintenanceStrategyFull)
+	return err
+}
+
+// runMaintenance is like sgMaintenance, but runs the given strategy. ran is
+// true if the strategy was run, and reason tells why it was needed.
+func runMaintenance(logger log.Logger, dir common.GitDir, strategy maintenanceStrategy) (ran bool, reason string, err error) {
 	// Don't run if sgmLog file is younger than sgmLogExpire hours. There is no need
 	// to report an error, because the error has already been logged in a previous
 	// run.
 	if fi, err := os.Stat(dir.Path(sgmLog)); err == nil {
 		if fi.ModTime().After(time.Now().Add(-sgmLogExpire)) {
-			return nil
+			return false, "", nil
 		}
 	}
-	needed, reason, err := needsMaintenance(dir)
+	needed, reason, err := strategy.needed(dir)
 	defer func() {
 		maintenanceStatus.WithLabelValues(strconv.FormatBool(err == nil), reason).Inc()
 	}()
 	if err != nil {
-		return err
+		return false, reason, err
 	}
 	if !needed {
-		return nil
+		return false, reason, nil
 	}
 
 	cmd := exec.Command("sh")
 	dir.Set(cmd)
 
-	cmd.Stdin = strings.NewReader(sgMaintenanceScript)
+	cmd.Stdin = strings.NewReader(strategy.script(dir))
 
 	err, unlock := lockRepoForGC(dir)
 	if err != nil {
@@ -957,21 +1146,23 @@ func sgMaintenance(logger log.Logger, dir common.GitDir) (err error) {
 			log.String("dir", string(dir)),
 			log.Error(err),
 		)
-		return nil
+		return false, reason, nil
 	}
 	defer unlock()
 
+	start := time.Now()
 	b, err := cmd.CombinedOutput()
+	maintenanceDuration.WithLabelValues(string(strategy), strconv.FormatBool(err == nil)).Observe(time.Since(start).Seconds())
 	if err != nil {
 		if err := writeSGMLog(dir, b); err != nil {
 			logger.Debug("sg maintenance failed to write log file", log.String("file", dir.Path(sgmLog)), log.Error(err))
 		}
-		logger.Debug("sg maintenance", log.String("dir", string(dir)), log.String("out", string(b)))
-		return errors.Wrapf(executil.WrapCmdError(cmd, err), "failed to run sg maintenance")
+		logger.Debug("sg maintenance", log.String("dir", string(dir)), log.String("strategy", string(strategy)), log.String("out", string(b)))
+		return true, reason, errors.Wrapf(executil.WrapCmdError(cmd, err), "failed to run sg maintenance")
 	}
 	// Remove the log file after a successful run.
 	_ = os.Remove(dir.Path(sgmLog))
-	return nil
+	return true, reason, nil
 }
 
 const gcLockFile = "gc.pid"
@@ -1040,13 +1231,17 @@ func pruneIfNeeded(rcf *wrexec.RecordingCommandFactory, reposDir string, dir com
 
 func needsMaintenance(dir common.GitDir) (bool, string, error) {
 	// Bitmaps store reachability information about the set of objects in a
-	// packfile which speeds up clone and fetch operations.
-	hasBm, err := hasBitmap(dir)
-	if err != nil {
-		return false, "", err
-	}
-	if !hasBm {
-		return true, "bitmap", nil
+	// packfile which speeds up clone and fetch operations. Git never writes
+	// bitmaps for the promisor packs of partial clones, so we don't expect
+	// one there.
+	if !git.IsPartialClone(dir) {
+		hasBm, err := hasBitmap(dir)
+		if err != nil {
+			return false, "", err
+		}
+		if !hasBm {
+			return true, "bitmap", nil
+		}
 	}
 
 	// The commit-graph file is a supplemental data structure that accelerates
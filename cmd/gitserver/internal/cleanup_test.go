@@ -19,6 +19,7 @@ import (
 
 	"github.com/sourcegraph/log/logtest"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
@@ -29,6 +30,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -828,12 +830,12 @@ func isEmptyDir(path string) (bool, error) {
 func TestFreeUpSpace(t *testing.T) {
 	logger := logtest.Scoped(t)
 	t.Run("no error if no space requested and no repos", func(t *testing.T) {
-		if err := freeUpSpace(context.Background(), logger, newMockedGitserverDB(), "test-gitserver", t.TempDir(), &fakeDiskSizer{}, 10, 0); err != nil {
+		if err := freeUpSpace(context.Background(), logger, newMockedGitserverDB(), nil, nil, "test-gitserver", t.TempDir(), nil, &fakeDiskSizer{}, 10, 0); err != nil {
 			t.Fatal(err)
 		}
 	})
 	t.Run("error if space requested and no repos", func(t *testing.T) {
-		if err := freeUpSpace(context.Background(), logger, newMockedGitserverDB(), "test-gitserver", t.TempDir(), &fakeDiskSizer{}, 10, 1); err == nil {
+		if err := freeUpSpace(context.Background(), logger, newMockedGitserverDB(), nil, nil, "test-gitserver", t.TempDir(), nil, &fakeDiskSizer{}, 10, 1); err == nil {
 			t.Fatal("want error")
 		}
 	})
@@ -863,7 +865,7 @@ func TestFreeUpSpace(t *testing.T) {
 		gr := dbmocks.NewMockGitserverRepoStore()
 		db.GitserverReposFunc.SetDefaultReturn(gr)
 		// Run.
-		if err := freeUpSpace(context.Background(), logger, db, "test-gitserver", rd, &fakeDiskSizer{}, 10, 1000); err != nil {
+		if err := freeUpSpace(context.Background(), logger, db, nil, nil, "test-gitserver", rd, nil, &fakeDiskSizer{}, 10, 1000); err != nil {
 			t.Fatal(err)
 		}
 
@@ -885,6 +887,104 @@ func TestFreeUpSpace(t *testing.T) {
 	})
 }
 
+func TestArchiveIdleRepos(t *testing.T) {
+	logger := logtest.Scoped(t)
+	rd := t.TempDir()
+
+	for _, name := range []string{"idle", "active", "busy"} {
+		for _, args := range [][]string{
+			{"init", "--quiet", filepath.Join(rd, name)},
+			{"-C", filepath.Join(rd, name), "-c", "user.name=a", "-c", "user.email=a@a.com", "commit", "--quiet", "--allow-empty", "-m", "initial"},
+		} {
+			if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+				t.Fatalf("git %v failed: %s: %s", args, err, out)
+			}
+		}
+	}
+	// Empty repos can't be bundled, so they are left alone.
+	if out, err := exec.Command("git", "init", "--quiet", filepath.Join(rd, "empty")).CombinedOutput(); err != nil {
+		t.Fatalf("git init failed: %s: %s", err, out)
+	}
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	for _, name := range []string{"idle", "busy", "empty"} {
+		require.NoError(t, os.Chtimes(filepath.Join(rd, name, ".git", "HEAD"), old, old))
+	}
+	coldstorage.MarkAccessed(common.GitDir(filepath.Join(rd, "active", ".git")))
+
+	uploads := uploadstoremocks.NewMockStore()
+	uploads.UploadFunc.SetDefaultHook(func(_ context.Context, _ string, r io.Reader) (int64, error) {
+		return io.Copy(io.Discard, r)
+	})
+
+	db := dbmocks.NewMockDB()
+	gr := dbmocks.NewMockGitserverRepoStore()
+	gr.GetByNameFunc.SetDefaultReturn(&types.GitserverRepo{RepoID: 42}, nil)
+	db.GitserverReposFunc.SetDefaultReturn(gr)
+
+	// A fetch of busy is in progress, so it must not be archived.
+	locker := NewRepositoryLocker()
+	lockRepo := func(repo api.RepoName, status string) (func(), bool) {
+		lock, ok := locker.TryAcquire(gitserverfs.RepoDirFromName(rd, repo), status)
+		if !ok {
+			return nil, false
+		}
+		return lock.Release, true
+	}
+	busy, ok := lockRepo("busy", "fetching")
+	require.True(t, ok)
+	defer busy()
+
+	cold := coldstorage.NewWithUploadStore(uploads, rd)
+	if err := archiveIdleRepos(context.Background(), logger, db, cold, lockRepo, "test-gitserver", rd, nil, 30*24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	require.NoDirExists(t, filepath.Join(rd, "idle"))
+	require.DirExists(t, filepath.Join(rd, "active", ".git"))
+	require.DirExists(t, filepath.Join(rd, "busy", ".git"))
+	require.DirExists(t, filepath.Join(rd, "empty", ".git"))
+
+	// The lock is released once the repo is archived.
+	unlock, ok := lockRepo("idle", "cloning")
+	require.True(t, ok)
+	unlock()
+
+	require.Len(t, uploads.UploadFunc.History(), 1)
+	// Bundles are keyed by repo ID, so that renames don't orphan them.
+	require.Equal(t, "repos/42.bundle", uploads.UploadFunc.History()[0].Arg1)
+
+	require.Len(t, gr.SetCloneStatusFunc.History(), 1)
+	require.Equal(t, api.RepoName("idle"), gr.SetCloneStatusFunc.History()[0].Arg1)
+	require.Equal(t, types.CloneStatusArchived, gr.SetCloneStatusFunc.History()[0].Arg2)
+}
+
+func TestDeleteRepo_ColdStorage(t *testing.T) {
+	logger := logtest.Scoped(t)
+	uploads := uploadstoremocks.NewMockStore()
+	cold := coldstorage.NewWithUploadStore(uploads, t.TempDir())
+
+	db := dbmocks.NewMockDB()
+	gr := dbmocks.NewMockGitserverRepoStore()
+	gr.GetByNameFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName) (*types.GitserverRepo, error) {
+		switch repo {
+		case "archived":
+			return &types.GitserverRepo{RepoID: 1, CloneStatus: types.CloneStatusArchived}, nil
+		case "cloned":
+			return &types.GitserverRepo{RepoID: 2, CloneStatus: types.CloneStatusCloned}, nil
+		}
+		return nil, &database.ErrGitserverRepoNotFound{}
+	})
+	db.GitserverReposFunc.SetDefaultReturn(gr)
+
+	for _, repo := range []api.RepoName{"archived", "cloned", "missing"} {
+		require.NoError(t, deleteRepo(context.Background(), logger, db, cold, "test-gitserver", t.TempDir(), repo))
+	}
+
+	// Only the bundle of the archived repo is deleted.
+	require.Len(t, uploads.DeleteFunc.History(), 1)
+	require.Equal(t, "repos/1.bundle", uploads.DeleteFunc.History()[0].Arg1)
+}
+
 func makeFakeRepo(d string, sizeBytes int) error {
 	gd := filepath.Join(d, ".git")
 	if err := os.MkdirAll(gd, 0o700); err != nil {
@@ -1174,6 +1274,37 @@ git commit-graph write --reachable --changed-paths
 	}
 }
 
+func TestNeedsMaintenance_PartialClone(t *testing.T) {
+	dir := t.TempDir()
+	gitDir := prepareEmptyGitRepo(t, dir)
+
+	// Promisor packs never get a bitmap, so a partial clone with a
+	// commit-graph shouldn't need maintenance.
+	script := `echo acont > afile
+git add afile
+git commit -am amsg
+git repack -d -l -A
+for p in .git/objects/pack/*.pack; do touch "${p%.pack}.promisor"; done
+git commit-graph write --reachable --changed-paths
+`
+	cmd := exec.Command("/bin/sh", "-euxc", script)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("out=%s, err=%s", out, err)
+	}
+
+	needed, reason, err := needsMaintenance(gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason != "skipped" {
+		t.Fatalf("want %s, got %s", "skipped", reason)
+	}
+	if needed {
+		t.Fatal("this repo doesn't need maintenance")
+	}
+}
+
 func TestPruneIfNeeded(t *testing.T) {
 	reposDir := t.TempDir()
 	gitDir := prepareEmptyGitRepo(t, reposDir)
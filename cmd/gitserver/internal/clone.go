@@ -5,6 +5,7 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -20,6 +21,7 @@ import (
 func (s *Server) maybeStartClone(ctx context.Context, logger log.Logger, repo api.RepoName) (notFound *protocol.NotFoundPayload, cloned bool) {
 	dir := gitserverfs.RepoDirFromName(s.ReposDir, repo)
 	if repoCloned(dir) {
+		coldstorage.MarkAccessed(dir)
 		return nil, true
 	}
 
@@ -28,6 +30,11 @@ func (s *Server) maybeStartClone(ctx context.Context, logger log.Logger, repo ap
 		return &protocol.NotFoundPayload{}, false
 	}
 
+	if s.isReplica(ctx, repo) {
+		logger.Debug("not cloning on demand as this is a replica of the repo")
+		return &protocol.NotFoundPayload{}, false
+	}
+
 	cloneProgress, cloneInProgress := s.Locker.Status(dir)
 	if cloneInProgress {
 		return &protocol.NotFoundPayload{
@@ -0,0 +1,210 @@
+// Package coldstorage implements a storage tier for rarely accessed gitserver
+// repositories. Idle repositories are packed into a single git bundle, uploaded
+// to an upload store and removed from local disk. When they are requested
+// again, they are rehydrated from the bundle and brought up to date with an
+// incremental fetch instead of a full clone from the code host.
+package coldstorage
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrNoRefs is returned by Archive for repositories without any refs, which
+// git can't bundle. They are cheap to clone again and don't need archiving.
+var ErrNoRefs = errors.New("repository has no refs")
+
+// Store moves repositories between local disk and bundles in an upload store.
+type Store struct {
+	uploads  uploadstore.Store
+	reposDir string
+}
+
+// New returns a cold storage Store backed by the upload store described by
+// conf.
+func New(ctx context.Context, observationCtx *observation.Context, conf *Config, reposDir string) (*Store, error) {
+	c := uploadstore.Config{
+		Backend:      conf.Backend,
+		ManageBucket: conf.ManageBucket,
+		Bucket:       conf.Bucket,
+		S3: uploadstore.S3Config{
+			Region:          conf.S3Region,
+			Endpoint:        conf.S3Endpoint,
+			UsePathStyle:    conf.S3UsePathStyle,
+			AccessKeyID:     conf.S3AccessKeyID,
+			SecretAccessKey: conf.S3SecretAccessKey,
+			SessionToken:    conf.S3SessionToken,
+		},
+		GCS: uploadstore.GCSConfig{
+			ProjectID:               conf.GCSProjectID,
+			CredentialsFile:         conf.GCSCredentialsFile,
+			CredentialsFileContents: conf.GCSCredentialsFileContents,
+		},
+	}
+	uploads, err := uploadstore.CreateLazy(ctx, c, uploadstore.NewOperations(observationCtx, "gitserver", "coldstorage"))
+	if err != nil {
+		return nil, err
+	}
+	return NewWithUploadStore(uploads, reposDir), nil
+}
+
+// NewWithUploadStore returns a cold storage Store that writes bundles to the
+// given upload store.
+func NewWithUploadStore(uploads uploadstore.Store, reposDir string) *Store {
+	return &Store{uploads: uploads, reposDir: reposDir}
+}
+
+// Archive packs all refs of the repository in dir into a single bundle and
+// uploads it. It returns the size of the uploaded bundle. The repository on
+// disk is left untouched; it is the responsibility of the caller to remove it.
+// Bundles are keyed by repo ID, so that they survive renames of the repo.
+func (s *Store) Archive(ctx context.Context, repo api.RepoID, dir common.GitDir) (int64, error) {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--count=1", "--format=%(refname)")
+	dir.Set(cmd)
+	refs, err := cmd.Output()
+	if err != nil {
+		return 0, errors.Wrap(err, "listing refs")
+	}
+	if len(refs) == 0 {
+		return 0, ErrNoRefs
+	}
+
+	tmpDir, err := gitserverfs.TempDir(s.reposDir, "cold-storage-")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bundlePath := filepath.Join(tmpDir, "repo.bundle")
+	cmd = exec.CommandContext(ctx, "git", "bundle", "create", bundlePath, "--all")
+	dir.Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return 0, errors.Wrapf(err, "failed to create bundle: %s", string(out))
+	}
+
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	n, err := s.uploads.Upload(ctx, bundleKey(repo), f)
+	if err != nil {
+		return 0, errors.Wrap(err, "uploading bundle")
+	}
+	return n, nil
+}
+
+// Restore creates a bare repository at tmpPath from the archived bundle of
+// repo. The restored repository contains all refs as of the time it was
+// archived, so callers are expected to follow up with a fetch from the code
+// host.
+func (s *Store) Restore(ctx context.Context, repo api.RepoID, tmpPath string) error {
+	tmpDir, err := gitserverfs.TempDir(s.reposDir, "cold-storage-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	bundlePath := filepath.Join(tmpDir, "repo.bundle")
+	if err := s.download(ctx, repo, bundlePath); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return err
+	}
+	if err := git.MakeBareRepo(ctx, tmpPath); err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--quiet", bundlePath, "+refs/*:refs/*")
+	common.GitDir(tmpPath).Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to fetch from bundle: %s", string(out))
+	}
+	return nil
+}
+
+// Delete removes the archived bundle of repo, if any.
+func (s *Store) Delete(ctx context.Context, repo api.RepoID) error {
+	return s.uploads.Delete(ctx, bundleKey(repo))
+}
+
+func (s *Store) download(ctx context.Context, repo api.RepoID, path string) error {
+	rc, err := s.uploads.Get(ctx, bundleKey(repo))
+	if err != nil {
+		return errors.Wrap(err, "downloading bundle")
+	}
+	defer rc.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, rc); err != nil {
+		return errors.Wrap(err, "downloading bundle")
+	}
+	return f.Close()
+}
+
+func bundleKey(repo api.RepoID) string {
+	return "repos/" + strconv.Itoa(int(repo)) + ".bundle"
+}
+
+// lastAccessFile is the name of the file in the git directory whose
+// modification time records when the repository was last accessed.
+const lastAccessFile = "sg_last_access"
+
+// lastAccessResolution bounds how often the last access marker is rewritten, so
+// that busy repositories do not cause a write on every request.
+const lastAccessResolution = time.Hour
+
+// MarkAccessed records that the repository in dir was accessed now. It is
+// best-effort and cheap to call on hot paths.
+func MarkAccessed(dir common.GitDir) {
+	path := dir.Path(lastAccessFile)
+	now := time.Now()
+	if fi, err := os.Stat(path); err == nil {
+		if now.Sub(fi.ModTime()) < lastAccessResolution {
+			return
+		}
+		_ = os.Chtimes(path, now, now)
+		return
+	} else if !os.IsNotExist(err) {
+		return
+	}
+	_ = os.WriteFile(path, nil, 0o600)
+}
+
+// LastAccessed returns when the repository in dir was last accessed. Repos
+// that have never been marked fall back to the modification time of HEAD.
+func LastAccessed(dir common.GitDir) (time.Time, error) {
+	fi, err := os.Stat(dir.Path(lastAccessFile))
+	if err == nil {
+		return fi.ModTime(), nil
+	}
+	if !os.IsNotExist(err) {
+		return time.Time{}, err
+	}
+	head, err := os.Stat(dir.Path("HEAD"))
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "getting repository modification time")
+	}
+	return head.ModTime(), nil
+}
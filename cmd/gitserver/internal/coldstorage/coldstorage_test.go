@@ -0,0 +1,126 @@
+package coldstorage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestArchiveRestore(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+
+	work := filepath.Join(t.TempDir(), "work")
+	runGit(t, "", "init", "--quiet", "--initial-branch=main", work)
+	runGit(t, work, "commit", "--quiet", "--allow-empty", "-m", "initial")
+	runGit(t, work, "tag", "v1")
+	want := runGit(t, work, "show-ref")
+
+	blobs := map[string][]byte{}
+	uploads := mocks.NewMockStore()
+	uploads.UploadFunc.SetDefaultHook(func(_ context.Context, key string, r io.Reader) (int64, error) {
+		b, err := io.ReadAll(r)
+		blobs[key] = b
+		return int64(len(b)), err
+	})
+	uploads.GetFunc.SetDefaultHook(func(_ context.Context, key string) (io.ReadCloser, error) {
+		b, ok := blobs[key]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return io.NopCloser(bytes.NewReader(b)), nil
+	})
+	uploads.DeleteFunc.SetDefaultHook(func(_ context.Context, key string) error {
+		delete(blobs, key)
+		return nil
+	})
+
+	s := NewWithUploadStore(uploads, reposDir)
+
+	n, err := s.Archive(ctx, 1, common.GitDir(filepath.Join(work, ".git")))
+	require.NoError(t, err)
+	require.Greater(t, n, int64(0))
+	require.Contains(t, blobs, "repos/1.bundle")
+
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+	require.NoError(t, s.Restore(ctx, 1, tmpPath))
+	require.Equal(t, want, runGit(t, tmpPath, "show-ref"))
+
+	require.NoError(t, s.Delete(ctx, 1))
+	require.Empty(t, blobs)
+
+	require.Error(t, s.Restore(ctx, 1, filepath.Join(t.TempDir(), ".git")))
+}
+
+func TestArchive_NoRefs(t *testing.T) {
+	work := filepath.Join(t.TempDir(), "work")
+	runGit(t, "", "init", "--quiet", work)
+
+	uploads := mocks.NewMockStore()
+	s := NewWithUploadStore(uploads, t.TempDir())
+
+	_, err := s.Archive(context.Background(), 2, common.GitDir(filepath.Join(work, ".git")))
+	require.ErrorIs(t, err, ErrNoRefs)
+	require.Empty(t, uploads.UploadFunc.History())
+}
+
+func TestLastAccessed(t *testing.T) {
+	dir := common.GitDir(t.TempDir())
+	require.NoError(t, os.WriteFile(dir.Path("HEAD"), []byte("ref: refs/heads/main"), 0o600))
+
+	headTime := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(dir.Path("HEAD"), headTime, headTime))
+
+	// Without a marker we fall back to HEAD.
+	got, err := LastAccessed(dir)
+	require.NoError(t, err)
+	require.Equal(t, headTime, got.Truncate(time.Second))
+
+	MarkAccessed(dir)
+	got, err = LastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), got, time.Minute)
+
+	// Recent markers are not rewritten.
+	recent := time.Now().Add(-time.Minute).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(dir.Path(lastAccessFile), recent, recent))
+	MarkAccessed(dir)
+	got, err = LastAccessed(dir)
+	require.NoError(t, err)
+	require.Equal(t, recent, got.Truncate(time.Second))
+
+	// Stale markers are.
+	stale := time.Now().Add(-2 * lastAccessResolution)
+	require.NoError(t, os.Chtimes(dir.Path(lastAccessFile), stale, stale))
+	MarkAccessed(dir)
+	got, err = LastAccessed(dir)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), got, time.Minute)
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=a",
+		"GIT_AUTHOR_EMAIL=a@a.com",
+		"GIT_COMMITTER_NAME=a",
+		"GIT_COMMITTER_EMAIL=a@a.com",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
@@ -52,6 +52,11 @@ func (s *Server) ensureRevision(ctx context.Context, repo api.RepoName, rev stri
 		ensureRevisionCounter.WithLabelValues("exists").Inc()
 		return false
 	}
+	if s.isReplica(ctx, repo) {
+		// Replicas only get new revisions once the primary has fetched them.
+		ensureRevisionCounter.WithLabelValues("replica").Inc()
+		return false
+	}
 	// Revision not found, update before returning.
 	err := s.doRepoUpdate(ctx, repo, rev)
 	if err != nil {
@@ -239,24 +239,7 @@ func configureRemoteGitCommand(cmd *exec.Cmd, tlsConf *tlsConfig) {
 		panic(fmt.Sprintf("Only git or p4-fusion commands are supported, got %q", executable))
 	}
 
-	cmd.Env = append(cmd.Env, "GIT_ASKPASS=true") // disable password prompt
-
-	// Suppress asking to add SSH host key to known_hosts (which will hang because
-	// the command is non-interactive).
-	//
-	// And set a timeout to avoid indefinite hangs if the server is unreachable.
-	cmd.Env = append(cmd.Env, "GIT_SSH_COMMAND=ssh -o BatchMode=yes -o ConnectTimeout=30")
-
-	// Identify HTTP requests with a user agent. Please keep the git/ prefix because GitHub breaks the protocol v2
-	// negotiation of clone URLs without a `.git` suffix (which we use) without it. Don't ask.
-	cmd.Env = append(cmd.Env, "GIT_HTTP_USER_AGENT=git/Sourcegraph-Bot")
-
-	if tlsConf.SSLNoVerify {
-		cmd.Env = append(cmd.Env, "GIT_SSL_NO_VERIFY=true")
-	}
-	if tlsConf.SSLCAInfo != "" {
-		cmd.Env = append(cmd.Env, "GIT_SSL_CAINFO="+tlsConf.SSLCAInfo)
-	}
+	cmd.Env = append(cmd.Env, remoteGitEnv(tlsConf)...)
 
 	extraArgs := []string{
 		// Unset credential helper because the command is non-interactive.
@@ -276,6 +259,47 @@ func configureRemoteGitCommand(cmd *exec.Cmd, tlsConf *tlsConfig) {
 	cmd.Args = append(cmd.Args[:1], append(extraArgs, cmd.Args[1:]...)...)
 }
 
+func remoteGitEnv(tlsConf *tlsConfig) []string {
+	env := []string{"GIT_ASKPASS=true"} // disable password prompt
+
+	// Suppress asking to add SSH host key to known_hosts (which will hang because
+	// the command is non-interactive).
+	//
+	// And set a timeout to avoid indefinite hangs if the server is unreachable.
+	env = append(env, "GIT_SSH_COMMAND=ssh -o BatchMode=yes -o ConnectTimeout=30")
+
+	// Identify HTTP requests with a user agent. Please keep the git/ prefix because GitHub breaks the protocol v2
+	// negotiation of clone URLs without a `.git` suffix (which we use) without it. Don't ask.
+	env = append(env, "GIT_HTTP_USER_AGENT=git/Sourcegraph-Bot")
+
+	if tlsConf.SSLNoVerify {
+		env = append(env, "GIT_SSL_NO_VERIFY=true")
+	}
+	if tlsConf.SSLCAInfo != "" {
+		env = append(env, "GIT_SSL_CAINFO="+tlsConf.SSLCAInfo)
+	}
+	return env
+}
+
+// PromisorRemoteEnv returns the environment for git commands run in a partial
+// clone, so that missing objects can be lazily fetched from remoteURL through
+// the promisor remote with the given name.
+//
+// The remote URL is passed through the environment rather than written to the
+// git config on disk, since it may contain credentials.
+func PromisorRemoteEnv(remote, remoteURL string) []string {
+	return append(remoteGitEnv(tlsExternal()),
+		"GIT_CONFIG_COUNT=3",
+		"GIT_CONFIG_KEY_0=remote."+remote+".url",
+		"GIT_CONFIG_VALUE_0="+remoteURL,
+		// Unset credential helper because the command is non-interactive.
+		"GIT_CONFIG_KEY_1=credential.helper",
+		"GIT_CONFIG_VALUE_1=",
+		"GIT_CONFIG_KEY_2=protocol.version",
+		"GIT_CONFIG_VALUE_2=2",
+	)
+}
+
 // removeUnsupportedP4Args removes all -c arguments as `p4-fusion` command doesn't
 // support -c argument and passing this causes warning logs.
 func removeUnsupportedP4Args(args []string) []string {
@@ -20,7 +20,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// GetObject resolves objectName to an object and returns its type. The object
+// database is read in-process if possible, falling back to git otherwise.
 func GetObject(ctx context.Context, rcf *wrexec.RecordingCommandFactory, reposDir string, repo api.RepoName, objectName string) (_ *gitdomain.GitObject, err error) {
+	if obj, err := getObjectNative(gitserverfs.RepoDirFromName(reposDir, repo), objectName); err == nil {
+		return obj, nil
+	}
 	return getObject(ctx, rcf, reposDir, getObjectType, revParse, repo, objectName)
 }
 
@@ -80,6 +85,7 @@ type getObjectTypeFunc func(ctx context.Context, rcf *wrexec.RecordingCommandFac
 func getObjectType(ctx context.Context, rcf *wrexec.RecordingCommandFactory, repo api.RepoName, dir common.GitDir, objectID string) (gitdomain.ObjectType, error) {
 	cmd := exec.Command("git", "cat-file", "-t", "--", objectID)
 	dir.Set(cmd)
+	setCommandEnv(ctx, cmd)
 	wrappedCmd := rcf.WrapWithRepoName(context.Background(), log.NoOp(), repo, cmd)
 	out, err := wrappedCmd.CombinedOutput()
 	if err != nil {
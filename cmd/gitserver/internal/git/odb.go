@@ -0,0 +1,650 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrUnsupported is returned by the ObjectReader for lookups it can't serve
+// in-process. Callers fall back to the git binary.
+var ErrUnsupported = errors.New("not supported by the in-process object reader")
+
+var errObjectNotFound = errors.New("object not found")
+
+var nativeObjectReaderEnabled = env.MustGetBool("SRC_GITSERVER_NATIVE_OBJECT_READER", true, "Serve object lookups of GetObject and ReadFile by reading the git object database in-process instead of running git")
+
+var nativeObjectReads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_native_object_reads_total",
+	Help: "Number of object lookups by operation, and whether they were served by the in-process object reader (true) or fell back to git (false)",
+}, []string{"op", "native"})
+
+// ObjectReader reads objects from the object database of a repository without
+// running git. It reads loose objects and version 2 pack indexes and packfiles
+// of SHA-1 repositories. Pack indexes are shared by all readers through a
+// cache.
+//
+// Anything else, like alternates, SHA-256 repositories or revisions that are
+// not plain refs or object IDs, results in ErrUnsupported. Objects it can't
+// find, for example the missing objects of partial clones or objects in a pack
+// written after the reader was created, result in an error too. In both cases
+// callers should retry with git.
+type ObjectReader struct {
+	dir        common.GitDir
+	packs      []pack
+	packedRefs map[string]gitdomain.OID
+}
+
+// NewObjectReader returns an ObjectReader for the repository in dir.
+func NewObjectReader(dir common.GitDir) (*ObjectReader, error) {
+	return newObjectReader(dir, sharedPackIndexCache)
+}
+
+func newObjectReader(dir common.GitDir, cache *packIndexCache) (*ObjectReader, error) {
+	if !nativeObjectReaderEnabled {
+		return nil, errors.Wrap(ErrUnsupported, "disabled")
+	}
+
+	config, err := os.ReadFile(dir.Path("config"))
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Contains(bytes.ToLower(config), []byte("objectformat")) {
+		return nil, errors.Wrap(ErrUnsupported, "object format extension")
+	}
+	if _, err := os.Stat(dir.Path("objects", "info", "alternates")); err == nil {
+		return nil, errors.Wrap(ErrUnsupported, "alternates")
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	idxs, err := filepath.Glob(dir.Path("objects", "pack", "*.idx"))
+	if err != nil {
+		return nil, err
+	}
+	r := &ObjectReader{dir: dir}
+	for _, p := range idxs {
+		idx, err := cache.get(p)
+		if err != nil {
+			// The pack was removed by a concurrent repack.
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		r.packs = append(r.packs, pack{path: strings.TrimSuffix(p, ".idx") + ".pack", idx: idx})
+	}
+	return r, nil
+}
+
+// ReadObject returns the type and the content of an object.
+func (r *ObjectReader) ReadObject(oid gitdomain.OID) (gitdomain.ObjectType, []byte, error) {
+	return r.readObject(oid, 0)
+}
+
+func (r *ObjectReader) readObject(oid gitdomain.OID, depth int) (gitdomain.ObjectType, []byte, error) {
+	for _, p := range r.packs {
+		if offset, ok := p.idx.find(oid); ok {
+			return r.readPackObject(p, offset, depth)
+		}
+	}
+	return r.readLooseObject(oid, false)
+}
+
+// ObjectType returns the type of an object. Unlike ReadObject, it doesn't
+// read the content of the object.
+func (r *ObjectReader) ObjectType(oid gitdomain.OID) (gitdomain.ObjectType, error) {
+	return r.objectType(oid, 0)
+}
+
+func (r *ObjectReader) objectType(oid gitdomain.OID, depth int) (gitdomain.ObjectType, error) {
+	for _, p := range r.packs {
+		offset, ok := p.idx.find(oid)
+		if !ok {
+			continue
+		}
+
+		f, err := os.Open(p.path)
+		if err != nil {
+			return "", err
+		}
+		defer f.Close()
+
+		// The type of a delta is the type of its base.
+		for ; depth < maxDeltaChainLength; depth++ {
+			h, err := readPackObjectHeader(f, offset)
+			if err != nil {
+				return "", err
+			}
+			switch h.typ {
+			case packObjectOfsDelta:
+				offset = h.baseOffset
+			case packObjectRefDelta:
+				return r.objectType(h.baseOID, depth+1)
+			default:
+				return packObjectType(h.typ), nil
+			}
+		}
+		return "", errors.New("delta chain too long")
+	}
+
+	typ, _, err := r.readLooseObject(oid, true)
+	return typ, err
+}
+
+func (r *ObjectReader) readPackObject(p pack, offset int64, depth int) (gitdomain.ObjectType, []byte, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	// Collect the deltas down to the base object, then apply them in reverse.
+	var deltas [][]byte
+	var typ gitdomain.ObjectType
+	var data []byte
+	for typ == "" {
+		if depth+len(deltas) >= maxDeltaChainLength {
+			return "", nil, errors.New("delta chain too long")
+		}
+
+		h, err := readPackObjectHeader(f, offset)
+		if err != nil {
+			return "", nil, err
+		}
+		if h.size > maxObjectSize {
+			return "", nil, errors.Wrapf(ErrUnsupported, "object of %d bytes", h.size)
+		}
+		obj, err := inflate(f, h.dataOffset, h.size)
+		if err != nil {
+			return "", nil, err
+		}
+
+		switch h.typ {
+		case packObjectOfsDelta:
+			deltas = append(deltas, obj)
+			offset = h.baseOffset
+		case packObjectRefDelta:
+			deltas = append(deltas, obj)
+			if typ, data, err = r.readObject(h.baseOID, depth+len(deltas)); err != nil {
+				return "", nil, errors.Wrap(err, "reading ref-delta base")
+			}
+		default:
+			typ, data = packObjectType(h.typ), obj
+		}
+	}
+
+	for i := len(deltas) - 1; i >= 0; i-- {
+		if data, err = applyDelta(data, deltas[i]); err != nil {
+			return "", nil, err
+		}
+	}
+	return typ, data, nil
+}
+
+// OpenObject returns the type, the size and a reader of the content of an
+// object. Objects stored whole are streamed from the object database, so their
+// size isn't bounded. Deltified objects are resolved in memory like ReadObject
+// does. The reader must be closed.
+func (r *ObjectReader) OpenObject(oid gitdomain.OID) (gitdomain.ObjectType, int64, io.ReadCloser, error) {
+	for _, p := range r.packs {
+		if offset, ok := p.idx.find(oid); ok {
+			return r.openPackObject(p, offset)
+		}
+	}
+	return r.openLooseObject(oid)
+}
+
+func (r *ObjectReader) openPackObject(p pack, offset int64) (_ gitdomain.ObjectType, _ int64, _ io.ReadCloser, err error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+		}
+	}()
+
+	h, err := readPackObjectHeader(f, offset)
+	if err != nil {
+		return "", 0, nil, err
+	}
+	if h.typ == packObjectOfsDelta || h.typ == packObjectRefDelta {
+		f.Close()
+		typ, data, err := r.readPackObject(p, offset, 0)
+		if err != nil {
+			return "", 0, nil, err
+		}
+		return typ, int64(len(data)), io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	zr, err := zlib.NewReader(bufio.NewReader(io.NewSectionReader(f, h.dataOffset, 1<<62)))
+	if err != nil {
+		return "", 0, nil, err
+	}
+	return packObjectType(h.typ), h.size, &objectStream{r: zr, remaining: h.size, closers: []io.Closer{zr, f}}, nil
+}
+
+// readLooseObject reads a loose object. If headerOnly is true, only the type
+// is read.
+func (r *ObjectReader) readLooseObject(oid gitdomain.OID, headerOnly bool) (gitdomain.ObjectType, []byte, error) {
+	typ, size, s, err := r.openLooseObject(oid)
+	if err != nil {
+		return "", nil, err
+	}
+	defer s.Close()
+
+	if headerOnly {
+		return typ, nil, nil
+	}
+	if size > maxObjectSize {
+		return "", nil, errors.Wrapf(ErrUnsupported, "object of %d bytes", size)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(s, data); err != nil {
+		return "", nil, errors.Wrap(err, "reading loose object")
+	}
+	return typ, data, nil
+}
+
+// openLooseObject opens a loose object, which is stored zlib compressed with a
+// "<type> <size>\x00" header.
+func (r *ObjectReader) openLooseObject(oid gitdomain.OID) (_ gitdomain.ObjectType, _ int64, _ *objectStream, err error) {
+	hex := oid.String()
+	f, err := os.Open(r.dir.Path("objects", hex[:2], hex[2:]))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return "", 0, nil, errors.Wrapf(errObjectNotFound, "object %s", hex)
+		}
+		return "", 0, nil, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+		}
+	}()
+
+	zr, err := zlib.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return "", 0, nil, err
+	}
+	defer func() {
+		if err != nil {
+			zr.Close()
+		}
+	}()
+
+	br := bufio.NewReader(zr)
+	header, err := br.ReadString(0)
+	if err != nil {
+		return "", 0, nil, errors.Wrap(err, "reading loose object header")
+	}
+	typ, size, ok := strings.Cut(strings.TrimSuffix(header, "\x00"), " ")
+	n, err := strconv.ParseInt(size, 10, 64)
+	if !ok || err != nil || n < 0 {
+		return "", 0, nil, errors.Newf("invalid loose object header %q", header)
+	}
+	switch gitdomain.ObjectType(typ) {
+	case gitdomain.ObjectTypeCommit, gitdomain.ObjectTypeTree, gitdomain.ObjectTypeBlob, gitdomain.ObjectTypeTag:
+	default:
+		return "", 0, nil, errors.Newf("invalid loose object header %q", header)
+	}
+	return gitdomain.ObjectType(typ), n, &objectStream{r: br, remaining: n, closers: []io.Closer{zr, f}}, nil
+}
+
+// objectStream reads the inflated content of an object of a known size. A
+// stream that ends early results in io.ErrUnexpectedEOF.
+type objectStream struct {
+	r         io.Reader
+	remaining int64
+	closers   []io.Closer
+}
+
+func (s *objectStream) Read(p []byte) (int, error) {
+	if s.remaining <= 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > s.remaining {
+		p = p[:s.remaining]
+	}
+	n, err := s.r.Read(p)
+	s.remaining -= int64(n)
+	if err == io.EOF && s.remaining > 0 {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (s *objectStream) Close() error {
+	var err error
+	for _, c := range s.closers {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// ResolveRevision resolves a full object ID or a ref name to an object ID,
+// like `git rev-parse <rev>`. Other revisions, like abbreviated object IDs or
+// revisions with ~ and ^, result in ErrUnsupported.
+func (r *ObjectReader) ResolveRevision(rev string) (gitdomain.OID, error) {
+	if len(rev) == 40 && isHex(rev) {
+		return decodeOID(rev)
+	}
+	if isHex(rev) || !isPlainRefName(rev) {
+		// Could be an abbreviated object ID.
+		return gitdomain.OID{}, errors.Wrapf(ErrUnsupported, "revision %q", rev)
+	}
+
+	// The order in which git looks up ref names. See gitrevisions(7).
+	var candidates []string
+	switch {
+	case rev == "HEAD" || strings.HasPrefix(rev, "refs/"):
+		candidates = append(candidates, rev)
+	case !strings.Contains(rev, "/"):
+		// Names like FETCH_HEAD are special refs with their own format.
+		if _, err := os.Stat(r.dir.Path(rev)); err == nil {
+			return gitdomain.OID{}, errors.Wrapf(ErrUnsupported, "revision %q", rev)
+		}
+	}
+	candidates = append(candidates,
+		"refs/"+rev,
+		"refs/tags/"+rev,
+		"refs/heads/"+rev,
+		"refs/remotes/"+rev,
+		"refs/remotes/"+rev+"/HEAD",
+	)
+
+	for _, name := range candidates {
+		oid, ok, err := r.resolveRef(name, 0)
+		if err != nil {
+			return gitdomain.OID{}, err
+		}
+		if ok {
+			return oid, nil
+		}
+	}
+	// Let git report the error.
+	return gitdomain.OID{}, errors.Wrapf(ErrUnsupported, "unknown revision %q", rev)
+}
+
+func (r *ObjectReader) resolveRef(name string, depth int) (gitdomain.OID, bool, error) {
+	if depth > 5 {
+		return gitdomain.OID{}, false, errors.Newf("symbolic ref %q nested too deeply", name)
+	}
+	// 🚨 SECURITY: The targets of symbolic refs must not escape the repo dir.
+	if !isPlainRefName(name) {
+		return gitdomain.OID{}, false, errors.Wrapf(ErrUnsupported, "ref %q", name)
+	}
+
+	data, err := os.ReadFile(r.dir.Path(filepath.FromSlash(name)))
+	switch {
+	case err == nil:
+		value := strings.TrimSpace(string(data))
+		if strings.HasPrefix(value, "ref: ") {
+			return r.resolveRef(strings.TrimPrefix(value, "ref: "), depth+1)
+		}
+		if len(value) != 40 || !isHex(value) {
+			return gitdomain.OID{}, false, errors.Wrapf(ErrUnsupported, "ref %q", name)
+		}
+		oid, err := decodeOID(value)
+		return oid, err == nil, err
+	case errors.Is(err, fs.ErrNotExist), isDirError(err):
+	default:
+		return gitdomain.OID{}, false, err
+	}
+
+	if r.packedRefs == nil {
+		if r.packedRefs, err = readPackedRefs(r.dir); err != nil {
+			return gitdomain.OID{}, false, err
+		}
+	}
+	oid, ok := r.packedRefs[name]
+	return oid, ok, nil
+}
+
+func isDirError(err error) bool {
+	var pathErr *fs.PathError
+	if !errors.As(err, &pathErr) {
+		return false
+	}
+	fi, statErr := os.Stat(pathErr.Path)
+	return statErr == nil && fi.IsDir()
+}
+
+// readPackedRefs reads the packed-refs file of the repository.
+func readPackedRefs(dir common.GitDir) (map[string]gitdomain.OID, error) {
+	refs := make(map[string]gitdomain.OID)
+	data, err := os.ReadFile(dir.Path("packed-refs"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return refs, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		// Skip the header and the peeled values of tags.
+		if line == "" || line[0] == '#' || line[0] == '^' {
+			continue
+		}
+		sha, name, ok := strings.Cut(line, " ")
+		if !ok || len(sha) != 40 || !isHex(sha) {
+			return nil, errors.Newf("invalid packed-refs line %q", line)
+		}
+		oid, err := decodeOID(sha)
+		if err != nil {
+			return nil, err
+		}
+		refs[name] = oid
+	}
+	return refs, nil
+}
+
+// TreeEntry is an entry of a git tree.
+type TreeEntry struct {
+	// Mode is the file mode, like 100644 or 40000.
+	Mode string
+	Type gitdomain.ObjectType
+	OID  gitdomain.OID
+	// Path is the path of the entry from the root of the tree.
+	Path string
+}
+
+// LookupPath returns the entry at path in the tree of a commit, like `git
+// ls-tree <treeish> -- <path>`. ok is false if there is no such entry. Paths
+// with glob characters or "." and ".." components result in ErrUnsupported.
+func (r *ObjectReader) LookupPath(treeish gitdomain.OID, path string) (_ TreeEntry, ok bool, err error) {
+	if path == "" || strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") || strings.ContainsAny(path, "*?[\\:") {
+		return TreeEntry{}, false, errors.Wrapf(ErrUnsupported, "path %q", path)
+	}
+	components := strings.Split(path, "/")
+	for _, c := range components {
+		if c == "" || c == "." || c == ".." {
+			return TreeEntry{}, false, errors.Wrapf(ErrUnsupported, "path %q", path)
+		}
+	}
+
+	tree, err := r.peelToTree(treeish)
+	if err != nil {
+		return TreeEntry{}, false, err
+	}
+
+	entry := TreeEntry{Type: gitdomain.ObjectTypeTree}
+	for i, c := range components {
+		if entry.Type != gitdomain.ObjectTypeTree {
+			return TreeEntry{}, false, nil
+		}
+		var found bool
+		if entry, found, err = findTreeEntry(tree, c); err != nil || !found {
+			return TreeEntry{}, false, err
+		}
+		if entry.Type == gitdomain.ObjectTypeTree && i < len(components)-1 {
+			if _, tree, err = r.ReadObject(entry.OID); err != nil {
+				return TreeEntry{}, false, err
+			}
+		}
+	}
+	entry.Path = path
+	return entry, true, nil
+}
+
+// peelToTree returns the content of the tree a commit, tag or tree points to.
+func (r *ObjectReader) peelToTree(oid gitdomain.OID) ([]byte, error) {
+	for i := 0; i < 10; i++ {
+		typ, data, err := r.ReadObject(oid)
+		if err != nil {
+			return nil, err
+		}
+
+		var header string
+		switch typ {
+		case gitdomain.ObjectTypeTree:
+			return data, nil
+		case gitdomain.ObjectTypeCommit:
+			header = "tree "
+		case gitdomain.ObjectTypeTag:
+			header = "object "
+		default:
+			return nil, errors.Newf("%s is a %s, not a tree-ish", oid, typ)
+		}
+
+		line, _, _ := bytes.Cut(data, []byte{'\n'})
+		sha := strings.TrimPrefix(string(line), header)
+		if len(sha) != 40 || !isHex(sha) {
+			return nil, errors.Newf("invalid %s object %s", typ, oid)
+		}
+		if oid, err = decodeOID(sha); err != nil {
+			return nil, err
+		}
+	}
+	return nil, errors.New("tags nested too deeply")
+}
+
+// findTreeEntry finds the entry with the given name in the content of a tree.
+// Each entry is "<mode> <name>\x00<20 byte object ID>".
+func findTreeEntry(tree []byte, name string) (TreeEntry, bool, error) {
+	for len(tree) > 0 {
+		header, rest, ok := bytes.Cut(tree, []byte{0})
+		if !ok || len(rest) < 20 {
+			return TreeEntry{}, false, errors.New("invalid tree object")
+		}
+		mode, entryName, ok := bytes.Cut(header, []byte{' '})
+		if !ok {
+			return TreeEntry{}, false, errors.New("invalid tree object")
+		}
+		if string(entryName) == name {
+			entry := TreeEntry{Mode: string(mode), Type: gitdomain.ObjectTypeBlob}
+			switch entry.Mode {
+			case "40000":
+				entry.Type = gitdomain.ObjectTypeTree
+			case "160000":
+				entry.Type = gitdomain.ObjectTypeCommit
+			}
+			copy(entry.OID[:], rest[:20])
+			return entry, true, nil
+		}
+		tree = rest[20:]
+	}
+	return TreeEntry{}, false, nil
+}
+
+// ReadFile returns the tree entry at path in the tree of rev and, for blobs, a
+// reader of their content, like `git ls-tree <rev> -- <path>` followed by `git
+// cat-file -p <oid>`. The entry is nil if there is no such path. The reader
+// must be closed.
+func ReadFile(dir common.GitDir, rev, path string) (_ *TreeEntry, blob io.ReadCloser, err error) {
+	defer func() {
+		nativeObjectReads.WithLabelValues("ReadFile", strconv.FormatBool(err == nil)).Inc()
+	}()
+
+	r, err := NewObjectReader(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	oid, err := r.ResolveRevision(rev)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry, ok, err := r.LookupPath(oid, path)
+	if err != nil || !ok {
+		return nil, nil, err
+	}
+	if entry.Type != gitdomain.ObjectTypeBlob {
+		return &entry, nil, nil
+	}
+	if _, _, blob, err = r.OpenObject(entry.OID); err != nil {
+		return nil, nil, err
+	}
+	return &entry, blob, nil
+}
+
+// getObjectNative is GetObject with the in-process object reader.
+func getObjectNative(dir common.GitDir, objectName string) (_ *gitdomain.GitObject, err error) {
+	defer func() {
+		nativeObjectReads.WithLabelValues("GetObject", strconv.FormatBool(err == nil)).Inc()
+	}()
+
+	r, err := NewObjectReader(dir)
+	if err != nil {
+		return nil, err
+	}
+	oid, err := r.ResolveRevision(objectName)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := r.ObjectType(oid)
+	if err != nil {
+		return nil, err
+	}
+	return &gitdomain.GitObject{ID: oid, Type: typ}, nil
+}
+
+func isHex(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// isPlainRefName reports whether rev is a ref name without any of the syntax
+// of gitrevisions(7) and that can't escape the git directory.
+func isPlainRefName(rev string) bool {
+	if rev == "" || strings.HasPrefix(rev, "/") || strings.HasSuffix(rev, "/") || strings.HasSuffix(rev, ".lock") {
+		return false
+	}
+	for _, c := range strings.Split(rev, "/") {
+		if c == "" || c[0] == '.' || c[0] == '-' {
+			return false
+		}
+	}
+	for i := 0; i < len(rev); i++ {
+		c := rev[i]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '/' || c == '_' || c == '-' || c == '.' || c == '+') {
+			return false
+		}
+	}
+	return !strings.Contains(rev, "..")
+}
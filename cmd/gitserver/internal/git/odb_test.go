@@ -0,0 +1,388 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// makeObjectReaderTestRepo creates a bare repository with a history that
+// packs into deltas, and runs script in it afterwards.
+func makeObjectReaderTestRepo(t testing.TB, script string) common.GitDir {
+	t.Helper()
+
+	dir := t.TempDir()
+	var lines []string
+	for i := 0; i < 200; i++ {
+		lines = append(lines, fmt.Sprintf("line %d of a file that changes a little with every commit", i))
+	}
+	var setup strings.Builder
+	setup.WriteString("git init --bare .\n")
+	setup.WriteString("export GIT_INDEX_FILE=\"$PWD/index\"\n")
+	for i := 0; i < 10; i++ {
+		lines[i*13] = fmt.Sprintf("changed in commit %d", i)
+		fmt.Fprintf(&setup, "blob=$(printf '%%s\\n' %s | git hash-object -w --stdin)\n", shellQuoteAll(lines))
+		fmt.Fprintf(&setup, "git update-index --add --cacheinfo 100644,$blob,dir/sub/file.txt\n")
+		fmt.Fprintf(&setup, "git update-index --add --cacheinfo 100644,$(echo readme %d | git hash-object -w --stdin),README.md\n", i)
+		setup.WriteString("tree=$(git write-tree)\n")
+		setup.WriteString("parent=$(git rev-parse -q --verify HEAD || true)\n")
+		fmt.Fprintf(&setup, "commit=$(echo commit %d | git commit-tree $tree ${parent:+-p $parent})\n", i)
+		setup.WriteString("git update-ref refs/heads/master $commit\n")
+	}
+	setup.WriteString(script)
+
+	cmd := exec.Command("/bin/sh", "-euc", setup.String())
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=a", "GIT_AUTHOR_EMAIL=a@a.com", "GIT_AUTHOR_DATE=2006-01-02T15:04:05Z",
+		"GIT_COMMITTER_NAME=a", "GIT_COMMITTER_EMAIL=a@a.com", "GIT_COMMITTER_DATE=2006-01-02T15:04:05Z",
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("out=%s, err=%s", out, err)
+	}
+	return common.GitDir(dir)
+}
+
+func shellQuoteAll(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+func runGit(t testing.TB, dir common.GitDir, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("git %v: %s", args, err)
+	}
+	return string(out)
+}
+
+func TestObjectReader_ReadObject(t *testing.T) {
+	for name, script := range map[string]string{
+		"loose": "",
+		// Packs with ofs-deltas, and with ref-deltas.
+		"packed":            "git repack -adf --depth=5\n",
+		"packed ref-deltas": "git -c repack.useDeltaBaseOffset=false repack -adf --depth=5\n",
+		// A pack for half of the history, loose objects for the rest.
+		"mixed": "git pack-objects --revs objects/pack/pack <<EOF\nmaster~5\nEOF\ngit prune-packed\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := makeObjectReaderTestRepo(t, script)
+			r, err := newObjectReader(dir, newPackIndexCache(1<<20))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			objects := strings.Fields(runGit(t, dir, "cat-file", "--batch-all-objects", "--batch-check=%(objectname)"))
+			if len(objects) == 0 {
+				t.Fatal("no objects")
+			}
+			for _, sha := range objects {
+				oid, err := decodeOID(sha)
+				if err != nil {
+					t.Fatal(err)
+				}
+				wantType := strings.TrimSpace(runGit(t, dir, "cat-file", "-t", sha))
+				wantData := runGit(t, dir, "cat-file", string(wantType), sha)
+
+				typ, data, err := r.ReadObject(oid)
+				if err != nil {
+					t.Fatalf("ReadObject(%s): %s", sha, err)
+				}
+				if string(typ) != wantType {
+					t.Errorf("ReadObject(%s): want type %s, got %s", sha, wantType, typ)
+				}
+				if string(data) != wantData {
+					t.Errorf("ReadObject(%s): unexpected content", sha)
+				}
+
+				typ, size, rc, err := r.OpenObject(oid)
+				if err != nil {
+					t.Fatalf("OpenObject(%s): %s", sha, err)
+				}
+				data, err = io.ReadAll(rc)
+				rc.Close()
+				if err != nil {
+					t.Fatalf("OpenObject(%s): %s", sha, err)
+				}
+				if string(typ) != wantType || size != int64(len(wantData)) || string(data) != wantData {
+					t.Errorf("OpenObject(%s): unexpected type %s or content of %d bytes", sha, typ, size)
+				}
+
+				typ, err = r.ObjectType(oid)
+				if err != nil {
+					t.Fatalf("ObjectType(%s): %s", sha, err)
+				}
+				if string(typ) != wantType {
+					t.Errorf("ObjectType(%s): want type %s, got %s", sha, wantType, typ)
+				}
+			}
+
+			if _, _, err := r.ReadObject(gitdomain.OID{1, 2, 3}); !errors.Is(err, errObjectNotFound) {
+				t.Errorf("want errObjectNotFound for a missing object, got %v", err)
+			}
+		})
+	}
+}
+
+func TestObjectStream(t *testing.T) {
+	s := &objectStream{r: strings.NewReader("hello world"), remaining: 5}
+	if data, err := io.ReadAll(s); err != nil || string(data) != "hello" {
+		t.Errorf("want the first 5 bytes, got %q, %v", data, err)
+	}
+
+	s = &objectStream{r: strings.NewReader("hello"), remaining: 10}
+	if _, err := io.ReadAll(s); err != io.ErrUnexpectedEOF {
+		t.Errorf("want io.ErrUnexpectedEOF for a truncated object, got %v", err)
+	}
+}
+
+func TestObjectReader_ResolveRevision(t *testing.T) {
+	dir := makeObjectReaderTestRepo(t, `git tag -a -m tag v1 master~2
+git tag lightweight master~3
+git update-ref refs/heads/feature/x master~4
+git pack-refs --all
+git update-ref refs/heads/loose master~1
+git symbolic-ref refs/heads/alias refs/heads/feature/x
+`)
+	r, err := newObjectReader(dir, newPackIndexCache(1<<20))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, rev := range []string{
+		"HEAD",
+		"master",
+		"refs/heads/master",
+		"heads/master",
+		"v1",
+		"refs/tags/v1",
+		"lightweight",
+		"feature/x",
+		"loose",
+		"alias",
+		strings.TrimSpace(runGit(t, dir, "rev-parse", "master~3")),
+	} {
+		want := strings.TrimSpace(runGit(t, dir, "rev-parse", rev))
+		got, err := r.ResolveRevision(rev)
+		if err != nil {
+			t.Errorf("ResolveRevision(%q): %s", rev, err)
+			continue
+		}
+		if got.String() != want {
+			t.Errorf("ResolveRevision(%q): want %s, got %s", rev, want, got)
+		}
+	}
+
+	for _, rev := range []string{
+		"HEAD~1",
+		"master^{tree}",
+		"master..feature/x",
+		"abc123",
+		"../config",
+		"missing",
+		"FETCH_HEAD",
+	} {
+		if _, err := r.ResolveRevision(rev); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("ResolveRevision(%q): want ErrUnsupported, got %v", rev, err)
+		}
+	}
+}
+
+func TestObjectReader_LookupPath(t *testing.T) {
+	dir := makeObjectReaderTestRepo(t, `export GIT_INDEX_FILE="$PWD/index"
+git update-index --add --cacheinfo 160000,$(git rev-parse master~1),dir/submodule
+git update-ref refs/heads/master $(echo submodule | git commit-tree $(git write-tree) -p master)
+git repack -ad
+`)
+	r, err := newObjectReader(dir, newPackIndexCache(1<<20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := r.ResolveRevision("HEAD")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{"README.md", "dir", "dir/sub/file.txt", "dir/submodule"} {
+		// 100644 blob 3bad331187e39c05c78a9b5e443689f78f4365a7<TAB>README.md
+		out := strings.TrimSpace(runGit(t, dir, "ls-tree", "HEAD", "--", path))
+		info, _, _ := strings.Cut(out, "\t")
+		fields := strings.Fields(info)
+		want := TreeEntry{Mode: strings.TrimLeft(fields[0], "0"), Type: gitdomain.ObjectType(fields[1]), Path: path}
+		if want.OID, err = decodeOID(fields[2]); err != nil {
+			t.Fatal(err)
+		}
+
+		got, ok, err := r.LookupPath(head, path)
+		if err != nil || !ok {
+			t.Fatalf("LookupPath(%q): ok=%v, err=%v", path, ok, err)
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("LookupPath(%q): (-want +got):\n%s", path, diff)
+		}
+	}
+
+	for _, path := range []string{"missing", "dir/missing", "README.md/file"} {
+		if _, ok, err := r.LookupPath(head, path); err != nil || ok {
+			t.Errorf("LookupPath(%q): want not found, got ok=%v, err=%v", path, ok, err)
+		}
+	}
+	for _, path := range []string{"dir/", "./README.md", "dir/../README.md", "*.md"} {
+		if _, _, err := r.LookupPath(head, path); !errors.Is(err, ErrUnsupported) {
+			t.Errorf("LookupPath(%q): want ErrUnsupported, got %v", path, err)
+		}
+	}
+}
+
+func TestObjectReader_Unsupported(t *testing.T) {
+	dir := makeObjectReaderTestRepo(t, "")
+	if err := os.WriteFile(dir.Path("objects", "info", "alternates"), []byte("/elsewhere/objects\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewObjectReader(dir); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("want ErrUnsupported for a repository with alternates, got %v", err)
+	}
+
+	if _, err := NewObjectReader(common.GitDir(filepath.Join(t.TempDir(), "missing"))); err == nil {
+		t.Error("want error for a missing repository")
+	}
+}
+
+func TestPackIndexCache(t *testing.T) {
+	dir := makeObjectReaderTestRepo(t, "git repack -ad\n")
+	idxs, err := filepath.Glob(dir.Path("objects", "pack", "*.idx"))
+	if err != nil || len(idxs) != 1 {
+		t.Fatalf("want one pack index, got %v (%v)", idxs, err)
+	}
+
+	c := newPackIndexCache(1 << 20)
+	first, err := c.get(idxs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := c.get(idxs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Error("expected the cached pack index to be reused")
+	}
+	if c.size != len(first.data) {
+		t.Errorf("want cache size %d, got %d", len(first.data), c.size)
+	}
+
+	// Indexes larger than the cache are read, but not cached.
+	small := newPackIndexCache(10)
+	if _, err := small.get(idxs[0]); err != nil {
+		t.Fatal(err)
+	}
+	if small.size != 0 || small.lru.Len() != 0 {
+		t.Errorf("expected pack index not to be cached, got size %d", small.size)
+	}
+}
+
+func TestApplyDelta(t *testing.T) {
+	base := []byte("hello, world")
+	delta := []byte{
+		12,                       // base size
+		13,                       // result size
+		0x80 | 0x01 | 0x10, 0, 7, // copy "hello, "
+		6, 'g', 'o', 'p', 'h', 'e', 'r', // insert "gopher"
+	}
+	got, err := applyDelta(base, delta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello, gopher" {
+		t.Errorf("got %q", got)
+	}
+
+	if _, err := applyDelta(base[:5], delta); err == nil {
+		t.Error("expected error for a base of the wrong size")
+	}
+	if _, err := applyDelta(base, []byte{12, 1, 0x80 | 0x01 | 0x10, 10, 7}); err == nil {
+		t.Error("expected error for a copy out of bounds")
+	}
+}
+
+// BenchmarkReadFile compares the in-process object reader with the git
+// commands gitserver used to run for the same lookups.
+func BenchmarkReadFile(b *testing.B) {
+	dir := makeObjectReaderTestRepo(b, "git repack -adf --depth=5\n")
+
+	b.Run("native", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			entry, blob, err := ReadFile(dir, "HEAD", "dir/sub/file.txt")
+			if err != nil || entry == nil {
+				b.Fatalf("ReadFile: %v, %v", entry, err)
+			}
+			data, err := io.ReadAll(blob)
+			blob.Close()
+			if err != nil || len(data) == 0 {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("git", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cmd := exec.Command("git", "ls-tree", "--long", "-z", "HEAD", "--", "dir/sub/file.txt")
+			dir.Set(cmd)
+			out, err := cmd.Output()
+			if err != nil {
+				b.Fatal(err)
+			}
+			sha := strings.Fields(string(out))[2]
+			cmd = exec.Command("git", "cat-file", "-p", sha)
+			dir.Set(cmd)
+			if out, err = cmd.Output(); err != nil || len(out) == 0 {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkGetObject(b *testing.B) {
+	dir := makeObjectReaderTestRepo(b, "git repack -adf --depth=5\n")
+
+	b.Run("native", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := getObjectNative(dir, "master"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("git", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cmd := exec.Command("git", "rev-parse", "master")
+			dir.Set(cmd)
+			out, err := cmd.Output()
+			if err != nil {
+				b.Fatal(err)
+			}
+			cmd = exec.Command("git", "cat-file", "-t", "--", string(bytes.TrimSpace(out)))
+			dir.Set(cmd)
+			if _, err := cmd.Output(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
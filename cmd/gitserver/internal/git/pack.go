@@ -0,0 +1,398 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"container/list"
+	"encoding/binary"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Object types as encoded in packfiles. See gitformat-pack(5).
+const (
+	packObjectCommit   = 1
+	packObjectTree     = 2
+	packObjectBlob     = 3
+	packObjectTag      = 4
+	packObjectOfsDelta = 6
+	packObjectRefDelta = 7
+)
+
+// maxDeltaChainLength bounds the delta chains we resolve. Git itself never
+// writes chains longer than 4095 objects.
+const maxDeltaChainLength = 4096
+
+// maxObjectSize bounds the objects read into memory by ReadObject and when
+// resolving deltas. Larger objects result in ErrUnsupported, so that git reads
+// them instead. OpenObject streams objects that are stored whole regardless of
+// their size.
+const maxObjectSize = 64 << 20
+
+var packIndexCacheSizeBytes = env.MustGetInt("SRC_GITSERVER_PACK_INDEX_CACHE_SIZE_MB", 256, "Size in megabytes of the pack index cache shared by the in-process git object reader of all repositories") << 20
+
+var (
+	packIndexCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_pack_index_cache_hits_total",
+		Help: "Number of pack index lookups served from the pack index cache of the in-process git object reader",
+	})
+	packIndexCacheMisses = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_pack_index_cache_misses_total",
+		Help: "Number of pack indexes read from disk by the in-process git object reader",
+	})
+)
+
+// packIndex is a version 2 pack index (.idx) file. The file is kept in memory
+// as is, lookups index into it directly.
+type packIndex struct {
+	data  []byte
+	count int
+}
+
+const (
+	packIndexHeaderSize = 8
+	packIndexFanoutSize = 256 * 4
+)
+
+func parsePackIndex(data []byte) (*packIndex, error) {
+	if len(data) < packIndexHeaderSize+packIndexFanoutSize || !bytes.Equal(data[:4], []byte{0xff, 't', 'O', 'c'}) {
+		// Version 1 indexes have no header. They haven't been written by
+		// default since git 1.5.2.
+		return nil, errors.Wrap(ErrUnsupported, "pack index version 1")
+	}
+	if v := binary.BigEndian.Uint32(data[4:8]); v != 2 {
+		return nil, errors.Wrapf(ErrUnsupported, "pack index version %d", v)
+	}
+	count := int(binary.BigEndian.Uint32(data[packIndexHeaderSize+packIndexFanoutSize-4:]))
+	// oids, crc32s and offsets, followed by the pack and index checksums.
+	if len(data) < packIndexHeaderSize+packIndexFanoutSize+count*(20+4+4)+2*20 {
+		return nil, errors.New("truncated pack index")
+	}
+	return &packIndex{data: data, count: count}, nil
+}
+
+func (idx *packIndex) oid(i int) []byte {
+	start := packIndexHeaderSize + packIndexFanoutSize + i*20
+	return idx.data[start : start+20]
+}
+
+// find returns the offset of oid in the packfile, or false if the pack doesn't
+// contain it.
+func (idx *packIndex) find(oid gitdomain.OID) (int64, bool) {
+	fanout := idx.data[packIndexHeaderSize:]
+	lo := 0
+	if oid[0] > 0 {
+		lo = int(binary.BigEndian.Uint32(fanout[(int(oid[0])-1)*4:]))
+	}
+	hi := int(binary.BigEndian.Uint32(fanout[int(oid[0])*4:]))
+
+	i := lo + sort.Search(hi-lo, func(i int) bool {
+		return bytes.Compare(idx.oid(lo+i), oid[:]) >= 0
+	})
+	if i >= hi || !bytes.Equal(idx.oid(i), oid[:]) {
+		return 0, false
+	}
+
+	offsets := packIndexHeaderSize + packIndexFanoutSize + idx.count*(20+4)
+	offset := binary.BigEndian.Uint32(idx.data[offsets+i*4:])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true
+	}
+	// The offset is an index into the table of 8 byte offsets that follows.
+	large := offsets + idx.count*4 + int(offset&0x7fffffff)*8
+	if large+8 > len(idx.data) {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(idx.data[large:])), true
+}
+
+// packIndexCache caches the pack indexes of all repositories up to a total
+// size, evicting the least recently used ones. Indexes are keyed by path and
+// revalidated with the modification time and size of the file, so a pack that
+// was replaced is read again.
+type packIndexCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	size     int
+	lru      *list.List
+	items    map[string]*list.Element
+}
+
+type packIndexCacheEntry struct {
+	path    string
+	modTime time.Time
+	fileLen int64
+	idx     *packIndex
+}
+
+func newPackIndexCache(maxBytes int) *packIndexCache {
+	return &packIndexCache{
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+var sharedPackIndexCache = newPackIndexCache(packIndexCacheSizeBytes)
+
+func (c *packIndexCache) get(path string) (*packIndex, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if e, ok := c.items[path]; ok {
+		entry := e.Value.(*packIndexCacheEntry)
+		if entry.modTime.Equal(fi.ModTime()) && entry.fileLen == fi.Size() {
+			c.lru.MoveToFront(e)
+			c.mu.Unlock()
+			packIndexCacheHits.Inc()
+			return entry.idx, nil
+		}
+		c.remove(e)
+	}
+	c.mu.Unlock()
+
+	packIndexCacheMisses.Inc()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := parsePackIndex(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading pack index %s", path)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[path]; ok {
+		// Another reader loaded the index concurrently.
+		c.remove(e)
+	}
+	if len(data) <= c.maxBytes {
+		c.items[path] = c.lru.PushFront(&packIndexCacheEntry{path: path, modTime: fi.ModTime(), fileLen: fi.Size(), idx: idx})
+		c.size += len(data)
+		for c.size > c.maxBytes {
+			c.remove(c.lru.Back())
+		}
+	}
+	return idx, nil
+}
+
+func (c *packIndexCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*packIndexCacheEntry)
+	delete(c.items, entry.path)
+	c.size -= len(entry.idx.data)
+}
+
+// pack is a packfile with its index.
+type pack struct {
+	path string
+	idx  *packIndex
+}
+
+// packObjectHeader is the header of an object in a packfile.
+type packObjectHeader struct {
+	typ  int
+	size int64
+	// dataOffset is the offset of the zlib compressed data.
+	dataOffset int64
+	// baseOffset is the offset of the base object of an ofs-delta.
+	baseOffset int64
+	// baseOID is the base object of a ref-delta.
+	baseOID gitdomain.OID
+}
+
+// readPackObjectHeader reads the header of the object at offset in f.
+func readPackObjectHeader(f io.ReaderAt, offset int64) (packObjectHeader, error) {
+	// A header is at most 10 bytes of type and size, followed by a 20 byte
+	// ref-delta base or a ofs-delta offset of at most 10 bytes.
+	var buf [32]byte
+	n, err := f.ReadAt(buf[:], offset)
+	if err != nil && err != io.EOF {
+		return packObjectHeader{}, err
+	}
+	b := buf[:n]
+
+	pos := 0
+	next := func() (byte, error) {
+		if pos >= len(b) {
+			return 0, errors.New("truncated packfile object header")
+		}
+		c := b[pos]
+		pos++
+		return c, nil
+	}
+
+	c, err := next()
+	if err != nil {
+		return packObjectHeader{}, err
+	}
+	h := packObjectHeader{typ: int(c>>4) & 7, size: int64(c & 0x0f)}
+	for shift := 4; c&0x80 != 0; shift += 7 {
+		if c, err = next(); err != nil {
+			return packObjectHeader{}, err
+		}
+		h.size |= int64(c&0x7f) << shift
+	}
+
+	switch h.typ {
+	case packObjectOfsDelta:
+		if c, err = next(); err != nil {
+			return packObjectHeader{}, err
+		}
+		rel := int64(c & 0x7f)
+		for c&0x80 != 0 {
+			if c, err = next(); err != nil {
+				return packObjectHeader{}, err
+			}
+			rel = ((rel + 1) << 7) | int64(c&0x7f)
+		}
+		h.baseOffset = offset - rel
+		if h.baseOffset <= 0 {
+			return packObjectHeader{}, errors.New("invalid ofs-delta base offset")
+		}
+	case packObjectRefDelta:
+		if pos+20 > len(b) {
+			return packObjectHeader{}, errors.New("truncated packfile object header")
+		}
+		copy(h.baseOID[:], b[pos:pos+20])
+		pos += 20
+	case packObjectCommit, packObjectTree, packObjectBlob, packObjectTag:
+	default:
+		return packObjectHeader{}, errors.Newf("unknown packfile object type %d", h.typ)
+	}
+
+	h.dataOffset = offset + int64(pos)
+	return h, nil
+}
+
+// inflate reads size bytes of zlib compressed data at offset in f.
+func inflate(f io.ReaderAt, offset, size int64) ([]byte, error) {
+	zr, err := zlib.NewReader(bufio.NewReader(io.NewSectionReader(f, offset, 1<<62)))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(zr, data); err != nil {
+		return nil, errors.Wrap(err, "inflating object")
+	}
+	return data, nil
+}
+
+// applyDelta applies a git delta to base. See the "Deltified representation"
+// section of gitformat-pack(5).
+func applyDelta(base, delta []byte) ([]byte, error) {
+	errInvalid := errors.New("invalid delta")
+
+	pos := 0
+	varint := func() (int, error) {
+		n, shift := 0, 0
+		for {
+			if pos >= len(delta) {
+				return 0, errInvalid
+			}
+			c := delta[pos]
+			pos++
+			n |= int(c&0x7f) << shift
+			shift += 7
+			if c&0x80 == 0 {
+				return n, nil
+			}
+		}
+	}
+
+	baseSize, err := varint()
+	if err != nil {
+		return nil, err
+	}
+	if baseSize != len(base) {
+		return nil, errors.New("delta base size mismatch")
+	}
+	resultSize, err := varint()
+	if err != nil {
+		return nil, err
+	}
+	if resultSize > maxObjectSize {
+		return nil, errors.Wrapf(ErrUnsupported, "object of %d bytes", resultSize)
+	}
+
+	result := make([]byte, 0, resultSize)
+	for pos < len(delta) {
+		cmd := delta[pos]
+		pos++
+		switch {
+		case cmd&0x80 != 0:
+			// Copy from base. The bits of cmd tell which bytes of the offset
+			// and size follow.
+			var offset, size int
+			for i := 0; i < 4; i++ {
+				if cmd&(1<<i) != 0 {
+					if pos >= len(delta) {
+						return nil, errInvalid
+					}
+					offset |= int(delta[pos]) << (8 * i)
+					pos++
+				}
+			}
+			for i := 0; i < 3; i++ {
+				if cmd&(0x10<<i) != 0 {
+					if pos >= len(delta) {
+						return nil, errInvalid
+					}
+					size |= int(delta[pos]) << (8 * i)
+					pos++
+				}
+			}
+			if size == 0 {
+				size = 0x10000
+			}
+			if offset+size > len(base) || offset+size < offset {
+				return nil, errInvalid
+			}
+			result = append(result, base[offset:offset+size]...)
+		case cmd != 0:
+			// Insert the next cmd bytes.
+			if pos+int(cmd) > len(delta) {
+				return nil, errInvalid
+			}
+			result = append(result, delta[pos:pos+int(cmd)]...)
+			pos += int(cmd)
+		default:
+			return nil, errInvalid
+		}
+	}
+
+	if len(result) != resultSize {
+		return nil, errors.New("delta result size mismatch")
+	}
+	return result, nil
+}
+
+func packObjectType(typ int) gitdomain.ObjectType {
+	switch typ {
+	case packObjectCommit:
+		return gitdomain.ObjectTypeCommit
+	case packObjectTree:
+		return gitdomain.ObjectTypeTree
+	case packObjectBlob:
+		return gitdomain.ObjectTypeBlob
+	case packObjectTag:
+		return gitdomain.ObjectTypeTag
+	}
+	return ""
+}
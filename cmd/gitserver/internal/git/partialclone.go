@@ -0,0 +1,60 @@
+package git
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PromisorRemote is the name of the remote that partial clones lazily fetch
+// missing objects from. Its URL is never stored in the git config, see
+// executil.PromisorRemoteEnv.
+const PromisorRemote = "origin"
+
+// ConfigurePartialClone sets up the repository in dir as a partial clone of
+// PromisorRemote, omitting blobs larger than blobSizeLimit on fetch.
+func ConfigurePartialClone(ctx context.Context, dir common.GitDir, blobSizeLimit string) error {
+	for _, kv := range [][2]string{
+		{"extensions.partialClone", PromisorRemote},
+		{"remote." + PromisorRemote + ".promisor", "true"},
+		{"remote." + PromisorRemote + ".partialclonefilter", "blob:limit=" + blobSizeLimit},
+	} {
+		cmd := exec.CommandContext(ctx, "git", "config", kv[0], kv[1])
+		dir.Set(cmd)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "failed to configure partial clone: %s", string(out))
+		}
+	}
+	return nil
+}
+
+// IsPartialClone returns true if the repository in dir contains objects from a
+// promisor remote, meaning some objects may be missing locally and have to be
+// fetched on demand.
+func IsPartialClone(dir common.GitDir) bool {
+	promisorPacks, err := filepath.Glob(dir.Path("objects", "pack", "*.promisor"))
+	return err == nil && len(promisorPacks) > 0
+}
+
+type commandEnvKey struct{}
+
+// WithCommandEnv returns a context that makes the helpers in this package that
+// may need to read object contents run git with the additional environment
+// variables env. It is used to allow lazy fetches in partial clones.
+func WithCommandEnv(ctx context.Context, env []string) context.Context {
+	if len(env) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, commandEnvKey{}, env)
+}
+
+// setCommandEnv appends the environment set with WithCommandEnv to cmd.
+func setCommandEnv(ctx context.Context, cmd *exec.Cmd) {
+	env, _ := ctx.Value(commandEnvKey{}).([]string)
+	if len(env) > 0 {
+		cmd.Env = append(cmd.Env, env...)
+	}
+}
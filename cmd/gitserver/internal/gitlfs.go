@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"io"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// The defaults of the gitLFS limits in the site configuration.
+const (
+	defaultLFSMaxObjectSize = 10 * 1024 * 1024
+	defaultLFSMaxRepoSize   = 1024 * 1024 * 1024
+)
+
+var gitLFSConfig = conf.Cached(func() *schema.GitLFS {
+	return conf.ExperimentalFeatures().GitLFS
+})
+
+// fetchLFSObjects fetches the LFS objects of the repository in dir if that is
+// enabled in the site configuration. Failures are only logged, as the
+// repository is usable without its LFS objects.
+//
+// Partial clones are skipped, listing their files would fetch the big blobs
+// they are meant to omit.
+func fetchLFSObjects(ctx context.Context, logger log.Logger, repo api.RepoName, dir common.GitDir, remoteURL *vcs.URL, syncer vcssyncer.VCSSyncer) {
+	c := gitLFSConfig()
+	if c == nil || !c.Enabled || syncer.Type() != "git" || git.IsPartialClone(dir) {
+		return
+	}
+
+	limits := lfs.Limits{
+		MaxObjectSize: defaultLFSMaxObjectSize,
+		MaxRepoSize:   defaultLFSMaxRepoSize,
+	}
+	if c.MaxObjectSize != 0 {
+		limits.MaxObjectSize = int64(c.MaxObjectSize)
+	}
+	if c.MaxRepoSize != 0 {
+		limits.MaxRepoSize = int64(c.MaxRepoSize)
+	}
+
+	n, err := lfs.Fetch(ctx, httpcli.ExternalDoer, dir, remoteURL, limits)
+	if err != nil {
+		logger.Warn("failed to fetch LFS objects", log.String("repo", string(repo)), log.Int("fetched", n), log.Error(err))
+		return
+	}
+	if n > 0 {
+		logger.Debug("fetched LFS objects", log.String("repo", string(repo)), log.Int("fetched", n))
+	}
+}
+
+// lfsArchiveFilter returns a function that copies a git archive of the given
+// format from r to w, replacing pointer files with their LFS objects. It returns
+// nil if no LFS objects have been fetched for the repository in dir, or if the
+// format can't be rewritten.
+func lfsArchiveFilter(dir common.GitDir, format string) func(w io.Writer, r io.Reader) error {
+	var smudge func(common.GitDir, io.Writer, io.Reader) error
+	switch format {
+	case string(gitserver.ArchiveFormatTar):
+		smudge = lfs.SmudgeTar
+	case string(gitserver.ArchiveFormatZip):
+		smudge = lfs.SmudgeZip
+	}
+	if smudge == nil || !lfs.HasObjects(dir) {
+		return nil
+	}
+
+	return func(w io.Writer, r io.Reader) error {
+		return smudge(dir, w, r)
+	}
+}
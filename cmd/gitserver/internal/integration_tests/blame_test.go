@@ -0,0 +1,86 @@
+package inttests
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+func TestClient_BlameFile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := MakeGitRepository(t,
+		"printf 'a\\nb\\n' > f",
+		"git add f",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m commit1 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+		"printf 'a\\nc\\n' > f",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -am commit2 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+	)
+
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	resolve := func(rev string) api.CommitID {
+		t.Helper()
+		commitID, err := client.ResolveRevision(ctx, repo, rev, gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return commitID
+	}
+	head, parent := resolve("HEAD"), resolve("HEAD~1")
+	opts := &gitserver.BlameOptions{NewestCommit: head}
+
+	hunks, err := client.BlameFile(ctx, repo, "f", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*gitserver.Hunk{
+		{StartLine: 1, EndLine: 2, StartByte: 0, EndByte: 2, CommitID: parent, Message: "commit1", Filename: "f"},
+		{StartLine: 2, EndLine: 3, StartByte: 2, EndByte: 4, CommitID: head, Message: "commit2", Filename: "f"},
+	}
+	ignoreAuthor := cmpopts.IgnoreFields(gitserver.Hunk{}, "Author")
+	if diff := cmp.Diff(want, hunks, ignoreAuthor); diff != "" {
+		t.Fatalf("unexpected hunks (-want +got):\n%s", diff)
+	}
+	for _, h := range hunks {
+		if h.Author.Name != "a" || h.Author.Email != "a@a.com" {
+			t.Errorf("unexpected author %+v", h.Author)
+		}
+	}
+
+	// Streamed hunks are the same, but don't have byte offsets.
+	hr, err := client.StreamBlameFile(ctx, repo, "f", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer hr.Close()
+	var streamed []*gitserver.Hunk
+	for {
+		h, err := hr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		streamed = append(streamed, h)
+	}
+	ignoreBytes := cmpopts.IgnoreFields(gitserver.Hunk{}, "StartByte", "EndByte")
+	sortHunks := cmpopts.SortSlices(func(a, b *gitserver.Hunk) bool { return a.StartLine < b.StartLine })
+	if diff := cmp.Diff(want, streamed, ignoreAuthor, ignoreBytes, sortHunks); diff != "" {
+		t.Fatalf("unexpected streamed hunks (-want +got):\n%s", diff)
+	}
+
+	// Blaming a file that doesn't exist fails.
+	if _, err := client.BlameFile(ctx, repo, "missing", opts); err == nil {
+		t.Fatal("expected error blaming a missing file")
+	}
+}
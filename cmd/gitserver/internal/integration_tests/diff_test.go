@@ -0,0 +1,182 @@
+package inttests
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+)
+
+// makeBranchedRepo returns a repo with a commit on master, and a commit on
+// each of master and branch on top of it.
+func makeBranchedRepo(t *testing.T) api.RepoName {
+	t.Helper()
+	return MakeGitRepository(t,
+		"echo base > f",
+		"git add f",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m base --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+		"git checkout -b branch",
+		"echo branch >> f",
+		"echo new > g",
+		"git add f g",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:06Z git commit -m branch --author='a <a@a.com>' --date 2006-01-02T15:04:06Z",
+		"git checkout master",
+		"echo master > h",
+		"git add h",
+		"GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:07Z git commit -m master --author='a <a@a.com>' --date 2006-01-02T15:04:07Z",
+	)
+}
+
+func TestClient_Diff(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := makeBranchedRepo(t)
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	diffFiles := func(opts gitserver.DiffOptions) []string {
+		t.Helper()
+		opts.Repo = repo
+		it, err := client.Diff(ctx, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer it.Close()
+
+		var names []string
+		for {
+			d, err := it.Next()
+			if err == io.EOF {
+				return names
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			name := d.NewName
+			if name == "/dev/null" {
+				name = d.OrigName
+			}
+			names = append(names, name)
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		opts gitserver.DiffOptions
+		want []string
+	}{
+		{
+			name: "three dot",
+			opts: gitserver.DiffOptions{Base: "master", Head: "branch"},
+			want: []string{"f", "g"},
+		},
+		{
+			name: "two dot",
+			opts: gitserver.DiffOptions{Base: "master", Head: "branch", RangeType: ".."},
+			want: []string{"f", "g", "h"},
+		},
+		{
+			name: "paths",
+			opts: gitserver.DiffOptions{Base: "master", Head: "branch", Paths: []string{"g"}},
+			want: []string{"g"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, diffFiles(tc.opts)); diff != "" {
+				t.Fatalf("unexpected files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_MergeBase(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := makeBranchedRepo(t)
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	base, err := client.ResolveRevision(ctx, repo, "master~1", gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mb, err := client.MergeBase(ctx, repo, "master", "branch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mb != base {
+		t.Fatalf("unexpected merge base: want %q, got %q", base, mb)
+	}
+}
+
+func TestClient_CommitGraph(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo := makeBranchedRepo(t)
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	resolve := func(rev string) string {
+		t.Helper()
+		commitID, err := client.ResolveRevision(ctx, repo, rev, gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(commitID)
+	}
+	base, master, branch := resolve("master~1"), resolve("master"), resolve("branch")
+
+	t.Run("commit", func(t *testing.T) {
+		graph, err := client.CommitGraph(ctx, repo, gitserver.CommitGraphOptions{Commit: master})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][]string{
+			master: {base},
+			base:   {},
+		}
+		if diff := cmp.Diff(want, graph.Graph()); diff != "" {
+			t.Fatalf("unexpected graph (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{base, master}, graph.Order()); diff != "" {
+			t.Fatalf("unexpected order (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("all refs", func(t *testing.T) {
+		graph, err := client.CommitGraph(ctx, repo, gitserver.CommitGraphOptions{AllRefs: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][]string{
+			master: {base},
+			branch: {base},
+			base:   {},
+		}
+		if diff := cmp.Diff(want, graph.Graph()); diff != "" {
+			t.Fatalf("unexpected graph (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("rev list", func(t *testing.T) {
+		var commits []string
+		err := client.RevList(ctx, string(repo), "branch", func(commit string) (bool, error) {
+			commits = append(commits, commit)
+			return true, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]string{branch, base}, commits); diff != "" {
+			t.Fatalf("unexpected commits (-want +got):\n%s", diff)
+		}
+	})
+}
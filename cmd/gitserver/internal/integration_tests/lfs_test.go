@@ -0,0 +1,123 @@
+package inttests
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_LFSObjects(t *testing.T) {
+	ctx := context.Background()
+
+	mockGRPC := func(enabled bool) {
+		conf.Mock(&conf.Unified{
+			SiteConfiguration: schema.SiteConfiguration{
+				ExperimentalFeatures: &schema.ExperimentalFeatures{
+					EnableGRPC: boolPointer(enabled),
+				},
+			},
+		})
+	}
+	// File reads only replace pointers over gRPC.
+	mockGRPC(true)
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	object := "the real content\n"
+	sum := sha256.Sum256([]byte(object))
+	oid := hex.EncodeToString(sum[:])
+	pointer := fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n", oid, len(object))
+
+	repo := MakeGitRepository(t,
+		fmt.Sprintf("printf '%%s' '%s' > lfs.txt", pointer),
+		"echo plain > plain.txt",
+		"git add lfs.txt plain.txt",
+		"git commit -m commit --author='a <a@a.com>' --date 2006-01-02T15:04:05Z",
+	)
+
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	head, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readFile := func(name string) string {
+		t.Helper()
+		data, err := client.ReadFile(ctx, repo, head, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// Pointers are served as is until their object has been fetched.
+	if got := readFile("lfs.txt"); got != pointer {
+		t.Fatalf("got %q, want pointer", got)
+	}
+
+	dir := gitserverfs.RepoDirFromName(filepath.Join(root, "repos"), repo)
+	path := lfs.ObjectPath(dir, oid)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(object), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := readFile("lfs.txt"); got != object {
+		t.Errorf("got %q, want object", got)
+	}
+	if got := readFile("plain.txt"); got != "plain\n" {
+		t.Errorf("got %q, want plain file", got)
+	}
+
+	readArchive := func() map[string]string {
+		t.Helper()
+		rc, err := client.ArchiveReader(ctx, repo, gitserver.ArchiveOptions{Treeish: string(head), Format: gitserver.ArchiveFormatTar})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+
+		got := map[string]string{}
+		tr := tar.NewReader(rc)
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got[hdr.Name] = string(data)
+		}
+		return got
+	}
+
+	if got := readArchive(); got["lfs.txt"] != object || got["plain.txt"] != "plain\n" {
+		t.Errorf("unexpected gRPC archive contents %q", got)
+	}
+
+	// Archives served by the HTTP /archive endpoint replace pointers as well.
+	mockGRPC(false)
+	if got := readArchive(); got["lfs.txt"] != object || got["plain.txt"] != "plain\n" {
+		t.Errorf("unexpected HTTP archive contents %q", got)
+	}
+}
@@ -0,0 +1,84 @@
+package inttests
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_Submodules(t *testing.T) {
+	ctx := context.Background()
+
+	// Submodules are only resolved over gRPC. Remotes of the test repositories
+	// are named after their directory.
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{
+				EnableGRPC: boolPointer(true),
+			},
+			GitCloneURLToRepositoryName: []*schema.CloneURLToRepositoryName{{
+				From: `/remotes/(?P<name>[^/]+)$`,
+				To:   "{name}",
+			}},
+		},
+	})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	commit := "GIT_COMMITTER_NAME=a GIT_COMMITTER_EMAIL=a@a.com GIT_COMMITTER_DATE=2006-01-02T15:04:05Z git commit -m commit1 --author='a <a@a.com>' --date 2006-01-02T15:04:05Z"
+	submodDir := InitGitRepository(t, "touch f", "git add f", commit)
+	const submodCommit = "94aa9078934ce2776ccbb589569eca5ef575f12e"
+
+	source := gitserver.NewTestClientSource(t, GitserverAddresses)
+	client := gitserver.NewTestClient(t).WithClientSource(source)
+
+	t.Run("no submodules", func(t *testing.T) {
+		repo := MakeGitRepository(t, "touch f", "git add f", commit)
+		head, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		submodules, err := client.Submodules(ctx, repo, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Empty(t, submodules)
+	})
+
+	t.Run("submodules", func(t *testing.T) {
+		// The relative URL is resolved against the remote URL of the
+		// superproject, which is next to the submodule.
+		repo := MakeGitRepository(t,
+			"git -c protocol.file.allow=always submodule add "+filepath.ToSlash(submodDir)+" absolute",
+			"git -c protocol.file.allow=always submodule add ../"+filepath.Base(submodDir)+" nested/relative",
+			commit,
+		)
+		head, err := client.ResolveRevision(ctx, repo, "HEAD", gitserver.ResolveRevisionOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		submodules, err := client.Submodules(ctx, repo, head)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.ElementsMatch(t, []gitdomain.Submodule{{
+			Path:     "absolute",
+			URL:      filepath.ToSlash(submodDir),
+			CommitID: submodCommit,
+			Repo:     api.RepoName(filepath.Base(submodDir)),
+		}, {
+			Path:     "nested/relative",
+			URL:      "../" + filepath.Base(submodDir),
+			CommitID: submodCommit,
+			Repo:     api.RepoName(filepath.Base(submodDir)),
+		}}, submodules)
+	})
+}
@@ -0,0 +1,158 @@
+package lfs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"os"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SmudgeTar copies the tar archive read from r to w, replacing pointer files
+// with the LFS objects stored for the repository in dir. Pointers to objects
+// that haven't been fetched are copied as is. Errors of r are returned
+// unchanged.
+func SmudgeTar(dir common.GitDir, w io.Writer, r io.Reader) error {
+	tr := tar.NewReader(r)
+	tw := tar.NewWriter(w)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeReg || hdr.Size >= MaxPointerSize {
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := io.Copy(tw, tr); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		f, err := OpenObject(dir, data)
+		if err != nil {
+			return err
+		}
+		if f == nil {
+			if err := tw.WriteHeader(hdr); err != nil {
+				return err
+			}
+			if _, err := tw.Write(data); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = copyObject(f, func(size int64) (io.Writer, error) {
+			hdr.Size = size
+			return tw, tw.WriteHeader(hdr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// SmudgeZip copies the zip archive read from r to w like SmudgeTar. As zip
+// archives can only be read with random access, r is buffered in a temporary
+// file first.
+func SmudgeZip(dir common.GitDir, w io.Writer, r io.Reader) error {
+	tmp, err := os.CreateTemp(dir.Path("lfs"), "archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	size, err := io.Copy(tmp, r)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return errors.Wrap(err, "reading zip archive")
+	}
+
+	zw := zip.NewWriter(w)
+	if err := zw.SetComment(zr.Comment); err != nil {
+		return err
+	}
+
+	for _, zf := range zr.File {
+		if zf.Mode().IsRegular() && zf.UncompressedSize64 < MaxPointerSize {
+			replaced, err := smudgeZipFile(dir, zw, zf)
+			if err != nil {
+				return err
+			}
+			if replaced {
+				continue
+			}
+		}
+		if err := zw.Copy(zf); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// smudgeZipFile writes the LFS object that zf points to to zw. It returns
+// false if zf isn't a pointer to a stored object.
+func smudgeZipFile(dir common.GitDir, zw *zip.Writer, zf *zip.File) (bool, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return false, err
+	}
+	data, err := io.ReadAll(rc)
+	rc.Close()
+	if err != nil {
+		return false, errors.Wrap(err, "reading zip archive")
+	}
+
+	f, err := OpenObject(dir, data)
+	if err != nil || f == nil {
+		return false, err
+	}
+
+	hdr := zf.FileHeader
+	hdr.CRC32 = 0
+	hdr.CompressedSize64 = 0
+	hdr.UncompressedSize64 = 0
+	err = copyObject(f, func(int64) (io.Writer, error) {
+		return zw.CreateHeader(&hdr)
+	})
+	return true, err
+}
+
+// copyObject copies the object f to the writer returned by create, which is
+// passed the size of the object, and closes f.
+func copyObject(f *os.File, create func(size int64) (io.Writer, error)) error {
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	w, err := create(fi.Size())
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, f)
+	return err
+}
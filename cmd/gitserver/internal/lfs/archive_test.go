@@ -0,0 +1,93 @@
+package lfs
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"io"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+)
+
+func TestSmudgeTar(t *testing.T) {
+	dir, want := makeSmudgeRepo(t)
+
+	var out bytes.Buffer
+	require.NoError(t, SmudgeTar(dir, &out, bytes.NewReader(gitArchive(t, dir, "tar"))))
+
+	got := map[string]string{}
+	tr := tar.NewReader(&out)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), hdr.Size)
+		got[hdr.Name] = string(data)
+	}
+	require.Equal(t, want, got)
+}
+
+func TestSmudgeZip(t *testing.T) {
+	dir, want := makeSmudgeRepo(t)
+
+	var out bytes.Buffer
+	require.NoError(t, SmudgeZip(dir, &out, bytes.NewReader(gitArchive(t, dir, "zip"))))
+
+	zr, err := zip.NewReader(bytes.NewReader(out.Bytes()), int64(out.Len()))
+	require.NoError(t, err)
+	// git archive stores the commit ID in the comment.
+	require.Len(t, zr.Comment, 40)
+
+	got := map[string]string{}
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := zf.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		got[zf.Name] = string(data)
+	}
+	require.Equal(t, want, got)
+}
+
+// makeSmudgeRepo returns a repository with a fetched and a missing LFS object,
+// and the files its smudged archives are expected to contain.
+func makeSmudgeRepo(t *testing.T) (common.GitDir, map[string]string) {
+	fetched := "fetched object\n"
+	missing := pointerFile(pointerTo("missing object\n"))
+
+	dir := makeRepo(t, map[string]string{
+		"fetched.txt": pointerFile(pointerTo(fetched)),
+		"sub/missing": missing,
+		"README.md":   "not a pointer\n",
+	})
+	storeObject(t, dir, fetched)
+
+	return dir, map[string]string{
+		"fetched.txt": fetched,
+		"sub/missing": missing,
+		"README.md":   "not a pointer\n",
+	}
+}
+
+func gitArchive(t *testing.T, dir common.GitDir, format string) []byte {
+	cmd := exec.Command("git", "archive", "--format="+format, "HEAD")
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	require.NoError(t, err)
+	return out
+}
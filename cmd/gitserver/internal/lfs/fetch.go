@@ -0,0 +1,363 @@
+package lfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Limits bound the LFS objects that are fetched for a repository.
+type Limits struct {
+	// MaxObjectSize is the size of the largest object that is fetched.
+	MaxObjectSize int64
+	// MaxRepoSize is the maximum total size of the objects stored for a
+	// repository.
+	MaxRepoSize int64
+}
+
+// batchSize is the number of objects requested from the LFS server at once.
+const batchSize = 100
+
+// mediaType is the media type of requests and responses of the LFS batch API.
+const mediaType = "application/vnd.git-lfs+json"
+
+// Fetch downloads the LFS objects referenced by pointer files at HEAD of the
+// repository in dir from the LFS server of remoteURL, and deletes the stored
+// objects that HEAD no longer references. Objects that are already stored or
+// don't fit into limits are skipped. It returns the number of objects that
+// were downloaded.
+func Fetch(ctx context.Context, doer httpcli.Doer, dir common.GitDir, remoteURL *vcs.URL, limits Limits) (int, error) {
+	pointers, err := headPointers(ctx, dir)
+	if err != nil {
+		return 0, err
+	}
+
+	// Objects that are no longer referenced at HEAD are deleted, so that they
+	// don't count towards MaxRepoSize and keep new objects from being fetched.
+	referenced := make(map[string]struct{}, len(pointers))
+	for _, p := range pointers {
+		referenced[p.OID] = struct{}{}
+	}
+	stored, err := pruneObjects(dir, referenced)
+	if err != nil {
+		return 0, errors.Wrap(err, "pruning stored LFS objects")
+	}
+
+	var missing []Pointer
+	for _, p := range pointers {
+		if p.Size > limits.MaxObjectSize || stored+p.Size > limits.MaxRepoSize {
+			continue
+		}
+		if _, err := os.Stat(ObjectPath(dir, p.OID)); err == nil {
+			continue
+		}
+		missing = append(missing, p)
+		stored += p.Size
+	}
+	if len(missing) == 0 {
+		return 0, nil
+	}
+
+	c, err := newBatchClient(doer, remoteURL)
+	if err != nil {
+		return 0, err
+	}
+
+	fetched := 0
+	for len(missing) > 0 {
+		n := len(missing)
+		if n > batchSize {
+			n = batchSize
+		}
+		requested := make(map[string]Pointer, n)
+		for _, p := range missing[:n] {
+			requested[p.OID] = p
+		}
+		objects, err := c.batch(ctx, missing[:n])
+		if err != nil {
+			return fetched, err
+		}
+		missing = missing[n:]
+
+		for _, o := range objects {
+			// Objects the server doesn't know about are reported per object
+			// and skipped, they shouldn't stop us from fetching the others.
+			p, ok := requested[o.OID]
+			if !ok || o.Error != nil || o.Actions.Download == nil {
+				continue
+			}
+			delete(requested, o.OID)
+			if err := c.download(ctx, dir, p, o.Actions.Download); err != nil {
+				return fetched, errors.Wrapf(err, "downloading LFS object %s", o.OID)
+			}
+			fetched++
+		}
+	}
+
+	return fetched, nil
+}
+
+// headPointers returns the distinct pointers of the files at HEAD of the
+// repository in dir.
+func headPointers(ctx context.Context, dir common.GitDir) ([]Pointer, error) {
+	// Empty repositories don't have a HEAD to list.
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "HEAD^{tree}")
+	dir.Set(cmd)
+	if err := cmd.Run(); err != nil {
+		return nil, nil
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "ls-tree", "-r", "-l", "-z", "HEAD")
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, errors.Wrap(err, "listing files at HEAD")
+	}
+
+	// Only small blobs can be pointers, so that's all we have to read.
+	seen := map[string]struct{}{}
+	var candidates []string
+	for _, entry := range bytes.Split(out, []byte{0}) {
+		// Entries are formatted as "<mode> <type> <oid> <size>\t<path>".
+		info, _, ok := bytes.Cut(entry, []byte{'\t'})
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(string(info))
+		if len(fields) != 4 || fields[1] != "blob" {
+			continue
+		}
+		size, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil || size >= MaxPointerSize {
+			continue
+		}
+		if _, ok := seen[fields[2]]; ok {
+			continue
+		}
+		seen[fields[2]] = struct{}{}
+		candidates = append(candidates, fields[2])
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "cat-file", "--batch")
+	dir.Set(cmd)
+	cmd.Stdin = strings.NewReader(strings.Join(candidates, "\n") + "\n")
+	out, err = cmd.Output()
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pointer candidates")
+	}
+
+	var pointers []Pointer
+	found := map[string]struct{}{}
+	r := bufio.NewReader(bytes.NewReader(out))
+	for range candidates {
+		// Each blob is formatted as "<oid> <type> <size>\n<contents>\n".
+		header, err := r.ReadString('\n')
+		if err != nil {
+			return nil, errors.Wrap(err, "reading pointer candidates")
+		}
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			return nil, errors.Errorf("unexpected cat-file output %q", header)
+		}
+		size, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, errors.Errorf("unexpected cat-file output %q", header)
+		}
+		blob := make([]byte, size+1)
+		if _, err := io.ReadFull(r, blob); err != nil {
+			return nil, errors.Wrap(err, "reading pointer candidates")
+		}
+
+		p, ok := ParsePointer(blob[:size])
+		if !ok {
+			continue
+		}
+		if _, ok := found[p.OID]; ok {
+			continue
+		}
+		found[p.OID] = struct{}{}
+		pointers = append(pointers, p)
+	}
+
+	return pointers, nil
+}
+
+// batchClient talks to the batch API of an LFS server.
+type batchClient struct {
+	doer     httpcli.Doer
+	endpoint string
+	user     *url.Userinfo
+}
+
+// newBatchClient returns a client for the LFS server of remoteURL, at the
+// location git-lfs derives when it isn't configured explicitly.
+func newBatchClient(doer httpcli.Doer, remoteURL *vcs.URL) (*batchClient, error) {
+	if remoteURL.Scheme != "http" && remoteURL.Scheme != "https" {
+		return nil, errors.Errorf("LFS objects can only be fetched over HTTP(S), not %q", remoteURL.Scheme)
+	}
+
+	u := remoteURL.URL
+	user := u.User
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(u.Path, ".git") {
+		u.Path += ".git"
+	}
+	u.RawPath = ""
+	u.Path += "/info/lfs/objects/batch"
+
+	return &batchClient{doer: doer, endpoint: u.String(), user: user}, nil
+}
+
+type batchRequest struct {
+	Operation string         `json:"operation"`
+	Transfers []string       `json:"transfers"`
+	Objects   []batchPointer `json:"objects"`
+}
+
+type batchPointer struct {
+	OID  string `json:"oid"`
+	Size int64  `json:"size"`
+}
+
+type batchResponse struct {
+	Objects []batchObject `json:"objects"`
+}
+
+type batchObject struct {
+	OID     string `json:"oid"`
+	Size    int64  `json:"size"`
+	Actions struct {
+		Download *batchAction `json:"download"`
+	} `json:"actions"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type batchAction struct {
+	Href   string            `json:"href"`
+	Header map[string]string `json:"header"`
+}
+
+// batch requests the download actions of objects.
+func (c *batchClient) batch(ctx context.Context, objects []Pointer) ([]batchObject, error) {
+	req := batchRequest{Operation: "download", Transfers: []string{"basic"}}
+	for _, p := range objects {
+		req.Objects = append(req.Objects, batchPointer{OID: p.OID, Size: p.Size})
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Accept", mediaType)
+	httpReq.Header.Set("Content-Type", mediaType)
+	if c.user != nil {
+		password, _ := c.user.Password()
+		httpReq.SetBasicAuth(c.user.Username(), password)
+	}
+
+	resp, err := c.doer.Do(httpReq)
+	if err != nil {
+		return nil, errors.Wrap(err, "requesting LFS batch")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("LFS batch request failed with status %d", resp.StatusCode)
+	}
+
+	var batchResp batchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batchResp); err != nil {
+		return nil, errors.Wrap(err, "decoding LFS batch response")
+	}
+	return batchResp.Objects, nil
+}
+
+// download stores the object of p, which is verified against p before it is
+// moved into place.
+func (c *batchClient) download(ctx context.Context, dir common.GitDir, p Pointer, action *batchAction) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, action.Href, nil)
+	if err != nil {
+		return err
+	}
+	for k, v := range action.Header {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.doer.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	tmpDir := dir.Path("lfs", "tmp")
+	if err := os.MkdirAll(tmpDir, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(tmpDir, p.OID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	// Read one byte more than expected, so that oversized objects are caught
+	// without reading all of them.
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(resp.Body, p.Size+1))
+	if err != nil {
+		return err
+	}
+	if n > p.Size {
+		return errors.Errorf("object is larger than %d bytes", p.Size)
+	}
+	if n < p.Size {
+		return errors.Errorf("expected %d bytes, got %d", p.Size, n)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); sum != p.OID {
+		return errors.Errorf("object has checksum %s", sum)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	path := ObjectPath(dir, p.OID)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
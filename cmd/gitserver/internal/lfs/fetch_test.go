@@ -0,0 +1,191 @@
+package lfs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// lfsServer is a fake LFS server serving the objects with the given contents.
+type lfsServer struct {
+	*httptest.Server
+	objects map[string]string
+	batches int
+}
+
+func newLFSServer(t *testing.T, contents ...string) *lfsServer {
+	s := &lfsServer{objects: map[string]string{}}
+	for _, c := range contents {
+		s.objects[pointerTo(c).OID] = c
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/org/repo.git/info/lfs/objects/batch", func(w http.ResponseWriter, r *http.Request) {
+		s.batches++
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var req batchRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var resp batchResponse
+		for _, o := range req.Objects {
+			obj := batchObject{OID: o.OID, Size: o.Size}
+			if _, ok := s.objects[o.OID]; ok {
+				obj.Actions.Download = &batchAction{
+					Href:   s.URL + "/objects/" + o.OID,
+					Header: map[string]string{"Authorization": "Bearer download"},
+				}
+			} else {
+				obj.Error = &struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				}{Code: 404, Message: "not found"}
+			}
+			resp.Objects = append(resp.Objects, obj)
+		}
+		w.Header().Set("Content-Type", mediaType)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/objects/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer download" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		c, ok := s.objects[strings.TrimPrefix(r.URL.Path, "/objects/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(c))
+	})
+
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *lfsServer) remoteURL(t *testing.T) *vcs.URL {
+	u, err := vcs.ParseURL(strings.Replace(s.URL, "http://", "http://user:secret@", 1) + "/org/repo")
+	require.NoError(t, err)
+	return u
+}
+
+func TestFetch(t *testing.T) {
+	small := "small object\n"
+	large := strings.Repeat("large object\n", 100)
+	unknown := "unknown to the server\n"
+
+	dir := makeRepo(t, map[string]string{
+		"small.txt":     pointerFile(pointerTo(small)),
+		"dir/small.txt": pointerFile(pointerTo(small)),
+		"large.bin":     pointerFile(pointerTo(large)),
+		"unknown.txt":   pointerFile(pointerTo(unknown)),
+		"README.md":     "not a pointer\n",
+	})
+	s := newLFSServer(t, small, large)
+
+	limits := Limits{MaxObjectSize: 1000, MaxRepoSize: 10000}
+	n, err := Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), limits)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	data, err := os.ReadFile(ObjectPath(dir, pointerTo(small).OID))
+	require.NoError(t, err)
+	require.Equal(t, small, string(data))
+	_, err = os.Stat(ObjectPath(dir, pointerTo(large).OID))
+	require.True(t, os.IsNotExist(err))
+
+	// Stored objects aren't fetched again.
+	s.objects = map[string]string{}
+	batches := s.batches
+	n, err = Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), Limits{MaxObjectSize: 1000, MaxRepoSize: 1000})
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.Equal(t, batches+1, s.batches)
+
+	// Nothing is requested once the repo size limit is reached.
+	n, err = Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), Limits{MaxObjectSize: 1000, MaxRepoSize: int64(len(small))})
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.Equal(t, batches+1, s.batches)
+}
+
+func TestFetch_PrunesUnreferencedObjects(t *testing.T) {
+	before := "content before\n"
+	after := "content after!\n"
+
+	dir := makeRepo(t, map[string]string{
+		"file.txt": pointerFile(pointerTo(before)),
+	})
+	s := newLFSServer(t, before, after)
+
+	// There is only room for one of the objects.
+	limits := Limits{MaxObjectSize: 1000, MaxRepoSize: int64(len(after))}
+	n, err := Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), limits)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	root := filepath.Dir(string(dir))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "file.txt"), []byte(pointerFile(pointerTo(after))), 0o644))
+	cmd := exec.Command("git", "commit", "-am", "update")
+	cmd.Dir = root
+	cmd.Env = append(os.Environ(),
+		"GIT_CONFIG_GLOBAL=/dev/null",
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_AUTHOR_NAME=a",
+		"GIT_AUTHOR_EMAIL=a@a.com",
+		"GIT_COMMITTER_NAME=a",
+		"GIT_COMMITTER_EMAIL=a@a.com",
+	)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// The object that HEAD no longer references makes room for the new one.
+	n, err = Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), limits)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	_, err = os.Stat(ObjectPath(dir, pointerTo(before).OID))
+	require.True(t, os.IsNotExist(err))
+	data, err := os.ReadFile(ObjectPath(dir, pointerTo(after).OID))
+	require.NoError(t, err)
+	require.Equal(t, after, string(data))
+}
+
+func TestFetch_CorruptObject(t *testing.T) {
+	content := "expected content\n"
+	dir := makeRepo(t, map[string]string{
+		"file.txt": pointerFile(pointerTo(content)),
+	})
+	s := newLFSServer(t, content)
+	s.objects[pointerTo(content).OID] = "corrupt content!\n"
+
+	_, err := Fetch(context.Background(), http.DefaultClient, dir, s.remoteURL(t), Limits{MaxObjectSize: 1000, MaxRepoSize: 1000})
+	require.Error(t, err)
+
+	_, err = os.Stat(ObjectPath(dir, pointerTo(content).OID))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestFetch_EmptyRepo(t *testing.T) {
+	dir := makeRepo(t, nil)
+	n, err := Fetch(context.Background(), http.DefaultClient, dir, &vcs.URL{}, Limits{MaxObjectSize: 1000, MaxRepoSize: 1000})
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+}
@@ -0,0 +1,135 @@
+// Package lfs implements support for Git LFS objects in gitserver. Objects
+// referenced by LFS pointer files are fetched from the LFS server of the code
+// host and stored alongside the repository, so that the real contents of files
+// can be served instead of their pointers.
+package lfs
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+// MaxPointerSize is the size from which blobs are never parsed as pointer
+// files. It is the same cutoff git-lfs uses.
+const MaxPointerSize = 1024
+
+// Pointer is a parsed Git LFS pointer file.
+type Pointer struct {
+	// OID is the hex encoded SHA-256 of the object.
+	OID string
+	// Size is the size of the object in bytes.
+	Size int64
+}
+
+var (
+	pointerVersionPrefix = []byte("version https://git-lfs.github.com/spec/v1\n")
+	pointerOIDRe         = lazyregexp.New(`(?m)^oid sha256:([0-9a-f]{64})$`)
+	pointerSizeRe        = lazyregexp.New(`(?m)^size (\d+)$`)
+)
+
+// ParsePointer parses b as a Git LFS pointer file. It returns false if b is
+// not a pointer.
+func ParsePointer(b []byte) (Pointer, bool) {
+	if len(b) >= MaxPointerSize || !bytes.HasPrefix(b, pointerVersionPrefix) {
+		return Pointer{}, false
+	}
+
+	oid := pointerOIDRe.FindSubmatch(b)
+	size := pointerSizeRe.FindSubmatch(b)
+	if oid == nil || size == nil {
+		return Pointer{}, false
+	}
+
+	n, err := strconv.ParseInt(string(size[1]), 10, 64)
+	if err != nil || n < 0 {
+		return Pointer{}, false
+	}
+
+	return Pointer{OID: string(oid[1]), Size: n}, true
+}
+
+// objectsDir returns the directory that LFS objects of the repository in dir
+// are stored in. It is laid out like the one of git-lfs.
+func objectsDir(dir common.GitDir) string {
+	return dir.Path("lfs", "objects")
+}
+
+// ObjectPath returns the path the LFS object with the given OID is stored at.
+func ObjectPath(dir common.GitDir, oid string) string {
+	return filepath.Join(objectsDir(dir), oid[0:2], oid[2:4], oid)
+}
+
+// HasObjects returns true if any LFS objects have been fetched for the
+// repository in dir.
+func HasObjects(dir common.GitDir) bool {
+	_, err := os.Stat(objectsDir(dir))
+	return err == nil
+}
+
+// Open opens the LFS object that p points to. It returns an error satisfying
+// os.IsNotExist if the object hasn't been fetched.
+func Open(dir common.GitDir, p Pointer) (*os.File, error) {
+	f, err := os.Open(ObjectPath(dir, p.OID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects are verified when they are fetched, so a matching size is
+	// enough to catch pointers that lie about their object.
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() != p.Size {
+		f.Close()
+		return nil, &os.PathError{Op: "open", Path: f.Name(), Err: os.ErrNotExist}
+	}
+
+	return f, nil
+}
+
+// OpenObject opens the LFS object that the pointer file data points to. It
+// returns nil if data is not a pointer or the object hasn't been fetched.
+func OpenObject(dir common.GitDir, data []byte) (*os.File, error) {
+	p, ok := ParsePointer(data)
+	if !ok {
+		return nil, nil
+	}
+	f, err := Open(dir, p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return f, err
+}
+
+// pruneObjects deletes the LFS objects stored for the repository in dir that
+// aren't in keep, and returns the total size of the remaining ones.
+func pruneObjects(dir common.GitDir, keep map[string]struct{}) (int64, error) {
+	var size int64
+	err := filepath.Walk(objectsDir(dir), func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		if _, ok := keep[fi.Name()]; !ok {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return nil
+		}
+		size += fi.Size()
+		return nil
+	})
+	return size, err
+}
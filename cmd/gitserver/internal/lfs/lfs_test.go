@@ -0,0 +1,134 @@
+package lfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+)
+
+func TestParsePointer(t *testing.T) {
+	oid := "4d7a214614ab2935c943f9e0ff69d22eadbb8f32b1258daaa5e2ca24d17e2393"
+
+	for _, tc := range []struct {
+		name string
+		data string
+		want Pointer
+		ok   bool
+	}{
+		{
+			name: "pointer",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\nsize 12345\n",
+			want: Pointer{OID: oid, Size: 12345},
+			ok:   true,
+		},
+		{
+			name: "extra keys",
+			data: "version https://git-lfs.github.com/spec/v1\next-0-foo sha256:" + oid + "\noid sha256:" + oid + "\nsize 3\n",
+			want: Pointer{OID: oid, Size: 3},
+			ok:   true,
+		},
+		{
+			name: "missing version",
+			data: "oid sha256:" + oid + "\nsize 12345\n",
+		},
+		{
+			name: "missing size",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:" + oid + "\n",
+		},
+		{
+			name: "short oid",
+			data: "version https://git-lfs.github.com/spec/v1\noid sha256:4d7a\nsize 12345\n",
+		},
+		{
+			name: "text file",
+			data: "hello world\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ParsePointer([]byte(tc.data))
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	dir := common.GitDir(t.TempDir())
+	p := storeObject(t, dir, "hello world\n")
+
+	f, err := Open(dir, p)
+	require.NoError(t, err)
+	f.Close()
+
+	// A pointer with the wrong size must not open the object.
+	_, err = Open(dir, Pointer{OID: p.OID, Size: p.Size + 1})
+	require.True(t, os.IsNotExist(err))
+
+	_, err = Open(dir, pointerTo("not stored"))
+	require.True(t, os.IsNotExist(err))
+}
+
+// pointerTo returns the pointer to an LFS object with the given content.
+func pointerTo(content string) Pointer {
+	sum := sha256.Sum256([]byte(content))
+	return Pointer{OID: hex.EncodeToString(sum[:]), Size: int64(len(content))}
+}
+
+// pointerFile returns the pointer file of p.
+func pointerFile(p Pointer) string {
+	return fmt.Sprintf("version https://git-lfs.github.com/spec/v1\noid sha256:%s\nsize %d\n", p.OID, p.Size)
+}
+
+// storeObject stores an LFS object with the given content for the repository
+// in dir.
+func storeObject(t *testing.T, dir common.GitDir, content string) Pointer {
+	t.Helper()
+
+	p := pointerTo(content)
+	path := ObjectPath(dir, p.OID)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return p
+}
+
+// makeRepo creates a Git repository with the given files committed at HEAD, or
+// an empty one if there are none, and returns its Git directory.
+func makeRepo(t *testing.T, files map[string]string) common.GitDir {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	commands := [][]string{{"init"}}
+	if len(files) > 0 {
+		commands = append(commands, []string{"add", "."}, []string{"commit", "-m", "init"})
+	}
+	for _, args := range commands {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = root
+		cmd.Env = append(os.Environ(),
+			"GIT_CONFIG_GLOBAL=/dev/null",
+			"GIT_CONFIG_NOSYSTEM=1",
+			"GIT_AUTHOR_NAME=a",
+			"GIT_AUTHOR_EMAIL=a@a.com",
+			"GIT_COMMITTER_NAME=a",
+			"GIT_COMMITTER_EMAIL=a@a.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return common.GitDir(filepath.Join(root, ".git"))
+}
@@ -0,0 +1,270 @@
+package internal
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// maintenanceStrategy is a set of git commands sg maintenance runs to optimize
+// a repository. Which strategy is used for a repository is configured with the
+// gitMaintenance site configuration.
+type maintenanceStrategy string
+
+const (
+	// maintenanceStrategyFull repacks all objects into a single packfile with
+	// sg_maintenance.sh. It is the most thorough strategy, but its cost grows
+	// with the size of the repository.
+	maintenanceStrategyFull maintenanceStrategy = "full"
+	// maintenanceStrategyGeometric only combines packfiles until the remaining
+	// ones form a geometric progression by size, so the big packfiles of large
+	// repositories are rarely rewritten.
+	maintenanceStrategyGeometric maintenanceStrategy = "geometric"
+	// maintenanceStrategyIncremental maintains a multi-pack-index and repacks
+	// small packfiles in batches, the way "git maintenance" does for large
+	// repositories.
+	maintenanceStrategyIncremental maintenanceStrategy = "incremental"
+	// maintenanceStrategyCommitGraph only keeps the commit-graph up to date. It
+	// suits small or idle repositories where repacking has little benefit.
+	maintenanceStrategyCommitGraph maintenanceStrategy = "commit-graph"
+)
+
+func parseMaintenanceStrategy(s string) (maintenanceStrategy, error) {
+	switch ms := maintenanceStrategy(s); ms {
+	case maintenanceStrategyFull, maintenanceStrategyGeometric, maintenanceStrategyIncremental, maintenanceStrategyCommitGraph:
+		return ms, nil
+	case "":
+		return maintenanceStrategyFull, nil
+	default:
+		return "", errors.Errorf("unknown git maintenance strategy %q", s)
+	}
+}
+
+// needed reports whether the repository in dir needs maintenance with this
+// strategy and the reason why.
+func (s maintenanceStrategy) needed(dir common.GitDir) (bool, string, error) {
+	switch s {
+	case maintenanceStrategyIncremental:
+		return needsIncrementalMaintenance(dir)
+	case maintenanceStrategyCommitGraph:
+		return needsCommitGraphRefresh(dir)
+	default:
+		return needsMaintenance(dir)
+	}
+}
+
+// script returns the shell script that performs this strategy on the
+// repository in dir.
+func (s maintenanceStrategy) script(dir common.GitDir) string {
+	switch s {
+	case maintenanceStrategyGeometric:
+		// Git never writes bitmaps for the promisor packs of partial clones.
+		repack := "git repack -d -l --geometric=2 --write-midx --write-bitmap-index"
+		if git.IsPartialClone(dir) {
+			repack = "git repack -d -l --geometric=2 --write-midx"
+		}
+		return strings.Join([]string{
+			"set -xe",
+			"git pack-refs --all --prune",
+			"git reflog expire --all",
+			repack,
+			"git commit-graph write --reachable --changed-paths",
+		}, "\n")
+	case maintenanceStrategyIncremental:
+		// The loose-objects task packs loose objects into a new packfile. The
+		// incremental-repack task writes and expires the multi-pack-index and
+		// repacks a batch of small packfiles. It fails without packfiles, EG in
+		// empty repositories.
+		return strings.Join([]string{
+			"set -xe",
+			"git pack-refs --all --prune",
+			"git maintenance run --task=loose-objects",
+			"if ls objects/pack/*.pack >/dev/null 2>&1; then git maintenance run --task=incremental-repack; fi",
+			"git commit-graph write --reachable --changed-paths",
+		}, "\n")
+	case maintenanceStrategyCommitGraph:
+		return strings.Join([]string{
+			"set -xe",
+			"git commit-graph write --reachable --changed-paths",
+		}, "\n")
+	default:
+		return sgMaintenanceScript
+	}
+}
+
+// needsIncrementalMaintenance is like needsMaintenance, but does not expect
+// bitmaps, which are not written by incremental repacks. Instead, it expects a
+// multi-pack-index if the repository has packfiles.
+func needsIncrementalMaintenance(dir common.GitDir) (bool, string, error) {
+	packs, err := filepath.Glob(dir.Path("objects", "pack", "*.pack"))
+	if err != nil {
+		return false, "", err
+	}
+	if len(packs) > 0 {
+		if _, err := os.Stat(dir.Path("objects", "pack", "multi-pack-index")); errors.Is(err, fs.ErrNotExist) {
+			return true, "multi_pack_index", nil
+		} else if err != nil {
+			return false, "", err
+		}
+	}
+
+	hasCg, err := hasCommitGraph(dir)
+	if err != nil {
+		return false, "", err
+	}
+	if !hasCg {
+		return true, "commit_graph", nil
+	}
+
+	tooManyPf, err := tooManyPackfiles(dir, autoPackLimit)
+	if err != nil {
+		return false, "", err
+	}
+	if tooManyPf {
+		return true, "packfiles", nil
+	}
+
+	tooManyLO, err := tooManyLooseObjects(dir, looseObjectsLimit)
+	if err != nil {
+		return false, "", err
+	}
+	if tooManyLO {
+		return true, "loose_objects", nil
+	}
+	return false, "skipped", nil
+}
+
+// needsCommitGraphRefresh reports whether the commit-graph is missing or older
+// than the newest packfile, in which case it doesn't cover all commits.
+func needsCommitGraphRefresh(dir common.GitDir) (bool, string, error) {
+	cg, err := os.Stat(dir.Path("objects", "info", "commit-graph"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return true, "commit_graph", nil
+	} else if err != nil {
+		return false, "", err
+	}
+
+	packs, err := filepath.Glob(dir.Path("objects", "pack", "*.pack"))
+	if err != nil {
+		return false, "", err
+	}
+	for _, p := range packs {
+		fi, err := os.Stat(p)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return false, "", err
+		}
+		if fi.ModTime().After(cg.ModTime()) {
+			return true, "commit_graph_stale", nil
+		}
+	}
+	return false, "skipped", nil
+}
+
+// maintenancePolicy selects a maintenance strategy for the repositories it
+// matches.
+type maintenancePolicy struct {
+	strategy    maintenanceStrategy
+	repoPattern *regexp.Regexp
+	minSize     int64
+	maxSize     int64
+}
+
+func (p maintenancePolicy) matches(repo api.RepoName, sizeBytes int64) bool {
+	if p.repoPattern != nil && !p.repoPattern.MatchString(string(repo)) {
+		return false
+	}
+	if sizeBytes < p.minSize {
+		return false
+	}
+	return p.maxSize == 0 || sizeBytes <= p.maxSize
+}
+
+// maintenancePolicies is the parsed gitMaintenance site configuration.
+type maintenancePolicies struct {
+	defaultStrategy maintenanceStrategy
+	policies        []maintenancePolicy
+}
+
+// strategyFor returns the strategy of the first policy matching the
+// repository, or the default strategy if none matches.
+func (mp *maintenancePolicies) strategyFor(repo api.RepoName, sizeBytes int64) maintenanceStrategy {
+	for _, p := range mp.policies {
+		if p.matches(repo, sizeBytes) {
+			return p.strategy
+		}
+	}
+	return mp.defaultStrategy
+}
+
+func parseMaintenancePolicies(c *schema.GitMaintenance) (*maintenancePolicies, error) {
+	mp := &maintenancePolicies{defaultStrategy: maintenanceStrategyFull}
+	if c == nil {
+		return mp, nil
+	}
+
+	var err error
+	if mp.defaultStrategy, err = parseMaintenanceStrategy(c.DefaultStrategy); err != nil {
+		return nil, err
+	}
+
+	for _, p := range c.Policies {
+		strategy, err := parseMaintenanceStrategy(p.Strategy)
+		if err != nil {
+			return nil, err
+		}
+		policy := maintenancePolicy{
+			strategy: strategy,
+			minSize:  int64(p.MinRepoSizeBytes),
+			maxSize:  int64(p.MaxRepoSizeBytes),
+		}
+		if p.RepoPattern != "" {
+			if policy.repoPattern, err = regexp.Compile(p.RepoPattern); err != nil {
+				return nil, errors.Wrapf(err, "invalid repoPattern %q", p.RepoPattern)
+			}
+		}
+		mp.policies = append(mp.policies, policy)
+	}
+	return mp, nil
+}
+
+var gitMaintenancePolicies = conf.Cached(func() *maintenancePolicies {
+	mp, err := parseMaintenancePolicies(conf.ExperimentalFeatures().GitMaintenance)
+	if err != nil {
+		log.Scoped("gitMaintenance").Warn("invalid gitMaintenance configuration, using the full strategy for all repositories", log.Error(err))
+		return &maintenancePolicies{defaultStrategy: maintenanceStrategyFull}
+	}
+	return mp
+})
+
+// maintenanceStrategyForRepo picks the maintenance strategy for repo from the
+// gitMaintenance site configuration. The policies are matched against the
+// repository size gitserver recorded in the database.
+func maintenanceStrategyForRepo(ctx context.Context, logger log.Logger, db database.DB, repo api.RepoName) maintenanceStrategy {
+	mp := gitMaintenancePolicies()
+	if len(mp.policies) == 0 {
+		return mp.defaultStrategy
+	}
+
+	gr, err := db.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		logger.Warn("failed to get repo stats for choosing a maintenance strategy", log.String("repo", string(repo)), log.Error(err))
+		return mp.defaultStrategy
+	}
+	return mp.strategyFor(repo, gr.RepoSizeBytes)
+}
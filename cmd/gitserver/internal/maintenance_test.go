@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMaintenancePolicies(t *testing.T) {
+	mp, err := parseMaintenancePolicies(&schema.GitMaintenance{
+		DefaultStrategy: "geometric",
+		Policies: []*schema.GitMaintenancePolicy{
+			{RepoPattern: `^github\.com/sourcegraph/monorepo$`, Strategy: "incremental"},
+			{MinRepoSizeBytes: 1000, Strategy: "full"},
+			{MaxRepoSizeBytes: 10, Strategy: "commit-graph"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		repo api.RepoName
+		size int64
+		want maintenanceStrategy
+	}{
+		{"github.com/sourcegraph/monorepo", 5, maintenanceStrategyIncremental},
+		{"github.com/sourcegraph/other", 5000, maintenanceStrategyFull},
+		{"github.com/sourcegraph/other", 5, maintenanceStrategyCommitGraph},
+		{"github.com/sourcegraph/other", 500, maintenanceStrategyGeometric},
+	} {
+		if got := mp.strategyFor(tc.repo, tc.size); got != tc.want {
+			t.Errorf("strategyFor(%s, %d): want %s, got %s", tc.repo, tc.size, tc.want, got)
+		}
+	}
+
+	if _, err := parseMaintenancePolicies(&schema.GitMaintenance{DefaultStrategy: "aggressive"}); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+
+	mp, err = parseMaintenancePolicies(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := mp.strategyFor("github.com/sourcegraph/other", 5); got != maintenanceStrategyFull {
+		t.Errorf("want the full strategy without configuration, got %s", got)
+	}
+}
+
+func TestRunMaintenance(t *testing.T) {
+	logger := logtest.Scoped(t)
+
+	for _, tc := range []struct {
+		strategy   maintenanceStrategy
+		wantReason string
+	}{
+		{maintenanceStrategyFull, "bitmap"},
+		{maintenanceStrategyGeometric, "bitmap"},
+		{maintenanceStrategyIncremental, "commit_graph"},
+		{maintenanceStrategyCommitGraph, "commit_graph"},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			dir := t.TempDir()
+			gitDir := prepareEmptyGitRepo(t, dir)
+
+			script := `echo acont > afile
+git add afile
+git commit -am amsg
+`
+			cmd := exec.Command("/bin/sh", "-euxc", script)
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("out=%s, err=%s", out, err)
+			}
+
+			ran, reason, err := runMaintenance(logger, gitDir, tc.strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ran {
+				t.Fatal("expected maintenance to run")
+			}
+			if reason != tc.wantReason {
+				t.Fatalf("want reason %s, got %s", tc.wantReason, reason)
+			}
+
+			// The strategy leaves the repository in a state that doesn't need
+			// maintenance.
+			needed, reason, err := tc.strategy.needed(gitDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if needed {
+				t.Fatalf("repo still needs maintenance after running it: %s", reason)
+			}
+
+			ran, _, err = runMaintenance(logger, gitDir, tc.strategy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ran {
+				t.Fatal("expected maintenance to be skipped")
+			}
+		})
+	}
+}
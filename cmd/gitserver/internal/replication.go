@@ -0,0 +1,246 @@
+package internal
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+var (
+	replicaLag = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "src_gitserver_replica_lag_seconds",
+		Help:    "Time between a fetch of a repo on its primary gitserver and a replica catching up with it",
+		Buckets: []float64{1, 10, 30, 60, 300, 900, 1800, 3600, 7200, 21600, 86400},
+	})
+	replicaMaxLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "src_gitserver_replica_max_lag_seconds",
+		Help: "Time since the primary fetched the repo whose replica on this gitserver is furthest behind",
+	})
+	replicaSyncCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "src_gitserver_replica_sync",
+		Help: "Incremented each time a replica is synced from its primary gitserver",
+	}, []string{"success"})
+)
+
+// replicaSyncOverlap is how far back each run of the replica syncer looks for
+// repos that were fetched by their primary before the previous run started.
+// It covers fetches that finished before the previous run but were only
+// written to the database after it had looked at them.
+const replicaSyncOverlap = 5 * time.Minute
+
+// NewReplicaSyncer returns a periodic goroutine that keeps the replicas of repos
+// whose primary is another gitserver in sync. Replicas are cloned and fetched
+// from the primary over its /git endpoint, never from the code host, and never
+// touch the state of the repo in the database, which is owned by the primary.
+//
+// The first run, and every run after the gitserver addresses have changed,
+// looks at all repos. Other runs only look at the repos that have been fetched
+// by their primary since the previous run, and the replicas that failed to
+// sync before.
+func NewReplicaSyncer(
+	ctx context.Context,
+	logger log.Logger,
+	db database.DB,
+	locker RepositoryLocker,
+	shardID string,
+	reposDir string,
+	interval time.Duration,
+) goroutine.BackgroundRoutine {
+	s := &replicaSyncer{
+		logger:   logger.Scoped("replicaSyncer"),
+		db:       db,
+		locker:   locker,
+		shardID:  shardID,
+		reposDir: reposDir,
+		behind:   make(map[api.RepoName]time.Time),
+	}
+
+	return goroutine.NewPeriodicGoroutine(
+		actor.WithInternalActor(ctx),
+		goroutine.HandlerFunc(func(ctx context.Context) error {
+			return s.sync(ctx, gitserver.NewGitserverAddresses(conf.Get()))
+		}),
+		goroutine.WithName("gitserver.replica-syncer"),
+		goroutine.WithDescription("syncs replicas of repos from their primary gitserver"),
+		goroutine.WithInterval(interval),
+	)
+}
+
+type replicaSyncer struct {
+	logger   log.Logger
+	db       database.DB
+	locker   RepositoryLocker
+	shardID  string
+	reposDir string
+
+	// previousAddrs identifies the gitserver addresses and replication factor
+	// of the previous run.
+	previousAddrs string
+	// syncedUntil is the time up to which fetches by the primaries have been
+	// synced to the replicas, except for those in behind.
+	syncedUntil time.Time
+	// behind maps the replicas that failed to sync to the time their primary
+	// last fetched them.
+	behind map[api.RepoName]time.Time
+}
+
+func (s *replicaSyncer) sync(ctx context.Context, gitServerAddrs gitserver.GitserverAddresses) error {
+	if gitServerAddrs.ReplicationFactor < 2 {
+		s.previousAddrs = ""
+		s.behind = make(map[api.RepoName]time.Time)
+		replicaMaxLag.Set(0)
+		return nil
+	}
+
+	currentAddrs := strings.Join(gitServerAddrs.Addresses, ",") + ";" + strconv.Itoa(gitServerAddrs.ReplicationFactor)
+	if currentAddrs != s.previousAddrs {
+		// The replicas of most repos have moved, so look at all of them.
+		s.syncedUntil = time.Time{}
+		s.behind = make(map[api.RepoName]time.Time)
+	}
+	s.previousAddrs = currentAddrs
+
+	start := time.Now()
+	options := database.IterateRepoGitserverStatusOptions{
+		FetchedAfter: s.syncedUntil,
+		BatchSize:    500,
+	}
+	for {
+		repos, nextRepo, err := s.db.GitserverRepos().IterateRepoGitserverStatus(ctx, options)
+		if err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if repo.CloneStatus != types.CloneStatusCloned {
+				// There is nothing to sync from until the primary has cloned it.
+				continue
+			}
+			s.syncRepo(ctx, gitServerAddrs, repo.Name, repo.LastFetched)
+		}
+
+		if nextRepo == 0 {
+			break
+		}
+		options.NextCursor = nextRepo
+	}
+
+	for repo, lastFetched := range s.behind {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		s.syncRepo(ctx, gitServerAddrs, repo, lastFetched)
+	}
+
+	var maxLag time.Duration
+	for _, lastFetched := range s.behind {
+		if lag := time.Since(lastFetched); lag > maxLag {
+			maxLag = lag
+		}
+	}
+	replicaMaxLag.Set(maxLag.Seconds())
+
+	s.syncedUntil = start.Add(-replicaSyncOverlap)
+	return nil
+}
+
+// syncRepo syncs the replica of repo from its primary, if this gitserver keeps a
+// replica of it and the replica is older than the last fetch by the primary.
+func (s *replicaSyncer) syncRepo(ctx context.Context, gitServerAddrs gitserver.GitserverAddresses, repo api.RepoName, lastFetched time.Time) {
+	if !isReplicaShard(ctx, s.shardID, repo, gitServerAddrs) {
+		delete(s.behind, repo)
+		return
+	}
+
+	dir := gitserverfs.RepoDirFromName(s.reposDir, repo)
+	if repoCloned(dir) {
+		if synced, err := repoLastFetched(dir); err == nil && !synced.Before(lastFetched) {
+			delete(s.behind, repo)
+			return
+		}
+	}
+
+	primary := addrForRepo(ctx, repo, gitServerAddrs)
+	if err := s.syncReplica(ctx, primary, repo, dir); err != nil {
+		replicaSyncCounter.WithLabelValues("false").Inc()
+		s.logger.Warn("failed to sync replica", log.String("repo", string(repo)), log.String("primary", primary), log.Error(err))
+		s.behind[repo] = lastFetched
+		return
+	}
+
+	replicaSyncCounter.WithLabelValues("true").Inc()
+	replicaLag.Observe(time.Since(lastFetched).Seconds())
+	delete(s.behind, repo)
+}
+
+// syncReplica fetches all refs of repo from the primary gitserver into dir,
+// cloning it first if it is not on disk yet.
+func (s *replicaSyncer) syncReplica(ctx context.Context, primary string, repo api.RepoName, dir common.GitDir) error {
+	lock, ok := s.locker.TryAcquire(dir, "syncing replica")
+	if !ok {
+		return errors.New("another operation is already in progress")
+	}
+	defer lock.Release()
+
+	ctx, cancel := context.WithTimeout(ctx, conf.GitLongCommandTimeout())
+	defer cancel()
+
+	remoteURL := replicaRemoteURL(primary, repo)
+
+	if repoCloned(dir) {
+		cmd := exec.CommandContext(ctx, "git", "fetch", "--prune", remoteURL, "+refs/*:refs/*")
+		dir.Set(cmd)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return &common.GitCommandError{Err: err, Output: string(output)}
+		}
+		return nil
+	}
+
+	// We clone to a temporary location first to avoid having incomplete
+	// clones in the repo tree.
+	tmpDir, err := gitserverfs.TempDir(s.reposDir, "replica-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	tmpPath := filepath.Join(tmpDir, ".git")
+
+	cmd := exec.CommandContext(ctx, "git", "clone", "--mirror", remoteURL, tmpPath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return &common.GitCommandError{Err: err, Output: string(output)}
+	}
+
+	dstPath := string(dir)
+	if err := os.MkdirAll(filepath.Dir(dstPath), os.ModePerm); err != nil {
+		return err
+	}
+	return fileutil.RenameAndSync(tmpPath, dstPath)
+}
+
+// replicaRemoteURL returns the URL under which the primary gitserver serves
+// repo to other gitservers.
+func replicaRemoteURL(primary string, repo api.RepoName) string {
+	return "http://" + primary + "/git/" + string(repo)
+}
@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestReplicaSyncer_SyncReplica(t *testing.T) {
+	ctx := context.Background()
+	repo := api.RepoName("github.com/foo/bar")
+
+	// Set up the primary, which serves its repos over /git like gitserver does.
+	remote := t.TempDir()
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	head := makeSingleCommitRepo(cmd)
+
+	primaryReposDir := t.TempDir()
+	primaryDir := gitserverfs.RepoDirFromName(primaryReposDir, repo)
+	if err := os.MkdirAll(filepath.Dir(string(primaryDir)), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	runCmd(t, remote, "git", "clone", "--mirror", remote, string(primaryDir))
+
+	primary := &Server{Logger: logtest.Scoped(t), ReposDir: primaryReposDir}
+	srv := httptest.NewServer(http.StripPrefix("/git", primary.gitServiceHandler()))
+	t.Cleanup(srv.Close)
+	primaryAddr := strings.TrimPrefix(srv.URL, "http://")
+
+	replicaReposDir := t.TempDir()
+	s := &replicaSyncer{
+		logger:   logtest.Scoped(t),
+		locker:   NewRepositoryLocker(),
+		reposDir: replicaReposDir,
+		behind:   make(map[api.RepoName]time.Time),
+	}
+	dir := gitserverfs.RepoDirFromName(replicaReposDir, repo)
+
+	// The first sync clones the replica.
+	if err := s.syncReplica(ctx, primaryAddr, repo, dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := runCmd(t, string(dir), "git", "rev-parse", "HEAD"); got != head {
+		t.Fatalf("unexpected HEAD of replica after clone: want %q, got %q", head, got)
+	}
+
+	// The primary fetches a new commit, which the next sync fetches from it.
+	cmd("sh", "-c", "echo more >> hello.txt")
+	head = addCommitToRepo(cmd)
+	runCmd(t, string(primaryDir), "git", "fetch", remote, "+refs/heads/*:refs/heads/*")
+
+	if err := s.syncReplica(ctx, primaryAddr, repo, dir); err != nil {
+		t.Fatal(err)
+	}
+	if got := runCmd(t, string(dir), "git", "rev-parse", "HEAD"); got != head {
+		t.Fatalf("unexpected HEAD of replica after fetch: want %q, got %q", head, got)
+	}
+
+	// A replica that can't reach its primary fails to sync.
+	srv.Close()
+	if err := s.syncReplica(ctx, primaryAddr, repo, dir); err == nil {
+		t.Fatal("expected error syncing from an unreachable primary")
+	}
+}
@@ -7,9 +7,11 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -56,7 +58,7 @@ func (s *Server) handleRepoDelete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := deleteRepo(r.Context(), s.Logger, s.DB, s.Hostname, s.ReposDir, req.Repo); err != nil {
+	if err := deleteRepo(r.Context(), s.Logger, s.DB, s.ColdStorage, s.Hostname, s.ReposDir, req.Repo); err != nil {
 		s.Logger.Error("failed to delete repository", log.String("repo", string(req.Repo)), log.Error(err))
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -68,6 +70,7 @@ func deleteRepo(
 	ctx context.Context,
 	logger log.Logger,
 	db database.DB,
+	cold *coldstorage.Store,
 	shardID string,
 	reposDir string,
 	repo api.RepoName,
@@ -78,6 +81,18 @@ func deleteRepo(
 	if err != nil {
 		return errors.Wrap(err, "removing repo directory")
 	}
+	// Archived repos only exist as a bundle in cold storage.
+	if cold != nil {
+		gr, err := db.GitserverRepos().GetByName(ctx, repo)
+		if err != nil && !errcode.IsNotFound(err) {
+			return errors.Wrap(err, "looking up repo")
+		}
+		if err == nil && gr.CloneStatus == types.CloneStatusArchived {
+			if err := cold.Delete(ctx, gr.RepoID); err != nil {
+				return errors.Wrap(err, "deleting cold storage bundle")
+			}
+		}
+	}
 	err = db.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusNotCloned, shardID)
 	if err != nil {
 		return errors.Wrap(err, "setting clone status after delete")
@@ -10,6 +10,7 @@ import (
 	"github.com/sourcegraph/log"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -89,6 +90,15 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, onMat
 		args.Limit = math.MaxInt32
 	}
 
+	// Revisions are passed as is to git log, so make sure none of them can be
+	// interpreted as a flag. Revision ranges (base..head) are passed as a
+	// single argument, so only need the same check.
+	for _, rev := range args.Revisions {
+		if err := git.CheckSpecArgSafety(rev.RevSpec); err != nil {
+			return false, err
+		}
+	}
+
 	// We used to have an `ensureRevision`/`CloneRepo` calls here that were
 	// obsolete, because a search for an unknown revision of the repo (of an
 	// uncloned repo) won't make it to gitserver and fail with an ErrNoResolvedRepos
@@ -143,6 +153,7 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, onMat
 		Query:                mt,
 		IncludeDiff:          args.IncludeDiff,
 		IncludeModifiedFiles: args.IncludeModifiedFiles || hasDiffModifiesFile,
+		Env:                  s.promisorRemoteEnv(ctx, args.Repo, dir),
 	}
 
 	return hitLimit.Load(), searcher.Search(ctx, limitedOnMatch)
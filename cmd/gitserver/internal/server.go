@@ -32,6 +32,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
@@ -199,6 +200,10 @@ type Server struct {
 
 	// Perforce is a plugin-like service attached to Server for all things Perforce.
 	Perforce *perforce.Service
+
+	// ColdStorage, if set, is used to rehydrate repositories that have been
+	// archived to cold storage by the janitor.
+	ColdStorage *coldstorage.Store
 }
 
 type locks struct {
@@ -340,6 +345,25 @@ func addrForRepo(ctx context.Context, repoName api.RepoName, gitServerAddrs gits
 	return gitServerAddrs.AddrForRepo(ctx, filepath.Base(os.Args[0]), repoName)
 }
 
+// isReplicaShard returns true if shardID keeps a replica of repoName rather than
+// being its primary.
+func isReplicaShard(ctx context.Context, shardID string, repoName api.RepoName, gitServerAddrs gitserver.GitserverAddresses) bool {
+	for _, addr := range gitServerAddrs.ReplicaAddrsForRepo(ctx, filepath.Base(os.Args[0]), repoName) {
+		if hostnameMatch(shardID, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReplica returns true if this gitserver keeps a replica of repo. Replicas
+// are synced from the primary by the replica syncer, so they must never be
+// cloned or fetched from the code host, and never write the repo's state to
+// the database.
+func (s *Server) isReplica(ctx context.Context, repo api.RepoName) bool {
+	return isReplicaShard(ctx, s.Hostname, repo, gitserver.NewGitserverAddresses(conf.Get()))
+}
+
 // NewClonePipeline creates a new pipeline that clones repos asynchronously. It
 // creates a producer-consumer pipeline that handles clone requests asychronously.
 func (s *Server) NewClonePipeline(logger log.Logger, cloneQueue *common.Queue[*cloneJob]) goroutine.BackgroundRoutine {
@@ -497,6 +521,23 @@ func (s *Server) getRemoteURL(ctx context.Context, name api.RepoName) (*vcs.URL,
 	return vcs.ParseURL(remoteURL)
 }
 
+// promisorRemoteEnv returns the environment git commands need to lazily fetch
+// objects that are missing in a partial clone of repo. It returns nil if repo
+// is not a partial clone.
+func (s *Server) promisorRemoteEnv(ctx context.Context, repo api.RepoName, dir common.GitDir) []string {
+	if !git.IsPartialClone(dir) {
+		return nil
+	}
+
+	// We may be fetching from a private repo so we need an internal actor.
+	remoteURL, err := s.getRemoteURL(actor.WithInternalActor(ctx), repo)
+	if err != nil {
+		s.Logger.Warn("failed to get remote URL for partial clone, missing objects can't be fetched", log.String("repo", string(repo)), log.Error(err))
+		return nil
+	}
+	return executil.PromisorRemoteEnv(git.PromisorRemote, remoteURL.String())
+}
+
 // acquireCloneLimiter() acquires a cancellable context associated with the
 // clone limiter.
 func (s *Server) acquireCloneLimiter(ctx context.Context) (context.Context, context.CancelFunc, error) {
@@ -594,6 +635,12 @@ func (s *Server) repoUpdate(req *protocol.RepoUpdateRequest) protocol.RepoUpdate
 	defer cancel2()
 
 	if !repoCloned(dir) && !s.skipCloneForTests {
+		// Repos in cold storage are only rehydrated when they are accessed, not
+		// when an update is scheduled for them.
+		if _, archived := s.repoArchived(ctx, req.Repo); archived {
+			return resp
+		}
+
 		_, err := s.CloneRepo(ctx, req.Repo, CloneOptions{Block: true})
 		if err != nil {
 			logger.Warn("error cloning repo", log.String("repo", string(req.Repo)), log.Error(err))
@@ -720,7 +767,10 @@ func (s *Server) handleArchive(w http.ResponseWriter, r *http.Request) {
 	req.Args = append(req.Args, treeish, "--")
 	req.Args = append(req.Args, pathspecs...)
 
-	s.execHTTP(w, r, req)
+	// Pointer files are replaced with their LFS objects, if any have been
+	// fetched for the repo.
+	dir := gitserverfs.RepoDirFromName(s.ReposDir, req.Repo)
+	s.execHTTP(w, r, req, lfsArchiveFilter(dir, format))
 }
 
 func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
@@ -978,7 +1028,7 @@ func (s *Server) handleExec(w http.ResponseWriter, r *http.Request) {
 		log.Strings("args", args),
 	)
 
-	s.execHTTP(w, r, &req)
+	s.execHTTP(w, r, &req, nil)
 }
 
 var blockedCommandExecutedCounter = promauto.NewCounter(prometheus.CounterOpts{
@@ -1159,9 +1209,12 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 	stdoutW := &writeCounter{w: w}
 	stderrW := &writeCounter{w: &limitWriter{W: &stderrBuf, N: 1024}}
 
+	promisorEnv := s.promisorRemoteEnv(ctx, repoName, dir)
+
 	cmdStart = time.Now()
 	cmd := s.RecordingCommandFactory.Command(ctx, s.Logger, string(repoName), "git", req.Args...)
 	dir.Set(cmd.Unwrap())
+	cmd.Unwrap().Env = append(cmd.Unwrap().Env, promisorEnv...)
 	cmd.Unwrap().Stdout = stdoutW
 	cmd.Unwrap().Stderr = stderrW
 	cmd.Unwrap().Stdin = bytes.NewReader(req.Stdin)
@@ -1183,7 +1236,9 @@ func (s *Server) exec(ctx context.Context, logger log.Logger, req *protocol.Exec
 }
 
 // execHTTP translates the results of an exec into the expected HTTP statuses and payloads
-func (s *Server) execHTTP(w http.ResponseWriter, r *http.Request, req *protocol.ExecRequest) {
+// execHTTP executes the given git command and writes its output to w. If filter
+// is not nil, the output is passed through it.
+func (s *Server) execHTTP(w http.ResponseWriter, r *http.Request, req *protocol.ExecRequest, filter func(w io.Writer, r io.Reader) error) {
 	logger := s.Logger.Scoped("exec").With(log.Strings("req.Args", req.Args))
 
 	// Flush writes more aggressively than standard net/http so that clients
@@ -1202,7 +1257,13 @@ func (s *Server) execHTTP(w http.ResponseWriter, r *http.Request, req *protocol.
 	w.Header().Add("Trailer", "X-Exec-Exit-Status")
 	w.Header().Add("Trailer", "X-Exec-Stderr")
 
-	execStatus, err := s.exec(ctx, logger, req, r.UserAgent(), w)
+	var execStatus execStatus
+	var err error
+	if filter == nil {
+		execStatus, err = s.exec(ctx, logger, req, r.UserAgent(), w)
+	} else {
+		execStatus, err = s.execFiltered(ctx, logger, req, r.UserAgent(), w, filter)
+	}
 	w.Header().Set("X-Exec-Error", errorString(execStatus.Err))
 	w.Header().Set("X-Exec-Exit-Status", strconv.Itoa(execStatus.ExitStatus))
 	w.Header().Set("X-Exec-Stderr", execStatus.Stderr)
@@ -1224,6 +1285,28 @@ func (s *Server) execHTTP(w http.ResponseWriter, r *http.Request, req *protocol.
 	}
 }
 
+// execFiltered is like exec, but passes the output of the command through
+// filter before writing it to w.
+func (s *Server) execFiltered(ctx context.Context, logger log.Logger, req *protocol.ExecRequest, userAgent string, w io.Writer, filter func(w io.Writer, r io.Reader) error) (execStatus, error) {
+	pr, pw := io.Pipe()
+	filterErr := make(chan error, 1)
+	go func() {
+		err := filter(w, pr)
+		// Unblocks exec if the filter stopped reading early.
+		pr.CloseWithError(errors.Wrap(err, "filtering output"))
+		filterErr <- err
+	}()
+
+	execStatus, err := s.exec(ctx, logger, req, userAgent, pw)
+	pw.CloseWithError(err)
+	// Like failures of the command itself, failures of the filter are reported
+	// in the exec status, as the output may already have been written.
+	if ferr := <-filterErr; err == nil && execStatus.Err == nil {
+		execStatus.Err = ferr
+	}
+	return execStatus, err
+}
+
 func setLastFetched(ctx context.Context, db database.DB, shardID string, dir common.GitDir, name api.RepoName) error {
 	lastFetched, err := repoLastFetched(dir)
 	if err != nil {
@@ -1463,6 +1546,14 @@ func (s *Server) doClone(
 	defer os.RemoveAll(tmpDir)
 	tmpPath := filepath.Join(tmpDir, ".git")
 
+	// Check whether we are rehydrating the repo from cold storage before the
+	// clone status is overwritten below.
+	var repoID api.RepoID
+	archived := false
+	if !repoCloned(dir) {
+		repoID, archived = s.repoArchived(ctx, repo)
+	}
+
 	// It may already be cloned
 	if !repoCloned(dir) {
 		if err := s.DB.GitserverRepos().SetCloneStatus(ctx, repo, types.CloneStatusCloning, s.Hostname); err != nil {
@@ -1470,8 +1561,13 @@ func (s *Server) doClone(
 		}
 	}
 	defer func() {
+		status := cloneStatus(repoCloned(dir), false)
+		if archived && status == types.CloneStatusNotCloned {
+			// The bundle is still in cold storage, so we can try again later.
+			status = types.CloneStatusArchived
+		}
 		// Use a background context to ensure we still update the DB even if we time out
-		if err := s.DB.GitserverRepos().SetCloneStatus(context.Background(), repo, cloneStatus(repoCloned(dir), false), s.Hostname); err != nil {
+		if err := s.DB.GitserverRepos().SetCloneStatus(context.Background(), repo, status, s.Hostname); err != nil {
 			s.Logger.Error("Setting clone status in DB", log.Error(err))
 		}
 	}()
@@ -1487,7 +1583,11 @@ func (s *Server) doClone(
 	output := &linebasedBufferedWriter{}
 	eg := readCloneProgress(s.DB, logger, lock, io.TeeReader(progressReader, output), repo)
 
-	cloneErr := syncer.Clone(ctx, repo, remoteURL, dir, tmpPath, progressWriter)
+	var cloneErr error
+	rehydrated := archived && s.rehydrateRepo(ctx, logger, repo, repoID, tmpPath, syncer, remoteURL, progressWriter)
+	if !rehydrated {
+		cloneErr = syncer.Clone(ctx, repo, remoteURL, dir, tmpPath, progressWriter)
+	}
 	progressWriter.Close()
 
 	if err := eg.Wait(); err != nil {
@@ -1531,11 +1631,67 @@ func (s *Server) doClone(
 	logger.Info("repo cloned")
 	repoClonedCounter.Inc()
 
+	if archived && s.ColdStorage != nil {
+		// The repo is back on disk, so the bundle is no longer needed.
+		if err := s.ColdStorage.Delete(ctx, repoID); err != nil {
+			logger.Warn("failed to delete cold storage bundle", log.Error(err))
+		}
+	}
+
 	s.Perforce.EnqueueChangelistMappingJob(perforce.NewChangelistMappingJob(repo, dir))
 
 	return nil
 }
 
+// repoArchived returns the ID of repo and true if it has been archived to cold
+// storage.
+func (s *Server) repoArchived(ctx context.Context, repo api.RepoName) (api.RepoID, bool) {
+	if s.ColdStorage == nil {
+		return 0, false
+	}
+	gr, err := s.DB.GitserverRepos().GetByName(ctx, repo)
+	if err != nil {
+		return 0, false
+	}
+	return gr.RepoID, gr.CloneStatus == types.CloneStatusArchived
+}
+
+// rehydrateRepo restores repo from its cold storage bundle into tmpPath and
+// fetches everything that changed on the code host since it was archived. It
+// returns false if the repo could not be rehydrated, in which case the caller
+// should fall back to a regular clone.
+func (s *Server) rehydrateRepo(
+	ctx context.Context,
+	logger log.Logger,
+	repo api.RepoName,
+	repoID api.RepoID,
+	tmpPath string,
+	syncer vcssyncer.VCSSyncer,
+	remoteURL *vcs.URL,
+	progressWriter io.Writer,
+) bool {
+	fmt.Fprintf(progressWriter, "Restoring %s from cold storage\n", repo)
+	if err := s.ColdStorage.Restore(ctx, repoID, tmpPath); err != nil {
+		logger.Warn("failed to restore repo from cold storage, falling back to clone", log.Error(err))
+		_ = os.RemoveAll(tmpPath)
+		return false
+	}
+
+	fmt.Fprintln(progressWriter, "Fetching changes since the repo was archived")
+	out, err := syncer.Fetch(ctx, remoteURL, repo, common.GitDir(tmpPath), "")
+	if len(out) > 0 {
+		_, _ = progressWriter.Write(out)
+	}
+	if err != nil {
+		logger.Warn("failed to fetch rehydrated repo, falling back to clone", log.Error(err))
+		_ = os.RemoveAll(tmpPath)
+		return false
+	}
+
+	repoRehydratedCounter.Inc()
+	return true
+}
+
 // linebasedBufferedWriter is an io.Writer that writes to a buffer.
 // '\r' resets the write offset to the index after last '\n' in the buffer,
 // or the beginning of the buffer if a '\n' has not been written yet.
@@ -1632,6 +1788,10 @@ func postRepoFetchActions(
 		errs = errors.Append(errs, errors.Wrap(err, "setting git gc mode"))
 	}
 
+	// LFS objects are fetched before the repo size is calculated below, so that
+	// it includes them.
+	fetchLFSObjects(ctx, logger, repo, dir, remoteURL, syncer)
+
 	// Update the last-changed stamp on disk.
 	if err := setLastChanged(logger, dir); err != nil {
 		errs = errors.Append(errs, errors.Wrap(err, "failed to update last changed time"))
@@ -1776,6 +1936,10 @@ var (
 		Name: "src_gitserver_repo_cloned",
 		Help: "number of successful git clones run",
 	})
+	repoRehydratedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "src_gitserver_repo_rehydrated",
+		Help: "number of repos restored from cold storage instead of being cloned",
+	})
 	repoCloneFailedCounter = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "src_gitserver_repo_cloned_failed",
 		Help: "number of failed git clones",
@@ -1789,14 +1953,7 @@ func (s *Server) doRepoUpdate(ctx context.Context, repo api.RepoName, revspec st
 	defer tr.EndWithErr(&err)
 
 	s.repoUpdateLocksMu.Lock()
-	l, ok := s.repoUpdateLocks[repo]
-	if !ok {
-		l = &locks{
-			once: new(sync.Once),
-			mu:   new(sync.Mutex),
-		}
-		s.repoUpdateLocks[repo] = l
-	}
+	l := s.repoUpdateLock(repo)
 	once := l.once
 	mu := l.mu
 	s.repoUpdateLocksMu.Unlock()
@@ -1842,6 +1999,45 @@ func (s *Server) doRepoUpdate(ctx context.Context, repo api.RepoName, revspec st
 	}
 }
 
+// repoUpdateLock returns the locks for updates of repo. The caller must hold
+// repoUpdateLocksMu.
+func (s *Server) repoUpdateLock(repo api.RepoName) *locks {
+	l, ok := s.repoUpdateLocks[repo]
+	if !ok {
+		l = &locks{
+			once: new(sync.Once),
+			mu:   new(sync.Mutex),
+		}
+		s.repoUpdateLocks[repo] = l
+	}
+	return l
+}
+
+// LockRepo locks repo for an operation that removes it from disk, such as
+// archiving it to cold storage. Neither clones nor fetches of repo run while
+// the lock is held. If another operation is already in progress, ok is false.
+// Otherwise the caller must call unlock when done.
+func (s *Server) LockRepo(repo api.RepoName, status string) (unlock func(), ok bool) {
+	lock, ok := s.Locker.TryAcquire(gitserverfs.RepoDirFromName(s.ReposDir, repo), status)
+	if !ok {
+		return nil, false
+	}
+
+	s.repoUpdateLocksMu.Lock()
+	mu := s.repoUpdateLock(repo).mu
+	s.repoUpdateLocksMu.Unlock()
+
+	if !mu.TryLock() {
+		lock.Release()
+		return nil, false
+	}
+
+	return func() {
+		mu.Unlock()
+		lock.Release()
+	}, true
+}
+
 var doBackgroundRepoUpdateMock func(api.RepoName) error
 
 func (s *Server) doBackgroundRepoUpdate(repo api.RepoName, revspec string) error {
@@ -2102,7 +2298,8 @@ func (s *Server) handleGetObject(w http.ResponseWriter, r *http.Request) {
 	// Log which actor is accessing the repo.
 	accesslog.Record(r.Context(), string(req.Repo), log.String("objectname", req.ObjectName))
 
-	obj, err := git.GetObject(r.Context(), s.RecordingCommandFactory, s.ReposDir, req.Repo, req.ObjectName)
+	ctx := git.WithCommandEnv(r.Context(), s.promisorRemoteEnv(r.Context(), req.Repo, gitserverfs.RepoDirFromName(s.ReposDir, req.Repo)))
+	obj, err := git.GetObject(ctx, s.RecordingCommandFactory, s.ReposDir, req.Repo, req.ObjectName)
 	if err != nil {
 		http.Error(w, errors.Wrap(err, "getting object").Error(), http.StatusInternalServerError)
 		return
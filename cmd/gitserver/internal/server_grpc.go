@@ -1,9 +1,14 @@
 package internal
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,8 +16,11 @@ import (
 	"github.com/sourcegraph/log"
 
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/lfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -105,6 +113,9 @@ func (gs *GRPCServer) DiskInfo(_ context.Context, _ *proto.DiskInfoRequest) (*pr
 	return getDiskInfo(gs.Server.ReposDir)
 }
 
+// Exec runs an allowlisted git command and streams its output. It is the
+// legacy path for the commands that don't have a typed RPC such as Blame or
+// Diff yet.
 func (gs *GRPCServer) Exec(req *proto.ExecRequest, ss proto.GitserverService_ExecServer) error {
 	internalReq := protocol.ExecRequest{
 		Repo:      api.RepoName(req.GetRepo()),
@@ -177,10 +188,495 @@ func (gs *GRPCServer) Archive(req *proto.ArchiveRequest, ss proto.GitserverServi
 		})
 	})
 
+	// Pointer files are replaced with their LFS objects, if any have been
+	// fetched for the repo.
+	dir := gitserverfs.RepoDirFromName(gs.Server.ReposDir, execReq.Repo)
+	if smudge := lfsArchiveFilter(dir, req.GetFormat()); smudge != nil {
+		r := gs.execReader(ss.Context(), execReq)
+		defer r.Close()
+		return smudge(w, r)
+	}
+
 	// TODO(mucles): set user agent from all grpc clients
 	return gs.doExec(ss.Context(), gs.Server.Logger, execReq, "unknown-grpc-client", w)
 }
 
+// Blame streams the hunks of `git blame` of a file.
+//
+// In incremental mode, hunks are sent as soon as git outputs them, but they
+// don't have byte offsets. Otherwise, the whole blame is computed before the
+// first hunk is sent.
+func (gs *GRPCServer) Blame(req *proto.BlameRequest, ss proto.GitserverService_BlameServer) error {
+	ctx := ss.Context()
+
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, req.GetRepo(),
+		log.String("commit", req.GetCommit()),
+		log.String("path", req.GetPath()),
+	)
+
+	if req.GetRepo() == "" || req.GetPath() == "" {
+		return status.Error(codes.InvalidArgument, "empty repo or path")
+	}
+	if err := git.CheckSpecArgSafety(req.GetCommit()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	execReq := &protocol.ExecRequest{
+		Repo: api.RepoName(req.GetRepo()),
+		Args: []string{"blame", "--porcelain"},
+	}
+	if req.GetIncremental() {
+		execReq.Args = append(execReq.Args, "--incremental")
+	}
+	if req.GetIgnoreWhitespace() {
+		execReq.Args = append(execReq.Args, "-w")
+	}
+	if req.GetStartLine() != 0 || req.GetEndLine() != 0 {
+		execReq.Args = append(execReq.Args, fmt.Sprintf("-L%d,%d", req.GetStartLine(), req.GetEndLine()))
+	}
+	if req.GetCommit() != "" {
+		execReq.Args = append(execReq.Args, req.GetCommit())
+	}
+	execReq.Args = append(execReq.Args, "--", req.GetPath())
+
+	if req.GetIncremental() {
+		hr := gitserver.NewBlameHunkReader(gs.execReader(ctx, execReq))
+		defer hr.Close()
+
+		for {
+			h, err := hr.Read()
+			if err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			if err := ss.Send(&proto.BlameResponse{Hunk: h.ToProto()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	if err := gs.doExec(ctx, gs.Server.Logger, execReq, "unknown-grpc-client", &out); err != nil {
+		return err
+	}
+	if out.Len() == 0 {
+		return nil
+	}
+
+	hunks, err := gitserver.ParseGitBlameOutput(out.String())
+	if err != nil {
+		return err
+	}
+	for _, h := range hunks {
+		if err := ss.Send(&proto.BlameResponse{Hunk: h.ToProto()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff streams the output of `git diff` between two revisions.
+func (gs *GRPCServer) Diff(req *proto.DiffRequest, ss proto.GitserverService_DiffServer) error {
+	// Log which actor is accessing the repo.
+	accesslog.Record(ss.Context(), req.GetRepo(),
+		log.String("base", req.GetBaseRevSpec()),
+		log.String("head", req.GetHeadRevSpec()),
+		log.Strings("paths", req.GetPaths()),
+	)
+
+	if req.GetRepo() == "" {
+		return status.Error(codes.InvalidArgument, "empty repo")
+	}
+
+	rangeType := "..."
+	if req.GetComparisonType() == proto.DiffRequest_COMPARISON_TYPE_TWO_DOT {
+		rangeType = ".."
+	}
+	rangeSpec := req.GetBaseRevSpec() + rangeType + req.GetHeadRevSpec()
+	if strings.HasPrefix(rangeSpec, "-") || strings.HasPrefix(rangeSpec, ".") {
+		// We don't want to allow user input to add `git diff` command line
+		// flags or refer to a file.
+		return status.Errorf(codes.InvalidArgument, "invalid diff range argument: %q", rangeSpec)
+	}
+
+	execReq := &protocol.ExecRequest{
+		Repo: api.RepoName(req.GetRepo()),
+		Args: append([]string{
+			"diff",
+			"--find-renames",
+			"--full-index",
+			"--inter-hunk-context=3",
+			"--no-prefix",
+			rangeSpec,
+			"--",
+		}, req.GetPaths()...),
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.DiffResponse{
+			Data: p,
+		})
+	})
+
+	return gs.doExec(ss.Context(), gs.Server.Logger, execReq, "unknown-grpc-client", w)
+}
+
+// MergeBase returns the best common ancestor of two revisions.
+func (gs *GRPCServer) MergeBase(ctx context.Context, req *proto.MergeBaseRequest) (*proto.MergeBaseResponse, error) {
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, req.GetRepo(),
+		log.String("base", req.GetBase()),
+		log.String("head", req.GetHead()),
+	)
+
+	if req.GetRepo() == "" || req.GetBase() == "" || req.GetHead() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty repo, base or head")
+	}
+
+	execReq := &protocol.ExecRequest{
+		Repo: api.RepoName(req.GetRepo()),
+		Args: []string{"merge-base", "--", req.GetBase(), req.GetHead()},
+	}
+
+	var out bytes.Buffer
+	if err := gs.doExec(ctx, gs.Server.Logger, execReq, "unknown-grpc-client", &out); err != nil {
+		return nil, err
+	}
+
+	return &proto.MergeBaseResponse{
+		MergeBaseCommitSha: string(bytes.TrimSpace(out.Bytes())),
+	}, nil
+}
+
+// revListBatchSize is the maximum number of commits sent in a single
+// RevListResponse.
+const revListBatchSize = 1000
+
+// RevList streams the commits reachable from the given revisions, along with
+// their parents.
+func (gs *GRPCServer) RevList(req *proto.RevListRequest, ss proto.GitserverService_RevListServer) error {
+	ctx := ss.Context()
+
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, req.GetRepo(),
+		log.Strings("revisions", req.GetRevisions()),
+		log.Bool("allRefs", req.GetAllRefs()),
+	)
+
+	if req.GetRepo() == "" {
+		return status.Error(codes.InvalidArgument, "empty repo")
+	}
+	if len(req.GetRevisions()) == 0 && !req.GetAllRefs() {
+		return status.Error(codes.InvalidArgument, "no revisions given")
+	}
+	for _, rev := range req.GetRevisions() {
+		if err := git.CheckSpecArgSafety(rev); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	execReq := &protocol.ExecRequest{
+		Repo:      api.RepoName(req.GetRepo()),
+		Args:      []string{"rev-list", "--parents"},
+		NoTimeout: true,
+	}
+	if req.GetFirstParent() {
+		execReq.Args = append(execReq.Args, "--first-parent")
+	}
+	if req.GetTopoOrder() {
+		execReq.Args = append(execReq.Args, "--topo-order")
+	}
+	if req.GetAllRefs() {
+		execReq.Args = append(execReq.Args, "--all")
+	}
+	if req.GetSince() != nil {
+		execReq.Args = append(execReq.Args, "--since="+req.GetSince().AsTime().Format(time.RFC3339))
+	}
+	if req.GetMaxCount() > 0 {
+		execReq.Args = append(execReq.Args, fmt.Sprintf("--max-count=%d", req.GetMaxCount()))
+	}
+	execReq.Args = append(execReq.Args, req.GetRevisions()...)
+	execReq.Args = append(execReq.Args, "--")
+
+	rc := gs.execReader(ctx, execReq)
+	defer rc.Close()
+
+	// Each line is a commit followed by its parents.
+	sc := bufio.NewScanner(rc)
+	var commits []*proto.RevListCommit
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		commits = append(commits, &proto.RevListCommit{Id: fields[0], Parents: fields[1:]})
+
+		if len(commits) == revListBatchSize {
+			if err := ss.Send(&proto.RevListResponse{Commits: commits}); err != nil {
+				return err
+			}
+			commits = nil
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	if len(commits) > 0 {
+		return ss.Send(&proto.RevListResponse{Commits: commits})
+	}
+	return nil
+}
+
+// ReadFile streams the contents of a file at a commit. The stream of a
+// submodule is empty.
+func (gs *GRPCServer) ReadFile(req *proto.ReadFileRequest, ss proto.GitserverService_ReadFileServer) error {
+	ctx := ss.Context()
+
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, req.GetRepo(),
+		log.String("commit", req.GetCommit()),
+		log.String("path", req.GetPath()),
+	)
+
+	if req.GetRepo() == "" || req.GetCommit() == "" || req.GetPath() == "" {
+		return status.Error(codes.InvalidArgument, "empty repo, commit or path")
+	}
+	if err := git.CheckSpecArgSafety(req.GetCommit()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	repo := api.RepoName(req.GetRepo())
+	dir := gitserverfs.RepoDirFromName(gs.Server.ReposDir, repo)
+
+	fileNotFound := func() error {
+		s, err := status.New(codes.NotFound, "file not found").WithDetails(&proto.FileNotFoundPayload{
+			Repo:   req.GetRepo(),
+			Commit: req.GetCommit(),
+			Path:   req.GetPath(),
+		})
+		if err != nil {
+			gs.Server.Logger.Error("failed to marshal status", log.Error(err))
+			return err
+		}
+		return s.Err()
+	}
+
+	w := streamio.NewWriter(func(p []byte) error {
+		return ss.Send(&proto.ReadFileResponse{
+			Data: p,
+		})
+	})
+
+	// Most reads are served from the object database in-process. Anything the
+	// object reader can't serve is read with git below.
+	if entry, blob, err := git.ReadFile(dir, req.GetCommit(), req.GetPath()); err == nil {
+		// The read doesn't go through exec, which records accesses for cold
+		// storage otherwise.
+		coldstorage.MarkAccessed(dir)
+		if blob != nil {
+			defer blob.Close()
+		}
+		switch {
+		case entry == nil:
+			return fileNotFound()
+		case entry.Type == gitdomain.ObjectTypeCommit:
+			return nil
+		case entry.Type != gitdomain.ObjectTypeBlob:
+			return status.Errorf(codes.FailedPrecondition, "%q is not a file", req.GetPath())
+		}
+		return writeBlob(w, dir, blob)
+	}
+
+	// Look up the blob with ls-tree rather than using `git show commit:path`,
+	// which resolves paths containing ".." as revision ranges.
+	var out bytes.Buffer
+	err := gs.doExec(ctx, gs.Server.Logger, &protocol.ExecRequest{
+		Repo: repo,
+		Args: []string{"ls-tree", "--long", "-z", req.GetCommit(), "--", req.GetPath()},
+	}, "unknown-grpc-client", &out)
+	if err != nil {
+		return err
+	}
+
+	// 100644 blob 3bad331187e39c05c78a9b5e443689f78f4365a7     123<TAB>README.md<NUL>
+	entry := bytes.TrimSuffix(out.Bytes(), []byte{0})
+	if len(entry) == 0 {
+		return fileNotFound()
+	}
+
+	info, name, _ := bytes.Cut(entry, []byte{'\t'})
+	fields := bytes.Fields(info)
+	if len(fields) != 4 {
+		return errors.Newf("unexpected output of git ls-tree: %q", out.String())
+	}
+	if string(fields[1]) == "commit" {
+		return nil
+	}
+	if string(fields[1]) != "blob" || string(name) != req.GetPath() {
+		return status.Errorf(codes.FailedPrecondition, "%q is not a file", req.GetPath())
+	}
+
+	catFile := &protocol.ExecRequest{
+		Repo: repo,
+		Args: []string{"cat-file", "-p", string(fields[2])},
+	}
+
+	// Small files can be LFS pointers, whose object is read instead if it has
+	// been fetched.
+	if size, err := strconv.Atoi(string(fields[3])); err != nil || size >= lfs.MaxPointerSize || !lfs.HasObjects(dir) {
+		return gs.doExec(ctx, gs.Server.Logger, catFile, "unknown-grpc-client", w)
+	}
+
+	var blob bytes.Buffer
+	if err := gs.doExec(ctx, gs.Server.Logger, catFile, "unknown-grpc-client", &blob); err != nil {
+		return err
+	}
+	return writeBlob(w, dir, &blob)
+}
+
+// writeBlob copies the content of a blob to w. If the blob is the pointer of
+// an LFS object that has been fetched, the object is written instead.
+func writeBlob(w io.Writer, dir common.GitDir, blob io.Reader) error {
+	// Only blobs smaller than lfs.MaxPointerSize can be pointers.
+	head := make([]byte, lfs.MaxPointerSize)
+	n, err := io.ReadFull(blob, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	head = head[:n]
+
+	if n < lfs.MaxPointerSize && lfs.HasObjects(dir) {
+		f, err := lfs.OpenObject(dir, head)
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			defer f.Close()
+			_, err = io.Copy(w, f)
+			return err
+		}
+	}
+	if _, err := w.Write(head); err != nil {
+		return err
+	}
+	_, err = io.Copy(w, blob)
+	return err
+}
+
+// Submodules returns the submodules of a repo at a commit, along with the repos
+// their URLs map to.
+func (gs *GRPCServer) Submodules(ctx context.Context, req *proto.SubmodulesRequest) (*proto.SubmodulesResponse, error) {
+	// Log which actor is accessing the repo.
+	accesslog.Record(ctx, req.GetRepo(),
+		log.String("commit", req.GetCommit()),
+	)
+
+	if req.GetRepo() == "" || req.GetCommit() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty repo or commit")
+	}
+	if err := git.CheckSpecArgSafety(req.GetCommit()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	repo := api.RepoName(req.GetRepo())
+	lsTree := func(paths ...string) ([][]byte, error) {
+		var out bytes.Buffer
+		err := gs.doExec(ctx, gs.Server.Logger, &protocol.ExecRequest{
+			Repo: repo,
+			Args: append([]string{"ls-tree", "-z", req.GetCommit(), "--"}, paths...),
+		}, "unknown-grpc-client", &out)
+		if err != nil {
+			return nil, err
+		}
+		entries := bytes.Split(bytes.TrimSuffix(out.Bytes(), []byte{0}), []byte{0})
+		if len(entries) == 1 && len(entries[0]) == 0 {
+			return nil, nil
+		}
+		return entries, nil
+	}
+
+	// 100644 blob 3bad331187e39c05c78a9b5e443689f78f4365a7<TAB>.gitmodules
+	entries, err := lsTree(".gitmodules")
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return &proto.SubmodulesResponse{}, nil
+	}
+	fields := bytes.Fields(entries[0])
+	if len(fields) != 4 || string(fields[1]) != "blob" {
+		return &proto.SubmodulesResponse{}, nil
+	}
+
+	var gitModules bytes.Buffer
+	err = gs.doExec(ctx, gs.Server.Logger, &protocol.ExecRequest{
+		Repo: repo,
+		Args: []string{"cat-file", "-p", string(fields[2])},
+	}, "unknown-grpc-client", &gitModules)
+	if err != nil {
+		return nil, err
+	}
+	urls, err := gitdomain.ParseGitModules(gitModules.Bytes())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if len(urls) == 0 {
+		return &proto.SubmodulesResponse{}, nil
+	}
+
+	// Only the configured submodules that exist at the commit are returned,
+	// pinned to the commit of their gitlink.
+	paths := make([]string, 0, len(urls))
+	for p := range urls {
+		paths = append(paths, p)
+	}
+	entries, err = lsTree(paths...)
+	if err != nil {
+		return nil, err
+	}
+	var submodules []gitdomain.Submodule
+	for _, entry := range entries {
+		// 160000 commit 94aa9078934ce2776ccbb589569eca5ef575f12e<TAB>submod
+		info, name, _ := bytes.Cut(entry, []byte{'\t'})
+		fields := bytes.Fields(info)
+		if len(fields) != 3 || string(fields[1]) != "commit" {
+			continue
+		}
+		url, ok := urls[string(name)]
+		if !ok {
+			continue
+		}
+		submodules = append(submodules, gitdomain.Submodule{
+			Path:     string(name),
+			URL:      url,
+			CommitID: api.CommitID(fields[2]),
+		})
+	}
+
+	gs.Server.resolveSubmoduleRepos(ctx, repo, submodules)
+
+	resp := &proto.SubmodulesResponse{}
+	for _, s := range submodules {
+		resp.Submodules = append(resp.Submodules, s.ToProto())
+	}
+	return resp, nil
+}
+
+// execReader executes the given git command like doExec, and returns a reader
+// of its output. The error of the command is returned by the reader once the
+// output has been read.
+func (gs *GRPCServer) execReader(ctx context.Context, req *protocol.ExecRequest) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(gs.doExec(ctx, gs.Server.Logger, req, "unknown-grpc-client", pw))
+	}()
+	return pr
+}
+
 // doExec executes the given git command and streams the output to the given writer.
 //
 // Note: This function wraps the underlying exec implementation and returns grpc specific error handling.
@@ -239,11 +735,16 @@ func (gs *GRPCServer) GetObject(ctx context.Context, req *proto.GetObjectRequest
 	// Log which actor is accessing the repo.
 	accesslog.Record(ctx, string(internalReq.Repo), log.String("objectname", internalReq.ObjectName))
 
+	dir := gitserverfs.RepoDirFromName(gs.Server.ReposDir, internalReq.Repo)
+	ctx = git.WithCommandEnv(ctx, gs.Server.promisorRemoteEnv(ctx, internalReq.Repo, dir))
 	obj, err := git.GetObject(ctx, gs.Server.RecordingCommandFactory, gs.Server.ReposDir, api.RepoName(req.Repo), req.ObjectName)
 	if err != nil {
 		gs.Server.Logger.Error("getting object", log.Error(err))
 		return nil, err
 	}
+	// Lookups don't go through exec, which records accesses for cold storage
+	// otherwise.
+	coldstorage.MarkAccessed(dir)
 
 	resp := protocol.GetObjectResponse{
 		Object: *obj,
@@ -333,7 +834,7 @@ func (gs *GRPCServer) RepoCloneProgress(_ context.Context, req *proto.RepoCloneP
 func (gs *GRPCServer) RepoDelete(ctx context.Context, req *proto.RepoDeleteRequest) (*proto.RepoDeleteResponse, error) {
 	repo := req.GetRepo()
 
-	if err := deleteRepo(ctx, gs.Server.Logger, gs.Server.DB, gs.Server.Hostname, gs.Server.ReposDir, api.UndeletedRepoName(api.RepoName(repo))); err != nil {
+	if err := deleteRepo(ctx, gs.Server.Logger, gs.Server.DB, gs.Server.ColdStorage, gs.Server.Hostname, gs.Server.ReposDir, api.RepoName(repo)); err != nil {
 		gs.Server.Logger.Error("failed to delete repository", log.String("repo", repo), log.Error(err))
 		return &proto.RepoDeleteResponse{}, status.Errorf(codes.Internal, "failed to delete repository %s: %s", repo, err)
 	}
@@ -522,6 +522,7 @@ var ignoreVolatileGitserverRepoFields = cmpopts.IgnoreFields(
 	"RepoSizeBytes",
 	"UpdatedAt",
 	"CorruptionLogs",
+	"MaintenanceLogs",
 	"CloningProgress",
 )
 
@@ -715,7 +716,7 @@ func TestHandleRepoUpdate(t *testing.T) {
 	}
 
 	// We don't care exactly what the error is here
-	cmpIgnored := cmpopts.IgnoreFields(types.GitserverRepo{}, "LastFetched", "LastChanged", "RepoSizeBytes", "UpdatedAt", "LastError", "CorruptionLogs")
+	cmpIgnored := cmpopts.IgnoreFields(types.GitserverRepo{}, "LastFetched", "LastChanged", "RepoSizeBytes", "UpdatedAt", "LastError", "CorruptionLogs", "MaintenanceLogs")
 	// But we do care that it exists
 	if fromDB.LastError == "" {
 		t.Errorf("Expected an error when trying to clone from an invalid URL")
@@ -800,6 +801,49 @@ func TestHandleRepoUpdate(t *testing.T) {
 	}
 }
 
+func TestLockRepo(t *testing.T) {
+	ctx := context.Background()
+	reposDir := t.TempDir()
+	s := makeTestServer(ctx, t, reposDir, "", nil)
+	s.repoUpdateLocks = make(map[api.RepoName]*locks)
+
+	updated := make(chan struct{})
+	doBackgroundRepoUpdateMock = func(api.RepoName) error {
+		close(updated)
+		return nil
+	}
+	t.Cleanup(func() { doBackgroundRepoUpdateMock = nil })
+
+	unlock, ok := s.LockRepo("foo", "archiving")
+	require.True(t, ok)
+
+	_, ok = s.LockRepo("foo", "archiving")
+	require.False(t, ok)
+
+	// Clones don't start while the repo is locked.
+	_, ok = s.Locker.TryAcquire(gitserverfs.RepoDirFromName(reposDir, "foo"), "starting clone")
+	require.False(t, ok)
+	status, _ := s.Locker.Status(gitserverfs.RepoDirFromName(reposDir, "foo"))
+	require.Equal(t, "archiving", status)
+
+	// Fetches wait for the lock to be released.
+	updateCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.doRepoUpdate(updateCtx, "foo", ""), context.DeadlineExceeded)
+	select {
+	case <-updated:
+		t.Fatal("repo was fetched while locked")
+	default:
+	}
+
+	unlock()
+	<-updated
+
+	unlock, ok = s.LockRepo("foo", "archiving")
+	require.True(t, ok)
+	unlock()
+}
+
 func TestCloneRepo_EnsureValidity(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
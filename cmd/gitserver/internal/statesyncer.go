@@ -203,6 +203,10 @@ func syncRepoState(
 				shouldUpdate = true
 			}
 			cloneStatus := cloneStatus(cloned, cloning)
+			if cloneStatus == types.CloneStatusNotCloned && repo.CloneStatus == types.CloneStatusArchived {
+				// Archived repos are not on disk by design.
+				cloneStatus = types.CloneStatusArchived
+			}
 			if repo.CloneStatus != cloneStatus {
 				repo.CloneStatus = cloneStatus
 				// Since the repo has been recloned or is being cloned
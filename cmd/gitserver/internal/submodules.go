@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/cloneurls"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// resolveSubmoduleRepos sets the repo of each submodule of repo whose URL maps
+// to a repo on Sourcegraph. Submodules that can't be resolved are left
+// without a repo.
+func (s *Server) resolveSubmoduleRepos(ctx context.Context, repo api.RepoName, submodules []gitdomain.Submodule) {
+	logger := s.Logger.Scoped("submodules").With(log.String("repo", string(repo)))
+
+	var remoteURL string
+	for i := range submodules {
+		cloneURL := submodules[i].URL
+		if isRelativeSubmoduleURL(cloneURL) {
+			if remoteURL == "" {
+				var err error
+				remoteURL, err = s.GetRemoteURLFunc(ctx, repo)
+				if err != nil {
+					logger.Warn("failed to get remote URL to resolve relative submodule URLs", log.Error(err))
+					return
+				}
+			}
+
+			var err error
+			cloneURL, err = relativeSubmoduleCloneURL(remoteURL, cloneURL)
+			if err != nil {
+				logger.Warn("failed to resolve relative submodule URL", log.String("path", submodules[i].Path), log.Error(err))
+				continue
+			}
+		}
+
+		name, err := cloneurls.RepoSourceCloneURLToRepoName(ctx, s.DB, cloneURL)
+		if err != nil {
+			logger.Warn("failed to map submodule URL to a repo", log.String("path", submodules[i].Path), log.Error(err))
+			continue
+		}
+		submodules[i].Repo = name
+	}
+}
+
+// isRelativeSubmoduleURL returns true if url is relative to the remote URL of
+// the superproject.
+func isRelativeSubmoduleURL(url string) bool {
+	return strings.HasPrefix(url, "./") || strings.HasPrefix(url, "../")
+}
+
+// relativeSubmoduleCloneURL returns the clone URL of a submodule with the
+// relative URL url, of the repo cloned from remoteURL. Like git, it treats
+// remoteURL as a directory, so "../lib" next to "github.com/org/app" is
+// "github.com/org/lib". Credentials of HTTP(S) remote URLs are not included.
+func relativeSubmoduleCloneURL(remoteURL, url string) (string, error) {
+	u, err := vcs.ParseURL(remoteURL)
+	if err != nil {
+		return "", err
+	}
+	if !u.IsSSH() {
+		u.User = nil
+	}
+	u.Path = path.Join(u.Path, url)
+	u.RawPath = ""
+	return u.String(), nil
+}
@@ -0,0 +1,92 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pubdev"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewDartPackagesSyncer(
+	connection *schema.DartPackagesConnection,
+	svc *dependencies.Service,
+	client *pubdev.Client,
+	reposDir string,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("DartPackagesSyncer"),
+		typ:         "dart_packages",
+		scheme:      dependencies.DartPackagesScheme,
+		placeholder: reposource.NewDartVersionedPackage("sourcegraph_placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		reposDir:    reposDir,
+		source:      &dartDependencySource{client: client},
+	}
+}
+
+type dartDependencySource struct {
+	client *pubdev.Client
+}
+
+func (dartDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParseDartVersionedPackage(string(name) + "@" + version), nil
+}
+
+func (dartDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParseDartVersionedPackage(dep), nil
+}
+
+func (dartDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParseDartPackageFromName(name), nil
+}
+
+func (dartDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParseDartPackageFromRepoName(repoName)
+}
+
+func (s *dartDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading Dart package %q", dep.VersionedPackageSyntax())
+	}
+	defer pkgContents.Close()
+
+	if err = unpackDartPackage(pkgContents, dir); err != nil {
+		return errors.Wrapf(err, "failed to unpack Dart package %q", dep.VersionedPackageSyntax())
+	}
+
+	return nil
+}
+
+// unpackDartPackage unpacks the given pub.dev archive into workDir, skipping
+// any files that aren't valid or that are potentially malicious. Unlike most
+// other registries, the files of a Dart package are not nested in a directory.
+func unpackDartPackage(pkg io.Reader, workDir string) error {
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				return false
+			}
+
+			malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	return unpack.Tgz(pkg, workDir, opts)
+}
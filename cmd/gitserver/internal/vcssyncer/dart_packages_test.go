@@ -0,0 +1,75 @@
+package vcssyncer
+
+import (
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackDartPackage(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files []fileInfo
+		want  []string
+	}{
+		{
+			name: "keeps files at the root of the archive",
+			files: []fileInfo{
+				{path: "pubspec.yaml", contents: []byte("name: http")},
+				{path: "lib/http.dart", contents: []byte("library http;")},
+			},
+			want: []string{"/lib/http.dart", "/pubspec.yaml"},
+		},
+		{
+			// pub.dev archives aren't nested in a directory, so a package
+			// that only ships lib/ must not have that directory stripped.
+			name: "doesn't strip a single outermost directory",
+			files: []fileInfo{
+				{path: "lib/http.dart", contents: []byte("library http;")},
+				{path: "lib/src/client.dart", contents: []byte("class Client {}")},
+			},
+			want: []string{"/lib/http.dart", "/lib/src/client.dart"},
+		},
+		{
+			name: "skips path traversal and other malicious paths",
+			files: []fileInfo{
+				{path: "pubspec.yaml", contents: []byte("name: http")},
+				{path: "../evil.dart", contents: []byte("filter me")},
+				{path: "lib/../../evil.dart", contents: []byte("filter me")},
+				{path: "/absolute/path/are/filtered", contents: []byte("filter me")},
+				{path: ".git/config", contents: []byte("filter me")},
+			},
+			want: []string{"/pubspec.yaml"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			workDir := t.TempDir()
+			require.NoError(t, unpackDartPackage(bytes.NewReader(createTgz(t, tc.files)), workDir))
+
+			var got []string
+			require.NoError(t, filepath.Walk(workDir, func(path string, info fs.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if info.IsDir() {
+					return nil
+				}
+				got = append(got, strings.TrimPrefix(path, workDir))
+				return nil
+			}))
+			sort.Strings(got)
+
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Fatalf("-want,+got\n%s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/nuget"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewDotnetPackagesSyncer(
+	connection *schema.DotnetPackagesConnection,
+	svc *dependencies.Service,
+	client *nuget.Client,
+	reposDir string,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("DotnetPackagesSyncer"),
+		typ:         "dotnet_packages",
+		scheme:      dependencies.DotnetPackagesScheme,
+		placeholder: reposource.NewDotnetVersionedPackage("sourcegraph.placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		reposDir:    reposDir,
+		source:      &dotnetDependencySource{client: client, reposDir: reposDir},
+	}
+}
+
+type dotnetDependencySource struct {
+	client   *nuget.Client
+	reposDir string
+}
+
+func (dotnetDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParseDotnetVersionedPackage(string(name) + "@" + version), nil
+}
+
+func (dotnetDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParseDotnetVersionedPackage(dep), nil
+}
+
+func (dotnetDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParseDotnetPackageFromName(name), nil
+}
+
+func (dotnetDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParseDotnetPackageFromRepoName(repoName)
+}
+
+func (s *dotnetDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading NuGet package %q", dep.VersionedPackageSyntax())
+	}
+	defer pkgContents.Close()
+
+	if err = unpackDotnetPackage(pkgContents, s.reposDir, dir); err != nil {
+		return errors.Wrapf(err, "failed to unzip NuGet package %q", dep.VersionedPackageSyntax())
+	}
+
+	return nil
+}
+
+// unpackDotnetPackage unpacks the given .nupkg archive into workDir, skipping
+// the OPC packaging metadata that NuGet adds next to the package contents.
+func unpackDotnetPackage(pkg io.Reader, reposDir, workDir string) error {
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			if path == "[Content_Types].xml" || strings.HasPrefix(path, "_rels/") || strings.HasPrefix(path, "package/") {
+				return false
+			}
+
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				return false
+			}
+
+			malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	// .nupkg files are zip archives, which cannot be unpacked in a streaming
+	// fashion.
+	tmpdir, err := gitserverfs.TempDir(reposDir, "nuget-packages")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpdir)
+
+	zip, zipLen, err := writeZipToTemp(tmpdir, pkg)
+	if err != nil {
+		return err
+	}
+	defer zip.Close()
+
+	return unpack.Zip(zip, zipLen, workDir, opts)
+}
@@ -0,0 +1,55 @@
+package vcssyncer
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackDotnetPackage(t *testing.T) {
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	for _, f := range []fileInfo{
+		{path: "Newtonsoft.Json.nuspec", contents: []byte("<package/>")},
+		{path: "lib/net6.0/Newtonsoft.Json.dll", contents: []byte("binary")},
+		{path: "src/JsonConvert.cs", contents: []byte("class JsonConvert {}")},
+		{path: "[Content_Types].xml", contents: []byte("filter me")},
+		{path: "_rels/.rels", contents: []byte("filter me")},
+		{path: "package/services/metadata/core-properties/1.psmdcp", contents: []byte("filter me")},
+		{path: ".git/index", contents: []byte("filter me")},
+	} {
+		fw, err := zw.Create(f.path)
+		require.NoError(t, err)
+		_, err = fw.Write(f.contents)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	tmp := t.TempDir()
+	require.NoError(t, unpackDotnetPackage(&zipBuf, tmp, tmp))
+
+	var got []string
+	require.NoError(t, filepath.Walk(tmp, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		got = append(got, strings.TrimPrefix(path, tmp))
+		return nil
+	}))
+	sort.Strings(got)
+
+	want := []string{"/Newtonsoft.Json.nuspec", "/lib/net6.0/Newtonsoft.Json.dll", "/src/JsonConvert.cs"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Fatalf("-want,+got\n%s", d)
+	}
+}
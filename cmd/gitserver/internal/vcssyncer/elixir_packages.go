@@ -0,0 +1,130 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/dependencies"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/hex"
+	"github.com/sourcegraph/sourcegraph/internal/unpack"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func NewElixirPackagesSyncer(
+	connection *schema.ElixirPackagesConnection,
+	svc *dependencies.Service,
+	client *hex.Client,
+	reposDir string,
+) VCSSyncer {
+	return &vcsPackagesSyncer{
+		logger:      log.Scoped("ElixirPackagesSyncer"),
+		typ:         "elixir_packages",
+		scheme:      dependencies.ElixirPackagesScheme,
+		placeholder: reposource.NewElixirVersionedPackage("sourcegraph_placeholder", "0.0.0"),
+		svc:         svc,
+		configDeps:  connection.Dependencies,
+		reposDir:    reposDir,
+		source:      &elixirDependencySource{client: client},
+	}
+}
+
+type elixirDependencySource struct {
+	client *hex.Client
+}
+
+func (elixirDependencySource) ParseVersionedPackageFromNameAndVersion(name reposource.PackageName, version string) (reposource.VersionedPackage, error) {
+	return reposource.ParseElixirVersionedPackage(string(name) + "@" + version), nil
+}
+
+func (elixirDependencySource) ParseVersionedPackageFromConfiguration(dep string) (reposource.VersionedPackage, error) {
+	return reposource.ParseElixirVersionedPackage(dep), nil
+}
+
+func (elixirDependencySource) ParsePackageFromName(name reposource.PackageName) (reposource.Package, error) {
+	return reposource.ParseElixirPackageFromName(name), nil
+}
+
+func (elixirDependencySource) ParsePackageFromRepoName(repoName api.RepoName) (reposource.Package, error) {
+	return reposource.ParseElixirPackageFromRepoName(repoName)
+}
+
+func (s *elixirDependencySource) Download(ctx context.Context, dir string, dep reposource.VersionedPackage) error {
+	pkgContents, err := s.client.GetPackageContents(ctx, dep)
+	if err != nil {
+		return errors.Wrapf(err, "error downloading Hex package %q", dep.VersionedPackageSyntax())
+	}
+	defer pkgContents.Close()
+
+	if err = unpackElixirPackage(pkgContents, dir); err != nil {
+		return errors.Wrapf(err, "failed to unpack Hex package %q", dep.VersionedPackageSyntax())
+	}
+
+	return nil
+}
+
+// unpackElixirPackage unpacks the given Hex tarball into workDir. The outer
+// tarball contains the package files in contents.tar.gz and the package
+// metadata in metadata.config.
+func unpackElixirPackage(pkg io.Reader, workDir string) error {
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			return path == "contents.tar.gz" || path == "metadata.config"
+		},
+	}
+
+	tmpDir, err := os.MkdirTemp("", "hex")
+	if err != nil {
+		return errors.Wrap(err, "failed to create a temporary directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := unpack.Tar(pkg, tmpDir, opts); err != nil {
+		return errors.Wrap(err, "failed to unpack downloaded tar")
+	}
+
+	if err := unpackElixirContentsTarGz(filepath.Join(tmpDir, "contents.tar.gz"), workDir); err != nil {
+		return err
+	}
+	metadata, err := os.ReadFile(filepath.Join(tmpDir, "metadata.config"))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(workDir, "hex-metadata.config"), metadata, 0o644)
+}
+
+// unpackElixirContentsTarGz unpacks the given `contents.tar.gz` from a
+// downloaded Hex package.
+func unpackElixirContentsTarGz(path string, workDir string) error {
+	r, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read contents archive file %q", path)
+	}
+	defer r.Close()
+	opts := unpack.Opts{
+		SkipInvalid:    true,
+		SkipDuplicates: true,
+		Filter: func(path string, file fs.FileInfo) bool {
+			size := file.Size()
+
+			const sizeLimit = 15 * 1024 * 1024
+			if size >= sizeLimit {
+				return false
+			}
+
+			malicious := isPotentiallyMaliciousFilepathInArchive(path, workDir)
+			return !malicious
+		},
+	}
+
+	return unpack.Tgz(r, workDir, opts)
+}
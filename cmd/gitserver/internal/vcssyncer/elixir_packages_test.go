@@ -0,0 +1,60 @@
+package vcssyncer
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackElixirPackage(t *testing.T) {
+	contents := createTgz(t, []fileInfo{
+		{path: "mix.exs", contents: []byte("defmodule Jason.MixProject do end")},
+		{path: "lib/jason.ex", contents: []byte("defmodule Jason do end")},
+		{path: "/absolute/path/are/filtered", contents: []byte("filter me")},
+	})
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range []fileInfo{
+		{path: "VERSION", contents: []byte("3")},
+		{path: "CHECKSUM", contents: []byte("abc")},
+		{path: "metadata.config", contents: []byte(`{<<"name">>,<<"jason">>}.`)},
+		{path: "contents.tar.gz", contents: contents},
+	} {
+		require.NoError(t, addFileToTarball(t, tw, f))
+	}
+	require.NoError(t, tw.Close())
+
+	tmp := t.TempDir()
+	require.NoError(t, unpackElixirPackage(&buf, tmp))
+
+	var got []string
+	require.NoError(t, filepath.Walk(tmp, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		got = append(got, strings.TrimPrefix(path, tmp))
+		return nil
+	}))
+	sort.Strings(got)
+
+	want := []string{"/hex-metadata.config", "/lib/jason.ex", "/mix.exs"}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Fatalf("-want,+got\n%s", d)
+	}
+
+	metadata, err := os.ReadFile(filepath.Join(tmp, "hex-metadata.config"))
+	require.NoError(t, err)
+	require.Equal(t, `{<<"name">>,<<"jason">>}.`, string(metadata))
+}
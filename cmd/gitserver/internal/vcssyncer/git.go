@@ -83,9 +83,20 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 	}
 	tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", tmpPath)
 
+	// Large repos can be configured to be cloned without big blobs. Custom
+	// fetch commands are run as is, so they can't be combined with this.
+	blobSizeLimit := partialCloneBlobSizeLimit(remoteURL)
+	partial := blobSizeLimit != "" && customFetchCmd(ctx, remoteURL) == nil
+	if partial {
+		if err := git.ConfigurePartialClone(ctx, common.GitDir(tmpPath), blobSizeLimit); err != nil {
+			return &common.GitCommandError{Err: err}
+		}
+		tryWrite(s.logger, progressWriter, "Configured partial clone omitting blobs larger than %s\n", blobSizeLimit)
+	}
+
 	// Now we build our fetch command. We don't actually clone, instead we init
 	// a bare repository and fetch all refs from remote once into local refs.
-	cmd, _ := s.fetchCommand(ctx, remoteURL)
+	cmd, _ := s.fetchCommand(ctx, remoteURL, partial)
 	cmd.Dir = tmpPath
 	if cmd.Env == nil {
 		cmd.Env = os.Environ()
@@ -93,6 +104,9 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 	// see issue #7322: skip LFS content in repositories with Git LFS configured.
 	cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
 	executil.ConfigureRemoteGitCommand(cmd)
+	if partial {
+		cmd.Env = append(cmd.Env, executil.PromisorRemoteEnv(git.PromisorRemote, remoteURL.String())...)
+	}
 
 	tryWrite(s.logger, progressWriter, "Fetching remote contents\n")
 	redactor := urlredactor.New(remoteURL)
@@ -110,8 +124,14 @@ func (s *gitRepoSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL
 
 // Fetch tries to fetch updates of a Git repository.
 func (s *gitRepoSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, repoName api.RepoName, dir common.GitDir, _ string) ([]byte, error) {
-	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL)
+	// Partial clones have to fetch through their promisor remote, so that the
+	// configured filter is applied to the fetch.
+	partial := git.IsPartialClone(dir)
+	cmd, configRemoteOpts := s.fetchCommand(ctx, remoteURL, partial)
 	dir.Set(cmd)
+	if partial {
+		cmd.Env = append(cmd.Env, executil.PromisorRemoteEnv(git.PromisorRemote, remoteURL.String())...)
+	}
 	r := urlredactor.New(remoteURL)
 	output, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), configRemoteOpts)
 	if err != nil {
@@ -125,16 +145,24 @@ func (s *gitRepoSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.UR
 	return exec.CommandContext(ctx, "git", "remote", "show", remoteURL.String()), nil
 }
 
-func (s *gitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, configRemoteOpts bool) {
+// fetchCommand returns the command to fetch all refs from remoteURL. If
+// promisor is true, the fetch goes through git.PromisorRemote instead, whose URL
+// has to be provided with executil.PromisorRemoteEnv.
+func (s *gitRepoSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL, promisor bool) (cmd *exec.Cmd, configRemoteOpts bool) {
+	remote := remoteURL.String()
+	if promisor {
+		remote = git.PromisorRemote
+	}
+
 	configRemoteOpts = true
 	if customCmd := customFetchCmd(ctx, remoteURL); customCmd != nil {
 		cmd = customCmd
 		configRemoteOpts = false
 	} else if useRefspecOverrides() {
-		cmd = refspecOverridesFetchCmd(ctx, remoteURL)
+		cmd = refspecOverridesFetchCmd(ctx, remote)
 	} else {
 		cmd = exec.CommandContext(ctx, "git", "fetch",
-			"--progress", "--prune", remoteURL.String(),
+			"--progress", "--prune", remote,
 			// Normal git refs
 			"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
 			// GitHub pull requests
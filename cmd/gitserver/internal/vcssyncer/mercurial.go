@@ -0,0 +1,154 @@
+package vcssyncer
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// mercurialDefaultBranch is the ref git-cinnabar publishes the tip of the
+// Mercurial "default" branch as. It becomes HEAD of the converted repository.
+const mercurialDefaultBranch = "refs/heads/branches/default/tip"
+
+// mercurialSyncer is a syncer for Mercurial repositories.
+//
+// The conversion to Git is done by the git-cinnabar remote helper, which git
+// invokes for remote URLs with the "hg::" prefix. git-cinnabar stores the
+// metadata of the conversion in the Git repository itself, so a fetch only
+// converts the changesets that were added since the previous one.
+type mercurialSyncer struct {
+	logger                  log.Logger
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+}
+
+func NewMercurialSyncer(logger log.Logger, r *wrexec.RecordingCommandFactory) VCSSyncer {
+	return &mercurialSyncer{
+		logger:                  logger.Scoped("MercurialSyncer"),
+		recordingCommandFactory: r,
+	}
+}
+
+func (s *mercurialSyncer) Type() string {
+	return "mercurial"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *mercurialSyncer) IsCloneable(ctx context.Context, repoName api.RepoName, remoteURL *vcs.URL) error {
+	args := []string{"ls-remote", mercurialRemote(remoteURL), "HEAD"}
+	ctx, cancel := context.WithTimeout(ctx, executil.ShortGitCommandTimeout(args))
+	defer cancel()
+
+	r := urlredactor.New(remoteURL)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	out, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = &common.GitCommandError{Err: err, Output: r.Redact(string(out))}
+		}
+		return err
+	}
+	return nil
+}
+
+// Clone converts a Mercurial repository into a Git repository at tmpPath. It
+// reports redacted progress logs via the progressWriter.
+func (s *mercurialSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, _ common.GitDir, tmpPath string, progressWriter io.Writer) error {
+	// First, make sure the tmpPath exists.
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	tryWrite(s.logger, progressWriter, "Creating bare repo\n")
+	if err := git.MakeBareRepo(ctx, tmpPath); err != nil {
+		return &common.GitCommandError{Err: err}
+	}
+	tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", tmpPath)
+
+	cmd := s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	cmd.Env = os.Environ()
+	executil.ConfigureRemoteGitCommand(cmd)
+
+	tryWrite(s.logger, progressWriter, "Converting repository using git-cinnabar\n")
+	redactor := urlredactor.New(remoteURL)
+	wrCmd := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, cmd).WithRedactorFunc(redactor.Redact)
+	// Note: Using RunCommandWriteOutput here does NOT store the output of the
+	// command as the command output of the wrexec command, because the pipes are
+	// already used.
+	exitCode, err := executil.RunCommandWriteOutput(ctx, wrCmd, progressWriter, redactor.Redact)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run hg->git conversion: exit code %d", exitCode)
+	}
+
+	if err := setHEAD(ctx, common.GitDir(tmpPath), mercurialDefaultBranch); err != nil {
+		return err
+	}
+	tryWrite(s.logger, progressWriter, "Converted repository!\n")
+
+	return nil
+}
+
+// Fetch converts the changesets that were added to the Mercurial repository
+// since the last fetch.
+func (s *mercurialSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, repoName api.RepoName, dir common.GitDir, _ string) ([]byte, error) {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	r := urlredactor.New(remoteURL)
+	output, err := executil.RunRemoteGitCommand(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact), true)
+	if err != nil {
+		return nil, &common.GitCommandError{Err: err, Output: r.Redact(string(output))}
+	}
+
+	if err := setHEAD(ctx, dir, mercurialDefaultBranch); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing the remote
+// of a Mercurial repository.
+func (s *mercurialSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", mercurialRemote(remoteURL)), nil
+}
+
+// fetchCommand returns the git fetch command that converts the repository.
+// Mercurial bookmarks are published as branches of the same name and the tip
+// of every named branch as branches/<name>/tip.
+func (s *mercurialSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	return exec.CommandContext(ctx, "git",
+		"-c", "cinnabar.refs=bookmarks,tips",
+		"fetch", "--progress", "--prune", mercurialRemote(remoteURL),
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+}
+
+// mercurialRemote returns the remote that makes git use git-cinnabar for
+// remoteURL.
+func mercurialRemote(remoteURL *vcs.URL) string {
+	return "hg::" + remoteURL.String()
+}
+
+// setHEAD points HEAD of the repository in dir at ref.
+func setHEAD(ctx context.Context, dir common.GitDir, ref string) error {
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "HEAD", ref)
+	dir.Set(cmd)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to set HEAD to %s: %s", ref, string(out))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package vcssyncer
+
+import (
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+var partialCloneMappings = conf.Cached(func() []*schema.GitPartialCloneMapping {
+	return conf.ExperimentalFeatures().GitPartialClone
+})
+
+// partialCloneBlobSizeLimit returns the blob size limit to partially clone
+// remoteURL with, or an empty string if it should be cloned in full.
+func partialCloneBlobSizeLimit(remoteURL *vcs.URL) string {
+	return matchPartialCloneMapping(partialCloneMappings(), remoteURL)
+}
+
+// matchPartialCloneMapping returns the blob size limit of the mapping with the
+// longest domainPath that is a prefix of remoteURL.
+func matchPartialCloneMapping(mappings []*schema.GitPartialCloneMapping, remoteURL *vcs.URL) string {
+	dp := strings.TrimSuffix(path.Join(remoteURL.Host, remoteURL.Path), ".git")
+
+	var match *schema.GitPartialCloneMapping
+	matchLen := -1
+	for _, m := range mappings {
+		prefix := strings.Trim(m.DomainPath, "/")
+		if dp != prefix && !strings.HasPrefix(dp, prefix+"/") {
+			continue
+		}
+		if len(prefix) > matchLen {
+			match, matchLen = m, len(prefix)
+		}
+	}
+
+	if match == nil {
+		return ""
+	}
+	return match.BlobSizeLimit
+}
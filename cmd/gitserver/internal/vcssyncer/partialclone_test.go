@@ -0,0 +1,39 @@
+package vcssyncer
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMatchPartialCloneMapping(t *testing.T) {
+	mappings := []*schema.GitPartialCloneMapping{
+		{DomainPath: "github.com", BlobSizeLimit: "10m"},
+		{DomainPath: "github.com/foo/monorepo", BlobSizeLimit: "1m"},
+		{DomainPath: "gitlab.com/bar/", BlobSizeLimit: "0"},
+	}
+
+	for _, tc := range []struct {
+		remoteURL string
+		want      string
+	}{
+		{remoteURL: "https://github.com/foo/small", want: "10m"},
+		{remoteURL: "https://token@github.com/foo/monorepo", want: "1m"},
+		{remoteURL: "git@github.com:foo/monorepo.git", want: "1m"},
+		{remoteURL: "https://github.com/foo/monorepo-other", want: "10m"},
+		{remoteURL: "https://gitlab.com/bar/baz", want: "0"},
+		{remoteURL: "https://gitlab.com/barbaz/qux", want: ""},
+		{remoteURL: "https://bitbucket.org/foo/monorepo", want: ""},
+	} {
+		t.Run(tc.remoteURL, func(t *testing.T) {
+			remoteURL, err := vcs.ParseURL(tc.remoteURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := matchPartialCloneMapping(mappings, remoteURL); got != tc.want {
+				t.Errorf("got blob size limit %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
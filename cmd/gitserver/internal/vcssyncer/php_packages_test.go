@@ -0,0 +1,85 @@
+package vcssyncer
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackPhpPackage(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files []fileInfo
+		want  []string
+	}{
+		{
+			name: "strips the single outermost directory",
+			files: []fileInfo{
+				{path: "guzzle-guzzle-abc123/composer.json", contents: []byte(`{"name":"guzzlehttp/guzzle"}`)},
+				{path: "guzzle-guzzle-abc123/src/Client.php", contents: []byte("<?php class Client {}")},
+			},
+			want: []string{"/composer.json", "/src/Client.php"},
+		},
+		{
+			name: "keeps files that aren't nested in a single directory",
+			files: []fileInfo{
+				{path: "composer.json", contents: []byte(`{"name":"guzzlehttp/guzzle"}`)},
+				{path: "src/Client.php", contents: []byte("<?php class Client {}")},
+			},
+			want: []string{"/composer.json", "/src/Client.php"},
+		},
+		{
+			name: "skips path traversal and other malicious paths",
+			files: []fileInfo{
+				{path: "guzzle-guzzle-abc123/composer.json", contents: []byte(`{"name":"guzzlehttp/guzzle"}`)},
+				{path: "../evil.php", contents: []byte("filter me")},
+				{path: "guzzle-guzzle-abc123/../../evil.php", contents: []byte("filter me")},
+				{path: "/absolute/path/are/filtered", contents: []byte("filter me")},
+				{path: "guzzle-guzzle-abc123/.git/config", contents: []byte("filter me")},
+			},
+			want: []string{"/composer.json"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var zipBuf bytes.Buffer
+			zw := zip.NewWriter(&zipBuf)
+			for _, f := range tc.files {
+				fw, err := zw.Create(f.path)
+				require.NoError(t, err)
+				_, err = fw.Write(f.contents)
+				require.NoError(t, err)
+			}
+			require.NoError(t, zw.Close())
+
+			reposDir := t.TempDir()
+			workDir := t.TempDir()
+			require.NoError(t, unpackPhpPackage(&zipBuf, reposDir, workDir))
+
+			var got []string
+			require.NoError(t, filepath.Walk(workDir, func(path string, info fs.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if info.IsDir() {
+					return nil
+				}
+				got = append(got, strings.TrimPrefix(path, workDir))
+				return nil
+			}))
+			sort.Strings(got)
+
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Fatalf("-want,+got\n%s", d)
+			}
+		})
+	}
+}
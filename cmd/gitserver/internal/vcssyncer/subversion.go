@@ -0,0 +1,301 @@
+package vcssyncer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/common"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/executil"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/git"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/urlredactor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// svnRemoteRefPrefix is the prefix of the refs git svn fetches Subversion
+// branches and tags into.
+const svnRemoteRefPrefix = "refs/remotes/svn/"
+
+// svnPasswordEnv is the environment variable svnAskPassScript reads the
+// password from.
+const svnPasswordEnv = "SRC_SVN_PASSWORD"
+
+// svnAskPassScript answers the password prompts of git svn without writing
+// the password to disk or exposing it in the arguments of the command.
+const svnAskPassScript = "#!/bin/sh\nprintf '%s\\n' \"$" + svnPasswordEnv + "\"\n"
+
+// subversionSyncer is a syncer for Subversion repositories.
+//
+// The conversion to Git is done by git svn, which keeps the metadata of the
+// conversion in the Git repository, so a fetch only converts the revisions
+// that were committed since the previous one.
+type subversionSyncer struct {
+	logger                  log.Logger
+	recordingCommandFactory *wrexec.RecordingCommandFactory
+
+	// StandardLayout is true if the repositories use the trunk/, branches/ and
+	// tags/ layout.
+	StandardLayout bool
+}
+
+func NewSubversionSyncer(logger log.Logger, r *wrexec.RecordingCommandFactory, connection *schema.SubversionConnection) VCSSyncer {
+	return &subversionSyncer{
+		logger:                  logger.Scoped("SubversionSyncer"),
+		recordingCommandFactory: r,
+		StandardLayout:          connection.Layout != "flat",
+	}
+}
+
+func (s *subversionSyncer) Type() string {
+	return "subversion"
+}
+
+// IsCloneable checks to see if the Subversion repository exists and can be
+// read with the credentials of the remote URL.
+func (s *subversionSyncer) IsCloneable(ctx context.Context, repoName api.RepoName, remoteURL *vcs.URL) error {
+	repoURL, username, password := decomposeSubversionRemoteURL(remoteURL)
+
+	args := []string{"info", "--non-interactive", "--no-auth-cache"}
+	if username != "" {
+		args = append(args, "--username", username, "--password-from-stdin")
+	}
+	args = append(args, repoURL)
+
+	ctx, cancel := context.WithTimeout(ctx, executil.ShortGitCommandTimeout(args))
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "svn", args...)
+	cmd.Stdin = strings.NewReader(password)
+
+	r := urlredactor.New(remoteURL)
+	out, err := executil.RunCommandCombinedOutput(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(r.Redact))
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = &common.GitCommandError{Err: err, Output: r.Redact(string(out))}
+		}
+		return err
+	}
+	return nil
+}
+
+// Clone converts a Subversion repository into a Git repository at tmpPath. It
+// reports redacted progress logs via the progressWriter.
+func (s *subversionSyncer) Clone(ctx context.Context, repo api.RepoName, remoteURL *vcs.URL, _ common.GitDir, tmpPath string, progressWriter io.Writer) (err error) {
+	// First, make sure the tmpPath exists.
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	tryWrite(s.logger, progressWriter, "Creating bare repo\n")
+	if err := git.MakeBareRepo(ctx, tmpPath); err != nil {
+		return &common.GitCommandError{Err: err}
+	}
+	tryWrite(s.logger, progressWriter, "Created bare repo at %s\n", tmpPath)
+
+	repoURL, username, password := decomposeSubversionRemoteURL(remoteURL)
+	dir := common.GitDir(tmpPath)
+	redactor := urlredactor.New(remoteURL)
+
+	// Example: git svn init --prefix=svn/ --stdlayout --username=admin https://svn.example.com/repos/project
+	args := []string{"svn", "init", "--prefix=svn/"}
+	if s.StandardLayout {
+		args = append(args, "--stdlayout")
+	}
+	args = append(args, s.authArgs(username)...)
+	args = append(args, repoURL)
+	initCmd := exec.CommandContext(ctx, "git", args...)
+	dir.Set(initCmd)
+	if out, err := executil.RunCommandCombinedOutput(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, initCmd).WithRedactorFunc(redactor.Redact)); err != nil {
+		return errors.Wrapf(err, "failed to initialize git svn: %s", redactor.Redact(string(out)))
+	}
+
+	tryWrite(s.logger, progressWriter, "Converting repository using git svn\n")
+	fetchCmd, cleanup, err := s.fetchCommand(ctx, username, password)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	dir.Set(fetchCmd)
+	wrCmd := s.recordingCommandFactory.WrapWithRepoName(ctx, s.logger, repo, fetchCmd).WithRedactorFunc(redactor.Redact)
+	// Note: Using RunCommandWriteOutput here does NOT store the output of the
+	// command as the command output of the wrexec command, because the pipes are
+	// already used.
+	exitCode, err := executil.RunCommandWriteOutput(ctx, wrCmd, progressWriter, redactor.Redact)
+	if err != nil {
+		return errors.Wrapf(err, "failed to run svn->git conversion: exit code %d", exitCode)
+	}
+
+	if err := publishSubversionRefs(ctx, dir); err != nil {
+		return err
+	}
+	if err := setHEAD(ctx, dir, "refs/heads/master"); err != nil {
+		return err
+	}
+	tryWrite(s.logger, progressWriter, "Converted repository!\n")
+
+	return nil
+}
+
+// Fetch converts the revisions that were committed to the Subversion
+// repository since the last fetch.
+func (s *subversionSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, repoName api.RepoName, dir common.GitDir, _ string) ([]byte, error) {
+	_, username, password := decomposeSubversionRemoteURL(remoteURL)
+
+	// Example: git svn fetch --username=admin
+	cmd, cleanup, err := s.fetchCommand(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+	dir.Set(cmd)
+
+	redactor := urlredactor.New(remoteURL)
+	output, err := executil.RunCommandCombinedOutput(ctx, s.recordingCommandFactory.WrapWithRepoName(ctx, log.NoOp(), repoName, cmd).WithRedactorFunc(redactor.Redact))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update with output %q", redactor.Redact(string(output)))
+	}
+
+	if err := publishSubversionRefs(ctx, dir); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing the remote
+// of a Subversion repository.
+func (s *subversionSyncer) RemoteShowCommand(ctx context.Context, _ *vcs.URL) (cmd *exec.Cmd, err error) {
+	// git svn does not configure a Git remote, the remote info is encoded in
+	// the current repository.
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+func (s *subversionSyncer) authArgs(username string) []string {
+	if username == "" {
+		return nil
+	}
+	return []string{"--username=" + username, "--no-auth-cache"}
+}
+
+// fetchCommand returns the git svn fetch command. The returned cleanup
+// function must be called once the command finished.
+func (s *subversionSyncer) fetchCommand(ctx context.Context, username, password string) (cmd *exec.Cmd, cleanup func(), err error) {
+	cmd = exec.CommandContext(ctx, "git", append([]string{"svn", "fetch"}, s.authArgs(username)...)...)
+	cmd.Env = os.Environ()
+	if password == "" {
+		return cmd, func() {}, nil
+	}
+
+	f, err := os.CreateTemp("", "svn-askpass-*")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create askpass script")
+	}
+	cleanup = func() { os.Remove(f.Name()) }
+	_, err = f.WriteString(svnAskPassScript)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), 0o700)
+	}
+	if err != nil {
+		cleanup()
+		return nil, nil, errors.Wrap(err, "failed to write askpass script")
+	}
+
+	cmd.Env = append(cmd.Env, "GIT_ASKPASS="+f.Name(), svnPasswordEnv+"="+password)
+	return cmd, cleanup, nil
+}
+
+// decomposeSubversionRemoteURL splits the credentials off remoteURL. svn+ssh
+// URLs keep the username, since the SSH tunnel authenticates with it.
+func decomposeSubversionRemoteURL(remoteURL *vcs.URL) (repoURL, username, password string) {
+	u := *remoteURL
+	u.User = nil
+	if remoteURL.User != nil {
+		username = remoteURL.User.Username()
+		password, _ = remoteURL.User.Password()
+		if remoteURL.Scheme == "svn+ssh" {
+			u.User = url.User(username)
+			username = ""
+		}
+	}
+	return u.String(), username, password
+}
+
+// publishSubversionRefs updates the branches and tags of the repository in
+// dir to the Subversion refs fetched by git svn.
+func publishSubversionRefs(ctx context.Context, dir common.GitDir) error {
+	cmd := exec.CommandContext(ctx, "git", "for-each-ref", "--format=%(objectname) %(refname)", svnRemoteRefPrefix)
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		return errors.Wrap(err, "failed to list git svn refs")
+	}
+
+	var updates bytes.Buffer
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		oid, remoteRef, ok := strings.Cut(sc.Text(), " ")
+		if !ok {
+			continue
+		}
+		if ref, ok := subversionRef(remoteRef); ok {
+			fmt.Fprintf(&updates, "update %s %s\n", ref, oid)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if updates.Len() == 0 {
+		return nil
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "update-ref", "--stdin")
+	dir.Set(cmd)
+	cmd.Stdin = &updates
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "failed to update refs: %s", string(out))
+	}
+	return nil
+}
+
+// subversionRef returns the ref a ref fetched by git svn is published as, or
+// false if it is not published.
+func subversionRef(remoteRef string) (string, bool) {
+	if !strings.HasPrefix(remoteRef, svnRemoteRefPrefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(remoteRef, svnRemoteRefPrefix)
+	switch {
+	case name == "":
+		return "", false
+	case strings.Contains(name, "@"):
+		// git svn keeps the history of deleted or replaced branches as
+		// <name>@<revision>.
+		return "", false
+	case name == "trunk" || name == "git-svn":
+		// git-svn is the single ref of repositories that don't use the
+		// standard layout.
+		return "refs/heads/master", true
+	case strings.HasPrefix(name, "tags/"):
+		return "refs/tags/" + strings.TrimPrefix(name, "tags/"), true
+	default:
+		return "refs/heads/" + name, true
+	}
+}
@@ -18,7 +18,11 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/crates"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodproxy"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/hex"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/npm"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/nuget"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/packagist"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/pubdev"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/pypi"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/rubygems"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
@@ -179,6 +183,58 @@ func NewVCSSyncer(ctx context.Context, opts *NewVCSSyncerOpts) (VCSSyncer, error
 			return nil, err
 		}
 		return NewRubyPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.TypeNugetPackages:
+		var c schema.DotnetPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := nuget.NewClient(urn, c.Registry, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return NewDotnetPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.TypePackagistPackages:
+		var c schema.PhpPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := packagist.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return NewPhpPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.TypeHexPackages:
+		var c schema.ElixirPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := hex.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return NewElixirPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.TypePubPackages:
+		var c schema.DartPackagesConnection
+		urn, err := extractOptions(&c)
+		if err != nil {
+			return nil, err
+		}
+		cli, err := pubdev.NewClient(urn, c.Repository, httpcli.ExternalClientFactory)
+		if err != nil {
+			return nil, err
+		}
+		return NewDartPackagesSyncer(&c, opts.DepsSvc, cli, opts.ReposDir), nil
+	case extsvc.VariantMercurial.AsType():
+		return NewMercurialSyncer(opts.Logger, opts.RecordingCommandFactory), nil
+	case extsvc.VariantSubversion.AsType():
+		var c schema.SubversionConnection
+		if _, err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return NewSubversionSyncer(opts.Logger, opts.RecordingCommandFactory, &c), nil
 	}
 
 	return NewGitRepoSyncer(opts.Logger, opts.RecordingCommandFactory), nil
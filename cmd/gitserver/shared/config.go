@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 	"github.com/sourcegraph/sourcegraph/internal/hostname"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -36,8 +37,12 @@ type Config struct {
 	SyncRepoStateUpdatePerSecond   int
 	BatchLogGlobalConcurrencyLimit int
 
+	ReplicaSyncInterval time.Duration
+
 	JanitorReposDesiredPercentFree int
 	JanitorInterval                time.Duration
+
+	ColdStorage *coldstorage.Config
 }
 
 func (c *Config) Load() {
@@ -74,6 +79,8 @@ func (c *Config) Load() {
 	c.SyncRepoStateUpdatePerSecond = c.GetInt("SRC_REPOS_SYNC_STATE_UPSERT_PER_SEC", "500", "The number of updated rows allowed per second across all gitserver instances")
 	c.BatchLogGlobalConcurrencyLimit = c.GetInt("SRC_BATCH_LOG_GLOBAL_CONCURRENCY_LIMIT", "256", "The maximum number of in-flight Git commands from all /batch-log requests combined")
 
+	c.ReplicaSyncInterval = c.GetInterval("SRC_GITSERVER_REPLICA_SYNC_INTERVAL", "1m", "Interval between syncs of the replicas stored on this gitserver")
+
 	// Align these variables with the 'disk_space_remaining' alerts in monitoring
 	c.JanitorReposDesiredPercentFree = c.GetInt("SRC_REPOS_DESIRED_PERCENT_FREE", "10", "Target percentage of free space on disk.")
 	if c.JanitorReposDesiredPercentFree < 0 {
@@ -84,4 +91,14 @@ func (c *Config) Load() {
 	}
 
 	c.JanitorInterval = c.GetInterval("SRC_REPOS_JANITOR_INTERVAL", "1m", "Interval between cleanup runs")
+
+	c.ColdStorage = &coldstorage.Config{}
+	c.ColdStorage.Load()
+}
+
+func (c *Config) Validate() error {
+	var errs error
+	errs = errors.Append(errs, c.BaseConfig.Validate())
+	errs = errors.Append(errs, c.ColdStorage.Validate())
+	return errs
 }
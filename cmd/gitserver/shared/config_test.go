@@ -40,6 +40,9 @@ func TestConfigDefaults(t *testing.T) {
 	if have, want := config.JanitorInterval, time.Minute; have != want {
 		t.Errorf("invalid value for JanitorInterval: have=%s want=%s", have, want)
 	}
+	if config.ColdStorage.Enabled {
+		t.Errorf("invalid value for ColdStorage.Enabled: have=true want=false")
+	}
 }
 
 func TestConfig_PercentFree(t *testing.T) {
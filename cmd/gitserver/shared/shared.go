@@ -19,6 +19,7 @@ import (
 	server "github.com/sourcegraph/sourcegraph/cmd/gitserver/internal"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/accesslog"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/cloneurl"
+	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/coldstorage"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/gitserverfs"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/perforce"
 	"github.com/sourcegraph/sourcegraph/cmd/gitserver/internal/vcssyncer"
@@ -82,6 +83,15 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 	recordingCommandFactory := wrexec.NewRecordingCommandFactory(nil, 0)
 	cloneQueue := server.NewCloneQueue(observationCtx, list.New())
 	locker := server.NewRepositoryLocker()
+
+	var coldStorage *coldstorage.Store
+	if config.ColdStorage.Enabled {
+		coldStorage, err = coldstorage.New(ctx, observationCtx, config.ColdStorage, config.ReposDir)
+		if err != nil {
+			return errors.Wrap(err, "initializing cold storage")
+		}
+	}
+
 	gitserver := server.Server{
 		Logger:         logger,
 		ObservationCtx: observationCtx,
@@ -108,6 +118,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		Perforce:                perforce.NewService(ctx, observationCtx, logger, db, list.New()),
 		RecordingCommandFactory: recordingCommandFactory,
 		Locker:                  locker,
+		ColdStorage:             coldStorage,
 		RPSLimiter: ratelimit.NewInstrumentedLimiter(
 			ratelimit.GitRPSLimiterBucketName,
 			ratelimit.NewGlobalRateLimiter(logger, ratelimit.GitRPSLimiterBucketName),
@@ -156,6 +167,15 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 			config.SyncRepoStateBatchSize,
 			config.SyncRepoStateUpdatePerSecond,
 		),
+		server.NewReplicaSyncer(
+			ctx,
+			logger,
+			db,
+			locker,
+			gitserver.Hostname,
+			config.ReposDir,
+			config.ReplicaSyncInterval,
+		),
 	}
 
 	if runtime.GOOS == "windows" {
@@ -171,10 +191,14 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 					JanitorInterval:    config.JanitorInterval,
 					ReposDir:           config.ReposDir,
 					DesiredPercentFree: config.JanitorReposDesiredPercentFree,
+
+					ColdStorage:          coldStorage,
+					ColdStorageIdleAfter: config.ColdStorage.IdleAfter,
 				},
 				db,
 				recordingCommandFactory,
 				gitserver.CloneRepo,
+				gitserver.LockRepo,
 				logger,
 			),
 		)
@@ -0,0 +1,319 @@
+package search
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// maxArchiveSize is the limit on the size in bytes of archives that are
+	// expanded, and of the files decompressed from gzip files. Archives are
+	// read into memory to be expanded.
+	maxArchiveSize = 64 << 20
+
+	// maxArchiveDepth is how deeply archives inside of archives are expanded.
+	// 1 only expands the archives in the repository.
+	maxArchiveDepth = 3
+
+	// maxArchiveExpandedSize is the limit on the number of bytes decompressed
+	// from an archive in the repository, including the archives nested in it.
+	// It protects against archives which expand to a huge size ("zip bombs").
+	maxArchiveExpandedSize = 256 << 20
+)
+
+// archiveSeparator separates the path of an archive from the path of a file
+// inside of it, like in "lib/foo.jar!/com/x/Y.java".
+const archiveSeparator = "!/"
+
+type archiveKind int
+
+const (
+	archiveNone archiveKind = iota
+	archiveZip
+	archiveTar
+	archiveTarGz
+	archiveGz
+)
+
+// archiveKindOf returns the kind of archive a file is judging by its name.
+func archiveKindOf(name string) archiveKind {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".zip"), strings.HasSuffix(name, ".jar"), strings.HasSuffix(name, ".war"), strings.HasSuffix(name, ".ear"), strings.HasSuffix(name, ".aar"):
+		return archiveZip
+	case strings.HasSuffix(name, ".tar"):
+		return archiveTar
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return archiveTarGz
+	case strings.HasSuffix(name, ".gz"):
+		return archiveGz
+	default:
+		return archiveNone
+	}
+}
+
+// copyArchive writes the archive hdr from tr to zw, followed by the files
+// inside of it. Like other binary files, only the name of the archive itself
+// is searchable. Archives that are too large, too deeply nested or invalid are
+// not expanded, or only partially.
+func copyArchive(tr *tar.Reader, zw *zip.Writer, filter *searchableFilter, hdr *tar.Header) error {
+	if _, err := zw.CreateHeader(&zip.FileHeader{Name: hdr.Name, Method: zip.Store}); err != nil {
+		return err
+	}
+	if hdr.Size > maxArchiveSize {
+		metricArchivesSkipped.WithLabelValues("too_large").Inc()
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(tr, hdr.Size))
+	if err != nil {
+		return err
+	}
+
+	e := &archiveExpander{zw: zw, filter: filter, remaining: maxArchiveExpandedSize}
+	err = e.expand(hdr.Name, data, 1)
+	if errors.Is(err, errArchiveExpandedSize) {
+		metricArchivesSkipped.WithLabelValues("expanded_size").Inc()
+		return nil
+	}
+	var invalid invalidArchiveError
+	if errors.As(err, &invalid) {
+		metricArchivesSkipped.WithLabelValues("invalid").Inc()
+		return nil
+	}
+	return err
+}
+
+var errArchiveExpandedSize = errors.New("archive exceeds maxArchiveExpandedSize")
+
+// invalidArchiveError is returned for archives that can't be read. Unlike
+// errors writing the zip, it only stops the expansion of the archive.
+type invalidArchiveError struct {
+	error
+}
+
+// archiveExpander writes the files inside of an archive in the repository to
+// a zip.
+type archiveExpander struct {
+	zw     *zip.Writer
+	filter *searchableFilter
+
+	// remaining is the number of bytes that may still be decompressed.
+	remaining int64
+}
+
+// expand writes the files of the archive name with contents data. depth is
+// the nesting level of the archive.
+func (e *archiveExpander) expand(name string, data []byte, depth int) error {
+	switch archiveKindOf(name) {
+	case archiveZip:
+		zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		for _, f := range zr.File {
+			if !f.Mode().IsRegular() {
+				continue
+			}
+			if err := e.addZipFile(name, f, depth); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case archiveTar:
+		return e.expandTar(name, bytes.NewReader(data), depth)
+
+	case archiveTarGz:
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		return e.expandTar(name, gr, depth)
+
+	case archiveGz:
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		// A gzip file contains a single file, named like the gzip file
+		// without its extension.
+		inner := path.Base(name)
+		inner = inner[:len(inner)-len(".gz")]
+		virtualPath := name + archiveSeparator + inner
+		// The size is unknown up front. Files larger than maxArchiveSize are
+		// added by name only.
+		limit := int64(maxArchiveSize + 1)
+		if limit > e.remaining+1 {
+			limit = e.remaining + 1
+		}
+		contents, err := io.ReadAll(io.LimitReader(gr, limit))
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		e.remaining -= int64(len(contents))
+		if e.remaining < 0 {
+			return errArchiveExpandedSize
+		}
+		size := int64(len(contents))
+		if size > maxArchiveSize || !e.wantContents(virtualPath, size, depth) {
+			contents = nil
+		}
+		return e.add(virtualPath, size, contents, depth)
+
+	default:
+		return nil
+	}
+}
+
+func (e *archiveExpander) expandTar(name string, r io.Reader, depth int) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		virtualPath, ok := archivePath(name, hdr.Name)
+		if !ok {
+			continue
+		}
+		var contents []byte
+		if e.wantContents(virtualPath, hdr.Size, depth) {
+			if contents, err = e.read(tr, hdr.Size); err != nil {
+				return err
+			}
+		}
+		if err := e.add(virtualPath, hdr.Size, contents, depth); err != nil {
+			return err
+		}
+	}
+}
+
+func (e *archiveExpander) addZipFile(name string, f *zip.File, depth int) error {
+	virtualPath, ok := archivePath(name, f.Name)
+	if !ok {
+		return nil
+	}
+	size := int64(f.UncompressedSize64)
+
+	var contents []byte
+	if e.wantContents(virtualPath, size, depth) {
+		rc, err := f.Open()
+		if err != nil {
+			return invalidArchiveError{err}
+		}
+		contents, err = e.read(rc, size)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return e.add(virtualPath, size, contents, depth)
+}
+
+// wantContents returns true if the contents of the file at virtualPath inside
+// an archive of the given depth are needed, either to search them or to
+// expand the file as an archive too.
+func (e *archiveExpander) wantContents(virtualPath string, size int64, depth int) bool {
+	if e.filter.Ignore(&tar.Header{Name: virtualPath, Size: size}) {
+		return false
+	}
+	if archiveKindOf(virtualPath) != archiveNone {
+		return depth < maxArchiveDepth && size <= maxArchiveSize
+	}
+	return !e.filter.SkipContent(&tar.Header{Name: virtualPath, Size: size})
+}
+
+// read reads at most size bytes from r, counting them against the expanded
+// size limit.
+func (e *archiveExpander) read(r io.Reader, size int64) ([]byte, error) {
+	if size > e.remaining {
+		return nil, errArchiveExpandedSize
+	}
+	contents, err := io.ReadAll(io.LimitReader(r, size))
+	e.remaining -= int64(len(contents))
+	if err != nil {
+		return nil, invalidArchiveError{err}
+	}
+	return contents, nil
+}
+
+// add writes the file at virtualPath inside of an archive of the given depth
+// to the zip. contents is nil if they are not searched.
+func (e *archiveExpander) add(virtualPath string, size int64, contents []byte, depth int) error {
+	if e.filter.Ignore(&tar.Header{Name: virtualPath, Size: size}) {
+		return nil
+	}
+
+	w, err := e.zw.CreateHeader(&zip.FileHeader{Name: virtualPath, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+
+	if archiveKindOf(virtualPath) != archiveNone {
+		if contents == nil {
+			if depth >= maxArchiveDepth {
+				metricArchivesSkipped.WithLabelValues("too_deep").Inc()
+			} else {
+				metricArchivesSkipped.WithLabelValues("too_large").Inc()
+			}
+			return nil
+		}
+		err := e.expand(virtualPath, contents, depth+1)
+		var invalid invalidArchiveError
+		if errors.As(err, &invalid) {
+			// Keep expanding the enclosing archive.
+			metricArchivesSkipped.WithLabelValues("invalid").Inc()
+			return nil
+		}
+		return err
+	}
+
+	// Heuristic: Assume file is binary if the first 32KB contain a 0x00, like
+	// copySearchable does.
+	if bytes.IndexByte(contents[:minInt(len(contents), 32*1024)], 0x00) >= 0 {
+		return nil
+	}
+	_, err = w.Write(contents)
+	return err
+}
+
+// archivePath returns the virtual path of the file name inside of the archive
+// at archive. Names are cleaned so that they can't refer to files outside of
+// the archive.
+func archivePath(archive, name string) (string, bool) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	if name == "" {
+		return "", false
+	}
+	return archive + archiveSeparator + name, true
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+var metricArchivesSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "searcher_store_archives_skipped_total",
+	Help: "The total number of archives that were not expanded, or only partially, when searching archives.",
+}, []string{"reason"})
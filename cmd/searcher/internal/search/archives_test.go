@@ -0,0 +1,216 @@
+package search
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestCopySearchable_Archives(t *testing.T) {
+	inner := makeZip(t, map[string]string{
+		"deep.txt": "deep content",
+	})
+	jar := makeZip(t, map[string]string{
+		"com/x/Y.java":       "class Y {}",
+		"../../escape.txt":   "escape content",
+		"lib/inner.zip":      string(inner),
+		"META-INF/broken.gz": "not gzip",
+	})
+	sdk := gzipBytes(t, makeTar(t, map[string]string{
+		"sdk/README.md": "sdk readme",
+	}))
+	repo := makeTar(t, map[string]string{
+		"main.go":          "package main",
+		"lib/foo.jar":      string(jar),
+		"vendor/sdk.tgz":   string(sdk),
+		"logs/app.log.gz":  string(gzipBytes(t, []byte("log line"))),
+		"broken/bad.zip":   "not a zip",
+		"docs/archive.txt": "not an archive",
+	})
+
+	copyToZip := func(searchArchives bool) map[string]string {
+		filter := newSearchableFilter(&schema.SiteConfiguration{})
+		filter.CommitIgnore = func(hdr *tar.Header) bool {
+			return false
+		}
+		filter.SearchArchives = searchArchives
+
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		if err := copySearchable(tar.NewReader(bytes.NewReader(repo)), zw, filter); err != nil {
+			t.Fatal(err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return readZip(t, buf.Bytes())
+	}
+
+	want := map[string]string{
+		"main.go":                              "package main",
+		"docs/archive.txt":                     "not an archive",
+		"broken/bad.zip":                       "",
+		"lib/foo.jar":                          "",
+		"lib/foo.jar!/com/x/Y.java":            "class Y {}",
+		"lib/foo.jar!/escape.txt":              "escape content",
+		"lib/foo.jar!/lib/inner.zip":           "",
+		"lib/foo.jar!/lib/inner.zip!/deep.txt": "deep content",
+		"lib/foo.jar!/META-INF/broken.gz":      "",
+		"vendor/sdk.tgz":                       "",
+		"vendor/sdk.tgz!/sdk/README.md":        "sdk readme",
+		"logs/app.log.gz":                      "",
+		"logs/app.log.gz!/app.log":             "log line",
+	}
+	if diff := cmp.Diff(want, copyToZip(true)); diff != "" {
+		t.Errorf("unexpected files with SearchArchives (-want +got):\n%s", diff)
+	}
+
+	// Without SearchArchives, archives are binary files like any other.
+	want = map[string]string{
+		"main.go":          "package main",
+		"docs/archive.txt": "not an archive",
+		"broken/bad.zip":   "not a zip",
+		"lib/foo.jar":      "",
+		"vendor/sdk.tgz":   "",
+		"logs/app.log.gz":  "",
+	}
+	if diff := cmp.Diff(want, copyToZip(false)); diff != "" {
+		t.Errorf("unexpected files without SearchArchives (-want +got):\n%s", diff)
+	}
+}
+
+func TestArchiveExpander_Limits(t *testing.T) {
+	filter := newSearchableFilter(&schema.SiteConfiguration{})
+	filter.CommitIgnore = func(hdr *tar.Header) bool {
+		return false
+	}
+
+	// Nest zips deeper than maxArchiveDepth.
+	archive := makeZip(t, map[string]string{"file.txt": "content"})
+	for i := 0; i < maxArchiveDepth; i++ {
+		archive = makeZip(t, map[string]string{"nested.zip": string(archive)})
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	e := &archiveExpander{zw: zw, filter: filter, remaining: maxArchiveExpandedSize}
+	if err := e.expand("a.zip", archive, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"a.zip!/nested.zip":                         "",
+		"a.zip!/nested.zip!/nested.zip":             "",
+		"a.zip!/nested.zip!/nested.zip!/nested.zip": "",
+	}
+	if diff := cmp.Diff(want, readZip(t, buf.Bytes())); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+
+	// Stop expanding once maxArchiveExpandedSize is exhausted.
+	zw = zip.NewWriter(io.Discard)
+	e = &archiveExpander{zw: zw, filter: filter, remaining: 10}
+	archive = makeZip(t, map[string]string{"a.txt": "12345678", "b.txt": "12345678"})
+	if err := e.expand("a.zip", archive, 1); err != errArchiveExpandedSize {
+		t.Fatalf("expected errArchiveExpandedSize, got %v", err)
+	}
+}
+
+func TestArchiveKindOf(t *testing.T) {
+	for name, want := range map[string]archiveKind{
+		"a.zip":     archiveZip,
+		"a/b.JAR":   archiveZip,
+		"a.tar":     archiveTar,
+		"a.tar.gz":  archiveTarGz,
+		"a.tgz":     archiveTarGz,
+		"a.txt.gz":  archiveGz,
+		"a.go":      archiveNone,
+		"zip":       archiveNone,
+		"a.gzip.md": archiveNone,
+	} {
+		if got := archiveKindOf(name); got != want {
+			t.Errorf("archiveKindOf(%q): want %d, got %d", name, want, got)
+		}
+	}
+}
+
+func makeZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(w, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func makeTar(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.WriteString(tw, content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func readZip(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(b)
+	}
+	return files
+}
@@ -63,6 +63,10 @@ type searchableFilter struct {
 	// SearchLargeFiles is a list of globs for files were we do not respect
 	// fileSizeMax. It comes from the site configuration search.largeFiles.
 	SearchLargeFiles []string
+
+	// SearchArchives expands archives like zip and tar files into the files
+	// they contain. See copyArchive.
+	SearchArchives bool
 }
 
 // Ignore returns true if the file should not appear at all when searched. IE
@@ -121,4 +125,7 @@ func (f *searchableFilter) HashKey(h hash.Hash) {
 		_, _ = h.Write([]byte{0})
 		_, _ = io.WriteString(h, p)
 	}
+	if f.SearchArchives {
+		_, _ = io.WriteString(h, "\x00SearchArchives")
+	}
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/sourcegraph/log"
 	"github.com/sourcegraph/zoekt"
 
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/internal/structural"
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
@@ -155,7 +156,8 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 		attribute.Int("limit", p.Limit),
 		attribute.Bool("patternMatchesContent", p.PatternMatchesContent),
 		attribute.Bool("patternMatchesPath", p.PatternMatchesPath),
-		attribute.String("select", p.Select))
+		attribute.String("select", p.Select),
+		attribute.Bool("searchArchives", p.SearchArchives))
 	defer tr.End()
 	defer func(start time.Time) {
 		code := "200"
@@ -197,29 +199,42 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 			log.Error(err))
 	}(time.Now())
 
-	if p.IsStructuralPat && p.Indexed {
-		// Execute the new structural search path that directly calls Zoekt.
-		// TODO use limit in indexed structural search
-		return structuralSearchWithZoekt(ctx, s.Log, s.Indexed, p, sender)
-	}
-
 	// Compile pattern before fetching from store incase it is bad.
-	var rg *readerGrep
-	if !p.IsStructuralPat {
+	var (
+		rg      *readerGrep
+		matcher *structural.Matcher
+	)
+	if p.IsStructuralPat {
+		matcher, err = structural.NewMatcher(p.Pattern, p.CombyRule)
+		if err != nil {
+			return badRequestError{err.Error()}
+		}
+	} else {
 		rg, err = compile(&p.PatternInfo)
 		if err != nil {
 			return badRequestError{err.Error()}
 		}
 	}
 
+	if p.IsStructuralPat && p.Indexed && p.Rewrite == "" {
+		// Execute the new structural search path that directly calls Zoekt.
+		// Rewrites need the contents of files, so are done unindexed.
+		// TODO use limit in indexed structural search
+		return structuralSearchWithZoekt(ctx, s.Indexed, matcher, p, sender)
+	}
+
 	if p.FetchTimeout == time.Duration(0) {
 		p.FetchTimeout = 500 * time.Millisecond
 	}
 	prepareCtx, cancel := context.WithTimeout(ctx, p.FetchTimeout)
 	defer cancel()
 
+	prepareZip := s.Store.PrepareZip
+	if p.SearchArchives {
+		prepareZip = s.Store.PrepareZipArchives
+	}
 	getZf := func() (string, *zipFile, error) {
-		path, err := s.Store.PrepareZip(prepareCtx, p.Repo, p.Commit)
+		path, err := prepareZip(prepareCtx, p.Repo, p.Commit)
 		if err != nil {
 			return "", nil, err
 		}
@@ -228,8 +243,8 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 	}
 
 	// Hybrid search only works with our normal searcher code path, not
-	// structural search.
-	hybrid := !p.IsStructuralPat
+	// structural search. Zoekt doesn't index the contents of archives.
+	hybrid := !p.IsStructuralPat && !p.SearchArchives
 	if hybrid {
 		logger := logWithTrace(ctx, s.Log).Scoped("hybrid").With(
 			log.String("repo", string(p.Repo)),
@@ -261,7 +276,7 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 		}
 	}
 
-	zipPath, zf, err := getZipFileWithRetry(getZf)
+	_, zf, err := getZipFileWithRetry(getZf)
 	if err != nil {
 		return errors.Wrap(err, "failed to get archive")
 	}
@@ -276,7 +291,7 @@ func (s *Service) search(ctx context.Context, p *protocol.Request, sender matchS
 	metricArchiveSize.Observe(float64(bytes))
 
 	if p.IsStructuralPat {
-		return filteredStructuralSearch(ctx, s.Log, zipPath, zf, &p.PatternInfo, p.Repo, sender)
+		return filteredStructuralSearch(ctx, matcher, zf, &p.PatternInfo, p.Repo, sender)
 	} else {
 		return regexSearch(ctx, rg, zf, p.PatternMatchesContent, p.PatternMatchesPath, p.IsNegated, sender)
 	}
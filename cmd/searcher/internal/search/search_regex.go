@@ -9,6 +9,7 @@ import (
 	"time"
 	"unicode/utf8"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/grafana/regexp"
 	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/atomic"
@@ -59,6 +60,13 @@ type readerGrep struct {
 	// re. It is the output of the longestLiteral function. It is only set if
 	// the regex has an empty LiteralPrefix.
 	literalSubstring []byte
+
+	// trigramLiteral is looked up in the trigram index of a zip to find the
+	// files which may contain a match. Like literalSubstring, it is
+	// guaranteed to appear in any match found by re, but it is lowercased and
+	// set regardless of the LiteralPrefix. It is nil if the index can't be
+	// used. See trigramLiteral.
+	trigramLiteral []byte
 }
 
 // compile returns a readerGrep for matching p.
@@ -66,6 +74,7 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 	var (
 		re               *regexp.Regexp
 		literalSubstring []byte
+		trigramLit       []byte
 	)
 	if p.Pattern != "" {
 		expr := p.Pattern
@@ -108,16 +117,18 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 			return nil, err
 		}
 
+		ast, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			return nil, err
+		}
+		ast = ast.Simplify()
+
 		// Only use literalSubstring optimization if the regex engine doesn't
 		// have a prefix to use.
 		if pre, _ := re.LiteralPrefix(); pre == "" {
-			ast, err := syntax.Parse(expr, syntax.Perl)
-			if err != nil {
-				return nil, err
-			}
-			ast = ast.Simplify()
 			literalSubstring = []byte(longestLiteral(ast))
 		}
+		trigramLit = trigramLiteral(ast)
 	}
 
 	matchPath, err := compilePathPatterns(p.IncludePatterns, p.ExcludePattern, p.PathPatternsAreCaseSensitive)
@@ -130,6 +141,7 @@ func compile(p *protocol.PatternInfo) (*readerGrep, error) {
 		ignoreCase:       !p.IsCaseSensitive,
 		matchPath:        matchPath,
 		literalSubstring: literalSubstring,
+		trigramLiteral:   trigramLit,
 	}, nil
 }
 
@@ -141,6 +153,7 @@ func (rg *readerGrep) Copy() *readerGrep {
 		ignoreCase:       rg.ignoreCase,
 		matchPath:        rg.matchPath,
 		literalSubstring: rg.literalSubstring,
+		trigramLiteral:   rg.trigramLiteral,
 	}
 }
 
@@ -304,10 +317,18 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 		return nil
 	}
 
+	// The trigram index of the zip, if built, rules out the files which can't
+	// contain a match. Negated patterns need to search every file.
+	var candidates *roaring.Bitmap
+	if !isPatternNegated {
+		candidates = zf.trigramCandidates(rg.trigramLiteral)
+	}
+
 	var (
 		lastFileIdx   = atomic.NewInt32(-1)
 		filesSkipped  atomic.Uint32
 		filesSearched atomic.Uint32
+		filesPruned   atomic.Uint32
 	)
 
 	g, ctx := errgroup.WithContext(ctx)
@@ -338,6 +359,14 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 					filesSkipped.Inc()
 					continue
 				}
+				if candidates != nil && !candidates.Contains(uint32(idx)) {
+					// The contents can't match, but the path still might.
+					filesPruned.Inc()
+					if patternMatchesPaths && rg.matchString(f.Name) {
+						sender.Send(protocol.FileMatch{Path: f.Name})
+					}
+					continue
+				}
 				filesSearched.Inc()
 
 				// process
@@ -371,7 +400,10 @@ func regexSearch(ctx context.Context, rg *readerGrep, zf *zipFile, patternMatche
 		"done",
 		attribute.Int("filesSkipped", int(filesSkipped.Load())),
 		attribute.Int("filesSearched", int(filesSearched.Load())),
+		attribute.Int("filesPruned", int(filesPruned.Load())),
+		attribute.Bool("trigramIndex", candidates != nil),
 	)
+	metricTrigramIndexFilesPruned.Add(float64(filesPruned.Load()))
 
 	return err
 }
@@ -1,13 +1,8 @@
 package search
 
 import (
-	"archive/tar"
 	"context"
-	"os"
-	"os/exec"
-	"path/filepath"
 	"reflect"
-	"runtime"
 	"sort"
 	"strings"
 	"testing"
@@ -15,15 +10,51 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sourcegraph/log/logtest"
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/internal/structural"
 	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
-	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 )
 
-func TestMatcherLookupByLanguage(t *testing.T) {
-	maybeSkipComby(t)
+// structuralSearchFiles runs an unindexed structural search for p over a zip
+// of the given files, and returns the file matches.
+func structuralSearchFiles(t *testing.T, input map[string]string, p *protocol.PatternInfo, limit int) []protocol.FileMatch {
+	t.Helper()
 
+	zipData, err := createZip(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := mockZipFile(zipData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	matcher, err := structural.NewMatcher(p.Pattern, p.CombyRule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), limit)
+	defer cancel()
+	if p.Limit == 0 {
+		p.Limit = 30
+	}
+	if err := filteredStructuralSearch(ctx, matcher, zf, p, "repo_foo", sender); err != nil {
+		t.Fatal(err)
+	}
+	return sender.collected
+}
+
+func matchedContents(fileMatches []protocol.FileMatch) []string {
+	var got []string
+	for _, fm := range fileMatches {
+		for _, m := range fm.ChunkMatches {
+			got = append(got, m.MatchedContent()...)
+		}
+	}
+	return got
+}
+
+func TestMatcherLookupByLanguage(t *testing.T) {
 	input := map[string]string{
 		"file_without_extension": `
 /* This foo(plain string) {} is in a Go comment should not match in Go, but should match in plaintext */
@@ -53,50 +84,25 @@ func foo(go string) {}
 		},
 	}
 
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
+	for _, tt := range cases {
+		t.Run(tt.Name, func(t *testing.T) {
+			p := &protocol.PatternInfo{
+				Pattern:         "foo(:[args])",
+				IncludePatterns: []string{"file_without_extension"},
+				Languages:       tt.Languages,
+			}
+
+			got := matchedContents(structuralSearchFiles(t, input, p, 100000000))
+			if !reflect.DeepEqual(got, tt.Want) {
+				t.Fatalf("got file matches %q, want %q", got, tt.Want)
+			}
+		})
 	}
-	zf := tempZipFileOnDisk(t, zipData)
-
-	t.Run("group", func(t *testing.T) {
-		for _, tt := range cases {
-			tt := tt
-			t.Run(tt.Name, func(t *testing.T) {
-				t.Parallel()
-
-				p := &protocol.PatternInfo{
-					Pattern:         "foo(:[args])",
-					IncludePatterns: []string{"file_without_extension"},
-					Languages:       tt.Languages,
-				}
-
-				ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 100000000)
-				defer cancel()
-				err := structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo", sender)
-				if err != nil {
-					t.Fatal(err)
-				}
-				var got []string
-				for _, fileMatches := range sender.collected {
-					for _, m := range fileMatches.ChunkMatches {
-						got = append(got, m.MatchedContent()...)
-					}
-				}
-
-				if !reflect.DeepEqual(got, tt.Want) {
-					t.Fatalf("got file matches %q, want %q", got, tt.Want)
-				}
-			})
-		}
-	})
 }
 
+// Tests that without a language, the grammar of each file is inferred from
+// its name.
 func TestMatcherLookupByExtension(t *testing.T) {
-	maybeSkipComby(t)
-
-	t.Parallel()
-
 	input := map[string]string{
 		"file_without_extension": `
 /* This foo(plain.empty) {} is in a Go comment should not match in Go, but should match in plaintext */
@@ -112,81 +118,39 @@ func foo(go.txt) {}
 `,
 	}
 
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
-	}
-	zf := tempZipFileOnDisk(t, zipData)
-
-	test := func(language, filename string) string {
-		var languages []string
-		if language != "" {
-			languages = []string{language}
-		}
-
-		extensionHint := filepath.Ext(filename)
-		ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-		defer cancel()
-		err := structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), all, extensionHint, "foo(:[args])", "", languages, "repo_foo", sender)
-		if err != nil {
-			return "ERROR: " + err.Error()
-		}
-		var got []string
-		for _, fileMatches := range sender.collected {
-			for _, m := range fileMatches.ChunkMatches {
-				got = append(got, m.MatchedContent()...)
-			}
-		}
-		sort.Strings(got)
-		return strings.Join(got, " ")
-	}
-
 	cases := []struct {
 		name     string
 		want     string
 		language string
-		filename string
 	}{{
-		name:     "No language and no file extension => .generic matcher",
-		want:     "foo(go.empty) foo(go.go) foo(go.txt) foo(plain.empty) foo(plain.go) foo(plain.txt)",
+		name:     "No language => inferred for each file",
+		want:     "foo(go.empty) foo(go.go) foo(go.txt) foo(plain.empty) foo(plain.txt)",
 		language: "",
-		filename: "file_without_extension",
 	}, {
-		name:     "No language and .go file extension => .go matcher",
-		want:     "foo(go.empty) foo(go.go) foo(go.txt)",
-		language: "",
-		filename: "a/b/c/file.go",
-	}, {
-		name:     "Language Go and no file extension => .go matcher",
+		name:     "Language Go => Go for all files",
 		want:     "foo(go.empty) foo(go.go) foo(go.txt)",
 		language: "go",
-		filename: "",
-	}, {
-		name:     "Language .go and .txt file extension => .go matcher",
-		want:     "foo(go.empty) foo(go.go) foo(go.txt)",
-		language: "go",
-		filename: "file.txt",
 	}}
-	t.Run("group", func(t *testing.T) {
-		for _, tc := range cases {
-			tc := tc
-			t.Run(tc.name, func(t *testing.T) {
-				t.Parallel()
-
-				got := test(tc.language, tc.filename)
-				if d := cmp.Diff(tc.want, got); d != "" {
-					t.Errorf("mismatch (-want +got):\n%s", d)
-				}
-			})
-		}
-	})
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var languages []string
+			if tc.language != "" {
+				languages = []string{tc.language}
+			}
+			p := &protocol.PatternInfo{Pattern: "foo(:[args])", Languages: languages}
+
+			got := matchedContents(structuralSearchFiles(t, input, p, 1000000000))
+			sort.Strings(got)
+			if d := cmp.Diff(tc.want, strings.Join(got, " ")); d != "" {
+				t.Errorf("mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
 }
 
 // Tests that structural search correctly infers the Go matcher from the .go
 // file extension.
 func TestInferredMatcher(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 /* This foo(ignore string) {} is in a Go comment should not match */
@@ -194,81 +158,46 @@ func foo(real string) {}
 `,
 	}
 
-	pattern := "foo(:[args])"
-	want := "foo(real string)"
-
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
-	}
-	zPath := tempZipFileOnDisk(t, zipData)
-
-	zFile, _ := mockZipFile(zipData)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	p := &protocol.PatternInfo{
-		Pattern: pattern,
-		Limit:   30,
-	}
-	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-	defer cancel()
-	err = filteredStructuralSearch(ctx, logtest.Scoped(t), zPath, zFile, p, "foo", sender)
-	if err != nil {
-		t.Fatal(err)
-	}
-	got := sender.collected[0].ChunkMatches[0].MatchedContent()[0]
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if got != want {
+	p := &protocol.PatternInfo{Pattern: "foo(:[args])"}
+	got := matchedContents(structuralSearchFiles(t, input, p, 1000000000))
+	if want := []string{"foo(real string)"}; !reflect.DeepEqual(got, want) {
 		t.Fatalf("got file matches %v, want %v", got, want)
 	}
 }
 
 func TestRecordMetrics(t *testing.T) {
 	cases := []struct {
-		name            string
-		language        []string
-		includePatterns []string
-		want            string
+		name   string
+		prefix string
+		lang   *structural.Language
+		want   string
 	}{
 		{
-			name:            "Empty values",
-			language:        nil,
-			includePatterns: []string{},
-			want:            ".generic",
+			name: "No language",
+			want: "native:.generic",
 		},
 		{
-			name:            "Include patterns no extension",
-			language:        nil,
-			includePatterns: []string{"foo", "bar.go"},
-			want:            ".generic",
+			name:   "Inferred no language",
+			prefix: "inferred:",
+			lang:   structural.LanguageForPath("foo"),
+			want:   "native:inferred:.generic",
 		},
 		{
-			name:            "Include patterns first extension",
-			language:        nil,
-			includePatterns: []string{"foo.c", "bar.go"},
-			want:            ".c",
+			name:   "Inferred language",
+			prefix: "inferred:",
+			lang:   structural.LanguageForPath("foo.go"),
+			want:   "native:inferred:go",
 		},
 		{
-			name:            "Non-empty language",
-			language:        []string{"xml"},
-			includePatterns: []string{"foo.c", "bar.go"},
-			want:            ".xml",
+			name: "Language",
+			lang: structural.LanguageByName("go"),
+			want: "native:go",
 		},
 	}
 
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
-			var extensionHint string
-			if len(tt.includePatterns) > 0 {
-				filename := tt.includePatterns[0]
-				extensionHint = filepath.Ext(filename)
-			}
-			got := toMatcher(tt.language, extensionHint)
+			got := nativeMatcherLabel(tt.prefix, tt.lang)
 			if diff := cmp.Diff(tt.want, got); diff != "" {
 				t.Fatal(diff)
 			}
@@ -276,49 +205,30 @@ func TestRecordMetrics(t *testing.T) {
 	}
 }
 
-// Tests that includePatterns works. includePatterns serve a similar role in
-// structural search compared to regex search, but is interpreted _differently_.
-// includePatterns cannot be a regex expression (as in traditional search), but
-// instead (currently) expects a list of patterns that represent a set of file
-// paths to search.
+// Tests that only files matching all include patterns are searched.
 func TestIncludePatterns(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
-		"a/b/c":         "",
-		"a/b/c/foo.go":  "",
-		"c/foo.go":      "",
-		"bar.go":        "",
-		"x/y/z/bar.go":  "",
-		"a/b/c/nope.go": "",
-		"nope.go":       "",
+		"a/b/c/foo.go":  "foo()",
+		"c/foo.go":      "foo()",
+		"bar.go":        "foo()",
+		"x/y/z/bar.go":  "foo()",
+		"a/b/c/nope.go": "foo()",
+		"nope.go":       "foo()",
+		"foo.txt":       "foo()",
 	}
 
 	want := []string{
 		"a/b/c/foo.go",
 		"bar.go",
+		"c/foo.go",
 		"x/y/z/bar.go",
 	}
 
-	includePatterns := []string{"a/b/c/foo.go", "bar.go"}
-
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
-	}
-	zf := tempZipFileOnDisk(t, zipData)
-
 	p := &protocol.PatternInfo{
-		Pattern:         "",
-		IncludePatterns: includePatterns,
+		Pattern:         "foo(:[x])",
+		IncludePatterns: []string{`(foo|bar)\.go$`, `\.go$`},
 	}
-	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-	defer cancel()
-	err = structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "foo", sender)
-	if err != nil {
-		t.Fatal(err)
-	}
-	fileMatches := sender.collected
+	fileMatches := structuralSearchFiles(t, input, p, 1000000000)
 
 	got := make([]string, len(fileMatches))
 	for i, fm := range fileMatches {
@@ -331,31 +241,16 @@ func TestIncludePatterns(t *testing.T) {
 }
 
 func TestRule(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"file.go": "func foo(success) {} func bar(fail) {}",
 	}
 
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
-	}
-	zf := tempZipFileOnDisk(t, zipData)
-
 	p := &protocol.PatternInfo{
 		Pattern:         "func :[[fn]](:[args])",
 		IncludePatterns: []string{".go"},
 		CombyRule:       `where :[args] == "success"`,
 	}
-
-	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-	defer cancel()
-	err = structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo", sender)
-	if err != nil {
-		t.Fatal(err)
-	}
-	got := sender.collected
+	got := structuralSearchFiles(t, input, p, 1000000000)
 
 	want := []protocol.FileMatch{{
 		Path:     "file.go",
@@ -376,8 +271,6 @@ func TestRule(t *testing.T) {
 }
 
 func TestStructuralLimits(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"test1.go": `
 func foo() {
@@ -399,11 +292,6 @@ func bar() {
 `,
 	}
 
-	zipData, err := createZip(input)
-	require.NoError(t, err)
-
-	zf := tempZipFileOnDisk(t, zipData)
-
 	count := func(matches []protocol.FileMatch) int {
 		c := 0
 		for _, match := range matches {
@@ -414,24 +302,15 @@ func bar() {
 
 	test := func(limit, wantCount int, p *protocol.PatternInfo) func(t *testing.T) {
 		return func(t *testing.T) {
-			ctx, cancel, sender := newLimitedStreamCollector(context.Background(), limit)
-			defer cancel()
-			err := structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo", sender)
-			require.NoError(t, err)
-
-			require.Equal(t, wantCount, count(sender.collected))
+			require.Equal(t, wantCount, count(structuralSearchFiles(t, input, p, limit)))
 		}
 	}
 
 	t.Run("unlimited", test(10000, 4, &protocol.PatternInfo{Pattern: "{:[body]}"}))
-	t.Run("exact limit", func(t *testing.T) { t.Skip("disabled because flaky") }) // test(4, 4, &protocol.PatternInfo{Pattern: "{:[body]}"}))
-	t.Run("limited", func(t *testing.T) { t.Skip("disabled because flaky") })     // test(2, 2, &protocol.PatternInfo{Pattern: "{:[body]}"}))
 	t.Run("many", test(12, 8, &protocol.PatternInfo{Pattern: "(:[_])"}))
 }
 
 func TestMatchCountForMultilineMatches(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 func foo() {
@@ -448,33 +327,16 @@ func bar() {
 
 	p := &protocol.PatternInfo{Pattern: "{:[body]}"}
 
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
+	var gotMatchCount int
+	for _, fileMatches := range structuralSearchFiles(t, input, p, 1000000000) {
+		gotMatchCount += fileMatches.MatchCount()
+	}
+	if gotMatchCount != wantMatchCount {
+		t.Fatalf("got match count %d, want %d", gotMatchCount, wantMatchCount)
 	}
-	zf := tempZipFileOnDisk(t, zipData)
-
-	t.Run("Strutural search match count", func(t *testing.T) {
-		ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-		defer cancel()
-		err := structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo", sender)
-		if err != nil {
-			t.Fatal(err)
-		}
-		matches := sender.collected
-		var gotMatchCount int
-		for _, fileMatches := range matches {
-			gotMatchCount += fileMatches.MatchCount()
-		}
-		if gotMatchCount != wantMatchCount {
-			t.Fatalf("got match count %d, want %d", gotMatchCount, wantMatchCount)
-		}
-	})
 }
 
 func TestMultilineMatches(t *testing.T) {
-	maybeSkipComby(t)
-
 	input := map[string]string{
 		"main.go": `
 func foo() {
@@ -489,40 +351,26 @@ func bar() {
 
 	p := &protocol.PatternInfo{Pattern: "{:[body]}"}
 
-	zipData, err := createZip(input)
-	if err != nil {
-		t.Fatal(err)
-	}
-	zf := tempZipFileOnDisk(t, zipData)
-
-	t.Run("Strutural search match count", func(t *testing.T) {
-		ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-		defer cancel()
-		err := structuralSearch(ctx, logtest.Scoped(t), comby.ZipPath(zf), subset(p.IncludePatterns), "", p.Pattern, p.CombyRule, p.Languages, "repo_foo", sender)
-		if err != nil {
-			t.Fatal(err)
-		}
-		matches := sender.collected
-		expected := []protocol.FileMatch{{
-			Path: "main.go",
-			ChunkMatches: []protocol.ChunkMatch{{
-				Content:      "func foo() {\n    fmt.Println(\"foo\")\n}",
-				ContentStart: protocol.Location{Offset: 1, Line: 1},
-				Ranges: []protocol.Range{{
-					Start: protocol.Location{Offset: 12, Line: 1, Column: 11},
-					End:   protocol.Location{Offset: 38, Line: 3, Column: 1},
-				}},
-			}, {
-				Content:      "func bar() {\n    fmt.Println(\"bar\")\n}",
-				ContentStart: protocol.Location{Offset: 40, Line: 5},
-				Ranges: []protocol.Range{{
-					Start: protocol.Location{Offset: 51, Line: 5, Column: 11},
-					End:   protocol.Location{Offset: 77, Line: 7, Column: 1},
-				}},
+	matches := structuralSearchFiles(t, input, p, 1000000000)
+	expected := []protocol.FileMatch{{
+		Path: "main.go",
+		ChunkMatches: []protocol.ChunkMatch{{
+			Content:      "func foo() {\n    fmt.Println(\"foo\")\n}",
+			ContentStart: protocol.Location{Offset: 1, Line: 1},
+			Ranges: []protocol.Range{{
+				Start: protocol.Location{Offset: 12, Line: 1, Column: 11},
+				End:   protocol.Location{Offset: 38, Line: 3, Column: 1},
 			}},
-		}}
-		require.Equal(t, expected, matches)
-	})
+		}, {
+			Content:      "func bar() {\n    fmt.Println(\"bar\")\n}",
+			ContentStart: protocol.Location{Offset: 40, Line: 5},
+			Ranges: []protocol.Range{{
+				Start: protocol.Location{Offset: 51, Line: 5, Column: 11},
+				End:   protocol.Location{Offset: 77, Line: 7, Column: 1},
+			}},
+		}},
+	}}
+	require.Equal(t, expected, matches)
 }
 
 func TestBuildQuery(t *testing.T) {
@@ -666,74 +514,72 @@ func Test_chunkRanges(t *testing.T) {
 	}
 }
 
-func TestTarInput(t *testing.T) {
-	maybeSkipComby(t)
-
+func TestNativeStructuralSearch(t *testing.T) {
 	input := map[string]string{
-		"main.go": `
-func foo() {
-    fmt.Println("foo")
-}
+		"main.go": `package main
 
-func bar() {
-    fmt.Println("bar")
+// fmt.Println(commented)
+func main() {
+	fmt.Println(")")
 }
 `,
+		"README.md": "Call fmt.Println(x) to print x.\n",
+		"other.go":  "package other\n",
 	}
 
-	p := &protocol.PatternInfo{Pattern: "{:[body]}"}
-
-	tarInputEventC := make(chan comby.TarInputEvent, 1)
-	hdr := tar.Header{
-		Name: "main.go",
-		Mode: 0600,
-		Size: int64(len(input["main.go"])),
-	}
-	tarInputEventC <- comby.TarInputEvent{
-		Header:  hdr,
-		Content: []byte(input["main.go"]),
+	p := &protocol.PatternInfo{
+		Pattern: "fmt.Println(:[x])",
+		Limit:   30,
 	}
-	close(tarInputEventC)
 
-	t.Run("Structural search tar input to comby", func(t *testing.T) {
-		ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 1000000000)
-		defer cancel()
-		err := structuralSearch(ctx, logtest.Scoped(t), comby.Tar{TarInputEventC: tarInputEventC}, all, "", p.Pattern, p.CombyRule, p.Languages, "repo_foo", sender)
-		if err != nil {
-			t.Fatal(err)
+	got := map[string][]string{}
+	for _, fm := range structuralSearchFiles(t, input, p, 100) {
+		for _, cm := range fm.ChunkMatches {
+			got[fm.Path] = append(got[fm.Path], cm.MatchedContent()...)
 		}
-		matches := sender.collected
-		expected := []protocol.FileMatch{{
-			Path: "main.go",
-			ChunkMatches: []protocol.ChunkMatch{{
-				Content:      "func foo() {\n    fmt.Println(\"foo\")\n}",
-				ContentStart: protocol.Location{Offset: 1, Line: 1},
-				Ranges: []protocol.Range{{
-					Start: protocol.Location{Offset: 12, Line: 1, Column: 11},
-					End:   protocol.Location{Offset: 38, Line: 3, Column: 1},
-				}},
-			}, {
-				Content:      "func bar() {\n    fmt.Println(\"bar\")\n}",
-				ContentStart: protocol.Location{Offset: 40, Line: 5},
-				Ranges: []protocol.Range{{
-					Start: protocol.Location{Offset: 51, Line: 5, Column: 11},
-					End:   protocol.Location{Offset: 77, Line: 7, Column: 1},
-				}},
-			}},
-		}}
-		require.Equal(t, expected, matches)
-	})
+	}
+	want := map[string][]string{
+		"main.go":   {`fmt.Println(")")`},
+		"README.md": {"fmt.Println(x)"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected matches (-want +got):\n%s", diff)
+	}
 }
 
-func maybeSkipComby(t *testing.T) {
-	t.Helper()
-	if os.Getenv("CI") != "" {
-		return
+func TestNativeStructuralSearch_Rewrite(t *testing.T) {
+	input := map[string]string{
+		"main.go": `package main
+
+func main() {
+	fmt.Println(strings.ToLower(a) == strings.ToLower(b))
+}
+`,
+		"unchanged.go": "package main\n",
 	}
-	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
-		t.Skip("Skipping due to limitations in comby and M1")
+
+	p := &protocol.PatternInfo{
+		Pattern: "strings.ToLower(:[a]) == strings.ToLower(:[b])",
+		Rewrite: "strings.EqualFold(:[a], :[b])",
+		Limit:   30,
 	}
-	if _, err := exec.LookPath("comby"); err != nil {
-		t.Skipf("skipping comby test when not on CI: %v", err)
+	fileMatches := structuralSearchFiles(t, input, p, 100)
+	if len(fileMatches) != 1 {
+		t.Fatalf("expected 1 file match, got %d", len(fileMatches))
+	}
+
+	want := `diff --git main.go main.go
+--- main.go
++++ main.go
+@@ -1,5 +1,5 @@
+ package main
+ 
+ func main() {
+-	fmt.Println(strings.ToLower(a) == strings.ToLower(b))
++	fmt.Println(strings.EqualFold(a, b))
+ }
+`
+	if diff := cmp.Diff(want, fileMatches[0].Diff); diff != "" {
+		t.Fatalf("unexpected diff (-want +got):\n%s", diff)
 	}
 }
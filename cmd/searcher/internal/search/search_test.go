@@ -9,8 +9,6 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
-	"os/exec"
-	"runtime"
 	"sort"
 	"strconv"
 	"strings"
@@ -313,10 +311,6 @@ file contains invalid utf8 � characters
 
 	for i, test := range cases {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
-			if test.arg.IsStructuralPat {
-				maybeSkipComby(t)
-			}
-
 			req := protocol.Request{
 				Repo:         "foo",
 				URL:          "u",
@@ -345,19 +339,6 @@ file contains invalid utf8 � characters
 	}
 }
 
-func maybeSkipComby(t *testing.T) {
-	t.Helper()
-	if os.Getenv("CI") != "" {
-		return
-	}
-	if runtime.GOOS == "darwin" && runtime.GOARCH == "arm64" {
-		t.Skip("Skipping due to limitations in comby and M1")
-	}
-	if _, err := exec.LookPath("comby"); err != nil {
-		t.Skipf("skipping comby test when not on CI: %v", err)
-	}
-}
-
 func TestSearch_badrequest(t *testing.T) {
 	cases := []protocol.Request{
 		// Bad regexp
@@ -466,6 +447,18 @@ func TestSearch_badrequest(t *testing.T) {
 				IsStructuralPat: true,
 			},
 		},
+
+		// structural search with unsupported rule
+		{
+			Repo:   "foo",
+			URL:    "u",
+			Commit: "deadbeefdeadbeefdeadbeefdeadbeefdeadbeef",
+			PatternInfo: protocol.PatternInfo{
+				Pattern:         "fmt.Println(:[x])",
+				CombyRule:       `where match :[x] { | "a" -> true }`,
+				IsStructuralPat: true,
+			},
+		},
 	}
 
 	store := newStore(t, nil)
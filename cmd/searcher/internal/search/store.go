@@ -10,6 +10,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -93,6 +96,15 @@ type Store struct {
 	// ObservationCtx is used to configure observability in diskcache.
 	ObservationCtx *observation.Context
 
+	// TrigramIndex if true builds a trigram index next to each zip in the
+	// cache, which speeds up later searches of the zip. Indexes are built in
+	// the background and evicted together with their zip.
+	TrigramIndex bool
+
+	// TrigramIndexMaxZipSizeBytes if positive is the size above which zips
+	// are not indexed.
+	TrigramIndexMaxZipSizeBytes int64
+
 	// once protects Start
 	once sync.Once
 
@@ -104,6 +116,12 @@ type Store struct {
 
 	// zipCache provides efficient access to repo zip files.
 	zipCache zipCache
+
+	// indexing is the set of zip paths whose trigram index is being built.
+	indexing sync.Map
+
+	// indexLimiter limits concurrent trigram index builds.
+	indexLimiter chan struct{}
 }
 
 // FilterFunc filters tar files based on their header.
@@ -117,9 +135,10 @@ type FilterFunc func(hdr *tar.Header) bool
 func (s *Store) Start() {
 	s.once.Do(func() {
 		s.fetchLimiter = limiter.NewMutable(15)
+		s.indexLimiter = make(chan struct{}, 2)
 		s.cache = diskcache.NewStore(s.Path, "store",
 			diskcache.WithBackgroundTimeout(s.BackgroundTimeout),
-			diskcache.WithBeforeEvict(s.zipCache.delete),
+			diskcache.WithBeforeEvict(s.beforeEvict),
 			diskcache.WithobservationCtx(s.ObservationCtx),
 		)
 		_ = os.MkdirAll(s.Path, 0o700)
@@ -145,6 +164,17 @@ func (s *Store) PrepareZip(ctx context.Context, repo api.RepoName, commit api.Co
 }
 
 func (s *Store) PrepareZipPaths(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string) (path string, err error) {
+	return s.prepareZip(ctx, repo, commit, paths, false)
+}
+
+// PrepareZipArchives is like PrepareZip, but the zip archive also contains
+// the files inside of the archives in the repository, at paths like
+// "lib/foo.jar!/com/x/Y.java".
+func (s *Store) PrepareZipArchives(ctx context.Context, repo api.RepoName, commit api.CommitID) (path string, err error) {
+	return s.prepareZip(ctx, repo, commit, nil, true)
+}
+
+func (s *Store) prepareZip(ctx context.Context, repo api.RepoName, commit api.CommitID, paths []string, searchArchives bool) (path string, err error) {
 	tr, ctx := trace.New(ctx, "ArchiveStore.PrepareZipPaths")
 	defer tr.EndWithErr(&err)
 
@@ -169,6 +199,7 @@ func (s *Store) PrepareZipPaths(ctx context.Context, repo api.RepoName, commit a
 	}
 
 	filter := newSearchableFilter(&conf.Get().SiteConfiguration)
+	filter.SearchArchives = searchArchives
 
 	// key is a sha256 hash since we want to use it for the disk name
 	h := sha256.New()
@@ -209,6 +240,8 @@ func (s *Store) PrepareZipPaths(ctx context.Context, repo api.RepoName, commit a
 		}
 		if err != nil {
 			s.Log.Error("failed to fetch archive", log.String("repo", string(repo)), log.String("commit", string(commit)), log.Duration("duration", time.Since(start)), log.Error(err))
+		} else {
+			s.indexZip(path)
 		}
 		resC <- result{path, err, cacheHit}
 	}()
@@ -341,6 +374,15 @@ func copySearchable(tr *tar.Reader, zw *zip.Writer, filter *searchableFilter) er
 				continue
 			}
 
+			// Archives are expanded into the files they contain if
+			// requested.
+			if filter.SearchArchives && archiveKindOf(hdr.Name) != archiveNone {
+				if err := copyArchive(tr, zw, filter, hdr); err != nil {
+					return err
+				}
+				continue
+			}
+
 			// We are happy with the file, so we can write it to zw.
 			w, err := zw.CreateHeader(&zip.FileHeader{
 				Name:   hdr.Name,
@@ -411,6 +453,82 @@ func copySearchable(tr *tar.Reader, zw *zip.Writer, filter *searchableFilter) er
 	}
 }
 
+// indexZip builds the trigram index of the zip at path in the background,
+// unless it already exists or is being built.
+func (s *Store) indexZip(path string) {
+	if !s.TrigramIndex {
+		return
+	}
+	if _, err := os.Stat(path + trigramIndexSuffix); err == nil {
+		return
+	}
+	if s.TrigramIndexMaxZipSizeBytes > 0 {
+		fi, err := os.Stat(path)
+		if err != nil || fi.Size() > s.TrigramIndexMaxZipSizeBytes {
+			return
+		}
+	}
+	if _, building := s.indexing.LoadOrStore(path, struct{}{}); building {
+		return
+	}
+
+	go func() {
+		defer s.indexing.Delete(path)
+
+		s.indexLimiter <- struct{}{}
+		defer func() { <-s.indexLimiter }()
+
+		start := time.Now()
+		err := s.buildIndex(path)
+		metricTrigramIndexBuild.WithLabelValues(strconv.FormatBool(err == nil)).Observe(time.Since(start).Seconds())
+		if err != nil {
+			s.Log.Warn("failed to build trigram index", log.String("path", path), log.Error(err))
+			return
+		}
+		s.zipCache.setTrigramIndex(path)
+	}()
+}
+
+func (s *Store) buildIndex(path string) error {
+	zf, err := s.zipCache.Get(path)
+	if err != nil {
+		return err
+	}
+	defer zf.Close()
+	return writeTrigramIndex(path+trigramIndexSuffix, zf)
+}
+
+// beforeEvict is called by the cache before it deletes the zip at path. It
+// returns the size of the trigram index it removed, if any.
+func (s *Store) beforeEvict(path string, trace observation.TraceLogger) int64 {
+	s.zipCache.delete(path, trace)
+	fi, err := os.Stat(path + trigramIndexSuffix)
+	if err != nil {
+		return 0
+	}
+	if err := os.Remove(path + trigramIndexSuffix); err != nil {
+		s.Log.Warn("failed to remove trigram index", log.String("path", path), log.Error(err))
+		return 0
+	}
+	return fi.Size()
+}
+
+// removeOrphanedIndexes removes the trigram indexes whose zip has been
+// evicted. They are left behind if a zip is evicted while its index is
+// being built.
+func (s *Store) removeOrphanedIndexes() {
+	paths, err := filepath.Glob(filepath.Join(s.Path, "*"+trigramIndexSuffix))
+	if err != nil {
+		return
+	}
+	for _, p := range paths {
+		zipPath := strings.TrimSuffix(p, trigramIndexSuffix)
+		if _, err := os.Stat(zipPath); os.IsNotExist(err) {
+			_ = os.Remove(p)
+		}
+	}
+}
+
 func (s *Store) String() string {
 	return "Store(" + s.Path + ")"
 }
@@ -434,6 +552,9 @@ func (s *Store) watchAndEvict() {
 		}
 		metricCacheSizeBytes.Set(float64(stats.CacheSize))
 		metricEvictions.Add(float64(stats.Evicted))
+		if stats.Evicted > 0 {
+			s.removeOrphanedIndexes()
+		}
 	}
 }
 
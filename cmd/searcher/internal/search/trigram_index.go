@@ -0,0 +1,567 @@
+package search
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp/syntax" //nolint:depguard // using the grafana fork of regexp clashes with zoekt, which uses the std regexp/syntax.
+	"sort"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// trigramIndexSuffix is appended to the path of a zip in the store to get the
+// path of its trigram index. The index is evicted together with the zip.
+const trigramIndexSuffix = ".trgm"
+
+// trigramIndexMagic identifies a trigram index file and its version.
+const trigramIndexMagic = "SRCHTRG1"
+
+// trigramIndexHeaderSize is the size of the magic followed by the number of
+// files and the number of trigrams.
+const trigramIndexHeaderSize = len(trigramIndexMagic) + 4 + 4
+
+// trigramIndex maps trigrams to the files of a zip which contain them. It
+// lets regexSearch skip the files which can't contain a match, which is most
+// of them for selective patterns.
+//
+// Contents are lowercased (ASCII only, like for case-insensitive searches)
+// before extracting trigrams, so the same index serves case-sensitive and
+// case-insensitive searches.
+//
+// The index file is laid out as:
+//
+//	magic       [8]byte
+//	numFiles    uint32
+//	numTrigrams uint32
+//	table       [numTrigrams]struct{ trigram, offset uint32 }, sorted by trigram
+//	postings    for each trigram, the uvarint deltas of the indexes into
+//	            zipFile.Files of the files containing it, starting at offset
+//
+// All integers are little endian.
+type trigramIndex struct {
+	numFiles int
+	table    []byte
+	postings []byte
+
+	// data is the mmap'd index file, and f the open file.
+	data []byte
+	f    *os.File
+}
+
+// trigramIndexRunSize is the number of postings a trigram index build
+// collects in memory before sorting them and spilling them to a temporary
+// file. It bounds the memory used by a build, whatever the size of the zip.
+var trigramIndexRunSize = 4 << 20
+
+// trigramIndexMaxRuns is the number of spilled runs at which they are merged
+// into a single run, which bounds the number of files open during a build.
+const trigramIndexMaxRuns = 64
+
+// writeTrigramIndex writes the trigram index of zf to path. The file is
+// written atomically so readers never observe a partial index.
+//
+// Postings are built like an external merge sort: they are collected in
+// sorted runs which are spilled to disk, and the runs are merged into the
+// index.
+func writeTrigramIndex(path string, zf *zipFile) error {
+	tmpDir, err := os.MkdirTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	b := &trigramIndexBuilder{dir: tmpDir}
+	defer b.close()
+	for i := range zf.Files {
+		if err := b.addFile(uint32(i), zf.DataFor(&zf.Files[i])); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.Create(filepath.Join(tmpDir, "index"))
+	if err != nil {
+		return err
+	}
+	if err := b.write(f, len(zf.Files)); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// trigramIndexBuilder collects the postings of a trigram index. A posting is
+// encoded as the trigram in the upper and the file index in the lower 32
+// bits, so that sorting postings groups them by trigram.
+type trigramIndexBuilder struct {
+	// dir is where runs are spilled to.
+	dir string
+	// run holds the postings which have not been spilled yet.
+	run []uint64
+	// runs are the spilled runs, each sorted and without duplicates.
+	runs []*os.File
+}
+
+func (b *trigramIndexBuilder) addFile(fileIdx uint32, data []byte) error {
+	start := len(b.run)
+	for j := 0; j+3 <= len(data); j++ {
+		b.run = append(b.run, uint64(trigramAt(data[j:]))<<32|uint64(fileIdx))
+		if len(b.run) >= trigramIndexRunSize {
+			if err := b.spill(); err != nil {
+				return err
+			}
+			start = 0
+		}
+	}
+	// Most trigrams repeat within a file, so drop them right away.
+	b.run = b.run[:start+len(sortUnique(b.run[start:]))]
+	return nil
+}
+
+// spill writes the postings in memory to a new run.
+func (b *trigramIndexBuilder) spill() error {
+	f, err := os.CreateTemp(b.dir, "run-*")
+	if err != nil {
+		return err
+	}
+	b.runs = append(b.runs, f)
+
+	w := bufio.NewWriter(f)
+	var buf [8]byte
+	for _, p := range sortUnique(b.run) {
+		binary.LittleEndian.PutUint64(buf[:], p)
+		if _, err := w.Write(buf[:]); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	b.run = b.run[:0]
+
+	if len(b.runs) >= trigramIndexMaxRuns {
+		return b.compact()
+	}
+	return nil
+}
+
+// compact merges the spilled runs into a single run.
+func (b *trigramIndexBuilder) compact() error {
+	f, err := os.CreateTemp(b.dir, "run-*")
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(f)
+	var buf [8]byte
+	err = mergePostings(b.runReaders(), func(p uint64) error {
+		binary.LittleEndian.PutUint64(buf[:], p)
+		_, err := w.Write(buf[:])
+		return err
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	for _, run := range b.runs {
+		run.Close()
+		os.Remove(run.Name())
+	}
+	b.runs = append(b.runs[:0], f)
+	return nil
+}
+
+func (b *trigramIndexBuilder) runReaders() []postingSource {
+	sources := make([]postingSource, 0, len(b.runs)+1)
+	for _, f := range b.runs {
+		sources = append(sources, &runReader{r: bufio.NewReader(f)})
+	}
+	return sources
+}
+
+// write merges the runs and writes the index of a zip with numFiles files to
+// w. Postings are first written to a temporary file, since the table in
+// front of them is only known once all of them have been merged.
+func (b *trigramIndexBuilder) write(w io.Writer, numFiles int) error {
+	postingsFile, err := os.CreateTemp(b.dir, "postings-*")
+	if err != nil {
+		return err
+	}
+	defer postingsFile.Close()
+
+	sources := append(b.runReaders(), &sliceReader{postings: sortUnique(b.run)})
+
+	var (
+		table    []byte
+		offset   uint64
+		last     uint64
+		prevFile uint32
+		buf      [binary.MaxVarintLen32]byte
+	)
+	pw := bufio.NewWriter(postingsFile)
+	err = mergePostings(sources, func(p uint64) error {
+		t, fileIdx := uint32(p>>32), uint32(p)
+		if len(table) == 0 || uint32(last>>32) != t {
+			if offset > math.MaxUint32 {
+				return errors.New("trigram index is too large")
+			}
+			table = binary.LittleEndian.AppendUint32(table, t)
+			table = binary.LittleEndian.AppendUint32(table, uint32(offset))
+			prevFile = 0
+		}
+		last = p
+		n := binary.PutUvarint(buf[:], uint64(fileIdx-prevFile))
+		prevFile = fileIdx
+		offset += uint64(n)
+		_, err := pw.Write(buf[:n])
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if offset > math.MaxUint32 {
+		return errors.New("trigram index is too large")
+	}
+	if err := pw.Flush(); err != nil {
+		return err
+	}
+
+	header := make([]byte, trigramIndexHeaderSize)
+	copy(header, trigramIndexMagic)
+	binary.LittleEndian.PutUint32(header[len(trigramIndexMagic):], uint32(numFiles))
+	binary.LittleEndian.PutUint32(header[len(trigramIndexMagic)+4:], uint32(len(table)/8))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(table); err != nil {
+		return err
+	}
+	if _, err := postingsFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err = io.Copy(w, postingsFile)
+	return err
+}
+
+func (b *trigramIndexBuilder) close() {
+	for _, f := range b.runs {
+		f.Close()
+	}
+}
+
+// postingSource returns sorted postings. ok is false once it is exhausted.
+type postingSource interface {
+	next() (p uint64, ok bool, err error)
+}
+
+type runReader struct {
+	r   *bufio.Reader
+	buf [8]byte
+}
+
+func (r *runReader) next() (uint64, bool, error) {
+	if _, err := io.ReadFull(r.r, r.buf[:]); err != nil {
+		if err == io.EOF {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+	return binary.LittleEndian.Uint64(r.buf[:]), true, nil
+}
+
+type sliceReader struct {
+	postings []uint64
+}
+
+func (r *sliceReader) next() (uint64, bool, error) {
+	if len(r.postings) == 0 {
+		return 0, false, nil
+	}
+	p := r.postings[0]
+	r.postings = r.postings[1:]
+	return p, true, nil
+}
+
+// mergePostings calls fn for each distinct posting of sources, in order.
+func mergePostings(sources []postingSource, fn func(uint64) error) error {
+	h := make(postingHeap, 0, len(sources))
+	for _, src := range sources {
+		p, ok, err := src.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h = append(h, postingHeapItem{posting: p, src: src})
+		}
+	}
+	heap.Init(&h)
+
+	var last uint64
+	first := true
+	for len(h) > 0 {
+		item := &h[0]
+		if first || item.posting != last {
+			if err := fn(item.posting); err != nil {
+				return err
+			}
+			last, first = item.posting, false
+		}
+
+		p, ok, err := item.src.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			item.posting = p
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return nil
+}
+
+type postingHeapItem struct {
+	posting uint64
+	src     postingSource
+}
+
+type postingHeap []postingHeapItem
+
+func (h postingHeap) Len() int           { return len(h) }
+func (h postingHeap) Less(i, j int) bool { return h[i].posting < h[j].posting }
+func (h postingHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *postingHeap) Push(x any)        { *h = append(*h, x.(postingHeapItem)) }
+func (h *postingHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// sortUnique sorts postings in place and returns them without duplicates.
+func sortUnique(postings []uint64) []uint64 {
+	if len(postings) == 0 {
+		return postings
+	}
+	sort.Slice(postings, func(i, j int) bool { return postings[i] < postings[j] })
+	n := 1
+	for _, p := range postings[1:] {
+		if p != postings[n-1] {
+			postings[n] = p
+			n++
+		}
+	}
+	return postings[:n]
+}
+
+// openTrigramIndex opens the trigram index at path of a zip with numFiles
+// files. It must be closed once no longer used.
+func openTrigramIndex(path string, numFiles int) (*trigramIndex, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() < int64(trigramIndexHeaderSize) {
+		f.Close()
+		return nil, errors.Errorf("trigram index %s is truncated", path)
+	}
+	data, err := mmap(path, f, fi)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	idx := &trigramIndex{data: data, f: f}
+	if err := idx.parse(numFiles); err != nil {
+		idx.close()
+		return nil, errors.Wrapf(err, "invalid trigram index %s", path)
+	}
+	return idx, nil
+}
+
+func (idx *trigramIndex) parse(numFiles int) error {
+	data := idx.data
+	if string(data[:len(trigramIndexMagic)]) != trigramIndexMagic {
+		return errors.New("bad magic")
+	}
+	idx.numFiles = int(binary.LittleEndian.Uint32(data[len(trigramIndexMagic):]))
+	if idx.numFiles != numFiles {
+		return errors.Errorf("index has %d files, zip has %d", idx.numFiles, numFiles)
+	}
+	numTrigrams := int64(binary.LittleEndian.Uint32(data[len(trigramIndexMagic)+4:]))
+	end := int64(trigramIndexHeaderSize) + 8*numTrigrams
+	if end > int64(len(data)) {
+		return errors.New("table is truncated")
+	}
+	idx.table = data[trigramIndexHeaderSize:end]
+	idx.postings = data[end:]
+
+	// Validate the table once, so that lookups can trust it.
+	var prevTrigram, prevOffset uint32
+	for i := 0; i < len(idx.table); i += 8 {
+		t := binary.LittleEndian.Uint32(idx.table[i:])
+		off := binary.LittleEndian.Uint32(idx.table[i+4:])
+		if i > 0 && t <= prevTrigram {
+			return errors.New("table is not sorted")
+		}
+		if off < prevOffset || int64(off) > int64(len(idx.postings)) {
+			return errors.New("table has invalid offsets")
+		}
+		prevTrigram, prevOffset = t, off
+	}
+	return nil
+}
+
+func (idx *trigramIndex) close() {
+	// Like zipCache.delete, only log errors here.
+	if err := unmap(idx.data); err != nil {
+		log.Printf("failed to munmap %q: %v", idx.f.Name(), err)
+	}
+	if err := idx.f.Close(); err != nil {
+		log.Printf("failed to close %q: %v", idx.f.Name(), err)
+	}
+}
+
+// candidates returns the set of indexes into zipFile.Files of the files which
+// may contain literal. literal must be lowercased like the indexed contents.
+// It returns nil if the index can't narrow down the files, ie if literal is
+// shorter than a trigram.
+func (idx *trigramIndex) candidates(literal []byte) *roaring.Bitmap {
+	if len(literal) < 3 {
+		return nil
+	}
+
+	var result *roaring.Bitmap
+	for j := 0; j+3 <= len(literal); j++ {
+		p, ok := idx.lookup(trigramAt(literal[j:]))
+		if !ok {
+			return roaring.New()
+		}
+		files := idx.decode(p)
+		if result == nil {
+			result = files
+		} else {
+			result.And(files)
+		}
+		if result.IsEmpty() {
+			break
+		}
+	}
+	return result
+}
+
+// lookup returns the encoded postings of trigram t.
+func (idx *trigramIndex) lookup(t uint32) ([]byte, bool) {
+	n := len(idx.table) / 8
+	i := sort.Search(n, func(i int) bool {
+		return binary.LittleEndian.Uint32(idx.table[8*i:]) >= t
+	})
+	if i == n || binary.LittleEndian.Uint32(idx.table[8*i:]) != t {
+		return nil, false
+	}
+	start := binary.LittleEndian.Uint32(idx.table[8*i+4:])
+	end := uint32(len(idx.postings))
+	if i+1 < n {
+		end = binary.LittleEndian.Uint32(idx.table[8*(i+1)+4:])
+	}
+	return idx.postings[start:end], true
+}
+
+// decode returns the files in the encoded postings p.
+func (idx *trigramIndex) decode(p []byte) *roaring.Bitmap {
+	files := roaring.New()
+	fileIdx := uint64(0)
+	for len(p) > 0 {
+		delta, n := binary.Uvarint(p)
+		if n <= 0 {
+			break
+		}
+		p = p[n:]
+		fileIdx += delta
+		files.Add(uint32(fileIdx))
+	}
+	return files
+}
+
+// trigramAt returns the lowercased trigram at the start of b.
+func trigramAt(b []byte) uint32 {
+	return uint32(lowerASCII(b[0]))<<16 | uint32(lowerASCII(b[1]))<<8 | uint32(lowerASCII(b[2]))
+}
+
+func lowerASCII(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
+
+// trigramLiteral returns the literal to look up in a trigram index for the
+// parsed regular expression re, or nil if re can't use the index. The
+// literal is guaranteed to appear in every match of re.
+func trigramLiteral(re *syntax.Regexp) []byte {
+	// Case folding matches non-ASCII runes (eg the Kelvin sign for "k")
+	// which aren't lowercased in the index.
+	if hasFoldCase(re) {
+		return nil
+	}
+	literal := []byte(longestLiteral(re))
+	if len(literal) < 3 {
+		return nil
+	}
+	for i, c := range literal {
+		literal[i] = lowerASCII(c)
+	}
+	return literal
+}
+
+func hasFoldCase(re *syntax.Regexp) bool {
+	if re.Op == syntax.OpLiteral && re.Flags&syntax.FoldCase != 0 {
+		return true
+	}
+	for _, sub := range re.Sub {
+		if hasFoldCase(sub) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	metricTrigramIndexBuild = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "searcher_store_trigram_index_build_duration_seconds",
+		Help:    "Observes the duration to build the trigram index of a zip file.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"success"})
+	metricTrigramIndexFilesPruned = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "searcher_trigram_index_files_pruned_total",
+		Help: "The total number of files not searched because the trigram index ruled out a match.",
+	})
+)
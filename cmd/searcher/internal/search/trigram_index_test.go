@@ -0,0 +1,348 @@
+package search
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp/syntax" //nolint:depguard // using the grafana fork of regexp clashes with zoekt, which uses the std regexp/syntax.
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+var trigramTestFiles = map[string]string{
+	"README.md":      "# Hello World\n\nSee main.go.\n",
+	"main.go":        "package main\n\nfunc main() {\n\tprintln(\"hello world\")\n}\n",
+	"lib/parse.go":   "package lib\n\n// ParseConfig parses the Kubernetes configuration.\nfunc ParseConfig() {}\n",
+	"lib/empty.go":   "",
+	"lib/short.txt":  "ab",
+	"docs/utf8.md":   "Grüße aus Köln\n",
+	"hello/world.sh": "#!/bin/sh\nexit 0\n",
+}
+
+func newIndexedZipFile(t *testing.T, files map[string]string) *zipFile {
+	t.Helper()
+	data, err := createZip(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := mockZipFile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "repo.zip"+trigramIndexSuffix)
+	if err := writeTrigramIndex(path, zf); err != nil {
+		t.Fatal(err)
+	}
+	idx, err := openTrigramIndex(path, len(zf.Files))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(idx.close)
+	zf.index.Store(idx)
+	return zf
+}
+
+func TestTrigramIndex_Candidates(t *testing.T) {
+	zf := newIndexedZipFile(t, trigramTestFiles)
+
+	for literal, want := range map[string][]string{
+		"hello world":  {"README.md", "main.go"},
+		"parseconfig":  {"lib/parse.go"},
+		"package":      {"lib/parse.go", "main.go"},
+		"kubernetes":   {"lib/parse.go"},
+		"grüße":        {"docs/utf8.md"},
+		"not anywhere": {},
+		"xyz":          {},
+		"ab":           nil, // too short to use the index
+	} {
+		candidates := zf.trigramCandidates([]byte(literal))
+		if want == nil {
+			if candidates != nil {
+				t.Errorf("%q: expected no candidates, got %v", literal, candidates)
+			}
+			continue
+		}
+		got := []string{}
+		for _, i := range candidates.ToArray() {
+			got = append(got, zf.Files[i].Name)
+		}
+		sort.Strings(got)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%q: unexpected candidates (-want +got):\n%s", literal, diff)
+		}
+	}
+
+	// Without an index every file is a candidate.
+	zf.index.Store(nil)
+	if candidates := zf.trigramCandidates([]byte("hello world")); candidates != nil {
+		t.Errorf("expected no candidates without an index, got %v", candidates)
+	}
+}
+
+func TestTrigramIndex_Invalid(t *testing.T) {
+	data, err := createZip(trigramTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := mockZipFile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "repo.zip"+trigramIndexSuffix)
+	if err := writeTrigramIndex(path, zf); err != nil {
+		t.Fatal(err)
+	}
+
+	// The index is for a different zip.
+	if _, err := openTrigramIndex(path, len(zf.Files)+1); err == nil {
+		t.Error("expected error for mismatched number of files")
+	}
+
+	// The index is truncated or garbage.
+	encoded, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range map[string][]byte{
+		"truncated header": encoded[:4],
+		"truncated table":  encoded[:trigramIndexHeaderSize+4],
+		"bad magic":        append([]byte("NOTANIDX"), encoded[8:]...),
+	} {
+		p := filepath.Join(dir, "bad"+trigramIndexSuffix)
+		if err := os.WriteFile(p, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := openTrigramIndex(p, len(zf.Files)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	// No temporary files are left behind.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if e.Name() != "repo.zip"+trigramIndexSuffix && e.Name() != "bad"+trigramIndexSuffix {
+			t.Errorf("unexpected file %s", e.Name())
+		}
+	}
+}
+
+// TestTrigramIndex_Runs checks that spilling postings to runs while building
+// an index doesn't change it.
+func TestTrigramIndex_Runs(t *testing.T) {
+	data, err := createZip(trigramTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zf, err := mockZipFile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	build := func(runSize int) []byte {
+		old := trigramIndexRunSize
+		trigramIndexRunSize = runSize
+		defer func() { trigramIndexRunSize = old }()
+
+		path := filepath.Join(t.TempDir(), "repo.zip"+trigramIndexSuffix)
+		if err := writeTrigramIndex(path, zf); err != nil {
+			t.Fatal(err)
+		}
+		encoded, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return encoded
+	}
+
+	want := build(1 << 20)
+	for _, runSize := range []int{1, 3, 16} {
+		if got := build(runSize); !bytes.Equal(want, got) {
+			t.Errorf("run size %d: index differs from the one built in memory", runSize)
+		}
+	}
+}
+
+// TestRegexSearch_TrigramIndex checks that searches return the same results
+// with and without a trigram index.
+func TestRegexSearch_TrigramIndex(t *testing.T) {
+	data, err := createZip(trigramTestFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unindexed, err := mockZipFile(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexed := newIndexedZipFile(t, trigramTestFiles)
+
+	cases := []struct {
+		pattern protocol.PatternInfo
+		negated bool
+	}{
+		{pattern: protocol.PatternInfo{Pattern: "hello world"}},
+		{pattern: protocol.PatternInfo{Pattern: "Hello World", IsCaseSensitive: true}},
+		{pattern: protocol.PatternInfo{Pattern: "hello world", IsCaseSensitive: true}},
+		{pattern: protocol.PatternInfo{Pattern: "ParseConfig", IsWordMatch: true}},
+		{pattern: protocol.PatternInfo{Pattern: `func \w+\(\)`, IsRegExp: true}},
+		{pattern: protocol.PatternInfo{Pattern: `(?i)KUBERNETES`, IsRegExp: true, IsCaseSensitive: true}},
+		{pattern: protocol.PatternInfo{Pattern: "Köln", IsCaseSensitive: true}},
+		{pattern: protocol.PatternInfo{Pattern: "world", PatternMatchesPath: true}},
+		{pattern: protocol.PatternInfo{Pattern: "package"}, negated: true},
+		{pattern: protocol.PatternInfo{Pattern: "nowhere to be found"}},
+	}
+	for _, tc := range cases {
+		p := tc.pattern
+		rg, err := compile(&p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		search := func(zf *zipFile) []protocol.FileMatch {
+			matches, _, err := regexSearchBatch(context.Background(), rg, zf, 100, true, p.PatternMatchesPath, tc.negated)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Slice(matches, func(i, j int) bool { return matches[i].Path < matches[j].Path })
+			return matches
+		}
+		want := search(unindexed)
+		got := search(indexed)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%s: unexpected matches with index (-want +got):\n%s", p.String(), diff)
+		}
+	}
+}
+
+func TestTrigramLiteral(t *testing.T) {
+	for expr, want := range map[string]string{
+		"HelloWorld":      "helloworld",
+		`foo\s+barbaz`:    "barbaz",
+		`(?i)hello`:       "",
+		`(?i:abc)defghij`: "",
+		"ab":              "",
+		"a|bcdef":         "",
+		`x(abcd)+y`:       "abcd",
+		"Grüße":           "grüße",
+	} {
+		ast, err := syntax.Parse(expr, syntax.Perl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(trigramLiteral(ast.Simplify())); got != want {
+			t.Errorf("trigramLiteral(%q): want %q, got %q", expr, want, got)
+		}
+	}
+}
+
+func TestStore_TrigramIndex(t *testing.T) {
+	s := tmpStore(t)
+	s.TrigramIndex = true
+	s.FetchTar = func(ctx context.Context, repo api.RepoName, commit api.CommitID) (io.ReadCloser, error) {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		body := "hello world"
+		if err := tw.WriteHeader(&tar.Header{Name: "main.go", Mode: 0o600, Size: int64(len(body))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			return nil, err
+		}
+		if err := tw.Close(); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(&buf), nil
+	}
+
+	path, err := s.PrepareZip(context.Background(), "somerepo", "0123456789012345678901234567890123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The index is built in the background.
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if _, err := os.Stat(path + trigramIndexSuffix); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("trigram index was not built")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// Wait for the build to finish attaching the index.
+	for {
+		if _, building := s.indexing.Load(path); !building {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	zf, err := s.zipCache.Get(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidates := zf.trigramCandidates([]byte("hello"))
+	zf.Close()
+	if candidates == nil || candidates.GetCardinality() != 1 {
+		t.Fatalf("expected 1 candidate, got %v", candidates)
+	}
+
+	// The index is evicted with its zip.
+	if _, err := s.cache.Evict(0); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{path, path + trigramIndexSuffix} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed, got %v", p, err)
+		}
+	}
+}
+
+func TestStore_TrigramIndexMaxZipSize(t *testing.T) {
+	s := tmpStore(t)
+	s.TrigramIndex = true
+	s.TrigramIndexMaxZipSizeBytes = 1
+	s.FetchTar = func(ctx context.Context, repo api.RepoName, commit api.CommitID) (io.ReadCloser, error) {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		body := "hello world"
+		if err := tw.WriteHeader(&tar.Header{Name: "main.go", Mode: 0o600, Size: int64(len(body))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write([]byte(body)); err != nil {
+			return nil, err
+		}
+		if err := tw.Close(); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(&buf), nil
+	}
+
+	path, err := s.PrepareZip(context.Background(), "somerepo", "0123456789012345678901234567890123456789")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The zip is too large to be indexed, so no build is started.
+	if _, building := s.indexing.Load(path); building {
+		t.Fatal("expected no trigram index build")
+	}
+	if _, err := os.Stat(path + trigramIndexSuffix); !os.IsNotExist(err) {
+		t.Fatalf("expected no trigram index, got %v", err)
+	}
+}
@@ -9,6 +9,9 @@ import (
 	"os"
 	"sort"
 	"sync"
+	"sync/atomic"
+
+	"github.com/RoaringBitmap/roaring"
 
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -60,6 +63,7 @@ func (c *zipCache) Get(path string) (*zipFile, error) {
 	if err != nil {
 		return nil, err
 	}
+	zf.loadTrigramIndex(path)
 	shard.m[path] = zf
 	zf.wg.Add(1)
 	return zf, nil
@@ -88,9 +92,29 @@ func (c *zipCache) delete(path string, trace observation.TraceLogger) {
 			log.Printf("failed to close %q: %v", zf.f.Name(), err)
 		}
 	}
+	if idx := zf.index.Load(); idx != nil {
+		idx.close()
+	}
 	delete(shard.m, path)
 }
 
+// setTrigramIndex makes the trigram index written for the zip at path
+// available to searches, if the zip is in use.
+//
+// Note: This MUST NOT be called while holding a zipFile for path, since
+// delete waits for those while holding the shard lock.
+func (c *zipCache) setTrigramIndex(path string) {
+	shard := c.shardFor(path)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	zf, ok := shard.m[path]
+	if !ok {
+		// Get will load the index if the zip is used again.
+		return
+	}
+	zf.loadTrigramIndex(path)
+}
+
 // zipFile provides efficient access to a single zip file.
 type zipFile struct {
 	// Take care with the size of this struct.
@@ -100,6 +124,10 @@ type zipFile struct {
 	Data   []byte
 	f      *os.File
 	wg     sync.WaitGroup // ensures underlying file is not munmap'd or closed while in use
+
+	// index is the trigram index of the zip, or nil if it has not been built
+	// (yet).
+	index atomic.Pointer[trigramIndex]
 }
 
 func readZipFile(path string) (*zipFile, error) {
@@ -131,6 +159,34 @@ func readZipFile(path string) (*zipFile, error) {
 	return zf, nil
 }
 
+// loadTrigramIndex loads the trigram index of the zip file at path, if it
+// exists and f has none yet. Errors are not fatal, we search without the
+// index instead.
+func (f *zipFile) loadTrigramIndex(path string) {
+	if f.index.Load() != nil {
+		return
+	}
+	idx, err := openTrigramIndex(path+trigramIndexSuffix, len(f.Files))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("failed to load trigram index of %q: %v", path, err)
+		}
+		return
+	}
+	f.index.Store(idx)
+}
+
+// trigramCandidates returns the set of indexes into f.Files of the files
+// which may contain literal, or nil if every file may contain it. See
+// trigramIndex.candidates.
+func (f *zipFile) trigramCandidates(literal []byte) *roaring.Bitmap {
+	idx := f.index.Load()
+	if idx == nil {
+		return nil
+	}
+	return idx.candidates(literal)
+}
+
 func (f *zipFile) PopulateFiles(r *zip.Reader) error {
 	f.Files = make([]srcFile, len(r.File))
 	for i, file := range r.File {
@@ -1,18 +1,16 @@
 package search
 
 import (
-	"archive/tar"
 	"context"
 	"path/filepath"
 	"regexp/syntax" //nolint:depguard // zoekt requires this pkg
 	"strings"
 	"time"
 
-	"github.com/sourcegraph/conc/pool"
-	"github.com/sourcegraph/log"
 	"github.com/sourcegraph/zoekt"
 	zoektquery "github.com/sourcegraph/zoekt/query"
 
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/internal/structural"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/search"
@@ -64,13 +62,13 @@ func buildQuery(args *search.TextPatternInfo, branchRepos []zoektquery.BranchRep
 	), nil
 }
 
-// zoektSearch searches repositories using zoekt, returning file contents for
-// files that match the given pattern.
+// zoektSearch searches repositories using zoekt, and searches the contents of
+// files that match the given pattern with matcher.
 //
 // Timeouts are reported through the context, and as a special case errNoResultsInTimeout
 // is returned if no results are found in the given timeout (instead of the more common
 // case of finding partial or full results in the given timeout).
-func zoektSearch(ctx context.Context, logger log.Logger, client zoekt.Streamer, args *search.TextPatternInfo, branchRepos []zoektquery.BranchRepos, since func(t time.Time) time.Duration, repo api.RepoName, sender matchSender) (err error) {
+func zoektSearch(ctx context.Context, client zoekt.Streamer, matcher *structural.Matcher, args *search.TextPatternInfo, branchRepos []zoektquery.BranchRepos, since func(t time.Time) time.Duration, repo api.RepoName, sender matchSender) (err error) {
 	if len(branchRepos) == 0 {
 		return nil
 	}
@@ -97,42 +95,18 @@ func zoektSearch(ctx context.Context, logger log.Logger, client zoekt.Streamer,
 		extensionHint = strings.TrimSuffix(filepath.Ext(args.IncludePatterns[0]), "$")
 	}
 
-	pool := pool.New().WithErrors()
-	tarInputEventC := make(chan comby.TarInputEvent)
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	pool.Go(func() error {
-		// Cancel the context on completion so that the writer doesn't
-		// block indefinitely if this stops reading.
-		defer cancel()
-		return structuralSearch(ctx, logger, comby.Tar{TarInputEventC: tarInputEventC}, all, extensionHint, args.Pattern, args.CombyRule, args.Languages, repo, sender)
-	})
-
-	pool.Go(func() error {
-		defer close(tarInputEventC)
-
+	produce := func(ctx context.Context, files chan<- structuralFile) error {
 		return client.StreamSearch(ctx, q, searchOpts, backend.ZoektStreamFunc(func(event *zoekt.SearchResult) {
 			for _, file := range event.Files {
-				hdr := tar.Header{
-					Name: file.FileName,
-					Mode: 0600,
-					Size: int64(len(file.Content)),
-				}
-				tarInput := comby.TarInputEvent{
-					Header:  hdr,
-					Content: file.Content,
-				}
 				select {
-				case tarInputEventC <- tarInput:
+				case files <- structuralFile{name: file.FileName, content: file.Content}:
 				case <-ctx.Done():
 					return
 				}
 			}
 		}))
-	})
-
-	err = pool.Wait()
+	}
+	err = runNativeMatcher(ctx, matcher, produce, args.Languages, extensionHint, "", repo, sender)
 	if err != nil {
 		return err
 	}
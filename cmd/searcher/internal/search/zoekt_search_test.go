@@ -6,13 +6,11 @@ import (
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
-	"github.com/sourcegraph/log/logtest"
 	"github.com/sourcegraph/zoekt"
 	"github.com/sourcegraph/zoekt/query"
 	"github.com/stretchr/testify/require"
 
-	"github.com/sourcegraph/sourcegraph/internal/api"
-	"github.com/sourcegraph/sourcegraph/internal/comby"
+	"github.com/sourcegraph/sourcegraph/cmd/searcher/internal/structural"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -27,37 +25,73 @@ func (mc *mockClient) StreamSearch(ctx context.Context, q query.Q, opts *zoekt.S
 }
 
 func Test_zoektSearch(t *testing.T) {
-	ctx := context.Background()
-
-	// Create a mock client that will send a few files worth of matches
+	// Create a mock client that will send a few files worth of matches before
+	// failing.
 	client := &mockClient{
 		mockStreamSearch: func(ctx context.Context, q query.Q, so *zoekt.SearchOptions, s zoekt.Sender) error {
 			for i := 0; i < 10; i++ {
 				s.Send(&zoekt.SearchResult{
-					Files: []zoekt.FileMatch{{}, {}},
+					Files: []zoekt.FileMatch{{Content: []byte("foo()")}, {}},
 				})
 			}
-			return nil
+			return errors.New("oops")
 		},
 	}
 
-	// Structural search fails immediately, so can't consume the events from the zoekt stream
-	mockStructuralSearch = func(ctx context.Context, inputType comby.Input, paths filePatterns, extensionHint, pattern, rule string, languages []string, repo api.RepoName, sender matchSender) error {
-		return errors.New("oops")
-	}
-	t.Cleanup(func() { mockStructuralSearch = nil })
+	matcher, err := structural.NewMatcher("foo(:[x])", "")
+	require.NoError(t, err)
 
-	// Ensure that this returns an error from structuralSearch, and does not block
-	// indefinitely because the reader returns early.
-	err := zoektSearch(
+	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 100)
+	defer cancel()
+
+	// Ensure that this returns the error of the stream, and does not block
+	// indefinitely.
+	err = zoektSearch(
 		ctx,
-		logtest.Scoped(t),
 		client,
-		&search.TextPatternInfo{},
+		matcher,
+		&search.TextPatternInfo{Pattern: "foo(:[x])"},
 		[]query.BranchRepos{{Branch: "test", Repos: roaring.BitmapOf(1, 2, 3)}},
 		time.Since,
 		"",
-		matchSender(nil),
+		sender,
+	)
+	require.ErrorContains(t, err, "oops")
+}
+
+func Test_zoektSearchNative(t *testing.T) {
+	client := &mockClient{
+		mockStreamSearch: func(ctx context.Context, q query.Q, so *zoekt.SearchOptions, s zoekt.Sender) error {
+			s.Send(&zoekt.SearchResult{
+				Files: []zoekt.FileMatch{
+					{FileName: "a.go", Content: []byte("package a\n\n// foo(comment)\nvar x = foo(bar)\n")},
+					{FileName: "b.go", Content: []byte("package b\n")},
+				},
+			})
+			return nil
+		},
+	}
+
+	matcher, err := structural.NewMatcher("foo(:[x])", `where :[x] == "bar"`)
+	require.NoError(t, err)
+
+	ctx, cancel, sender := newLimitedStreamCollector(context.Background(), 100)
+	defer cancel()
+	err = zoektSearch(
+		ctx,
+		client,
+		matcher,
+		&search.TextPatternInfo{Pattern: "foo(:[x])", CombyRule: `where :[x] == "bar"`, IsStructuralPat: true},
+		[]query.BranchRepos{{Branch: "test", Repos: roaring.BitmapOf(1)}},
+		func(time.Time) time.Duration { return 0 },
+		"",
+		sender,
 	)
-	require.Error(t, err)
+	require.NoError(t, err)
+
+	require.Len(t, sender.collected, 1)
+	fm := sender.collected[0]
+	require.Equal(t, "a.go", fm.Path)
+	require.Len(t, fm.ChunkMatches, 1)
+	require.Equal(t, "var x = foo(bar)", fm.ChunkMatches[0].Content)
 }
@@ -0,0 +1,89 @@
+package structural
+
+import (
+	"path"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/cpp"
+	"github.com/smacker/go-tree-sitter/csharp"
+	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/java"
+	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/python"
+	"github.com/smacker/go-tree-sitter/ruby"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+)
+
+// Language is a language with a tree-sitter grammar. These are the grammars
+// used by squirrel in cmd/symbols.
+type Language struct {
+	// Name is the lowercase name of the language, eg "go".
+	Name string
+
+	grammar    *sitter.Language
+	extensions []string
+	filenames  []string
+}
+
+var languages = []*Language{
+	{Name: "cpp", grammar: cpp.GetLanguage(), extensions: []string{".c", ".h", ".cc", ".cpp", ".cxx", ".hh", ".hpp", ".hxx"}},
+	{Name: "csharp", grammar: csharp.GetLanguage(), extensions: []string{".cs"}},
+	{Name: "go", grammar: golang.GetLanguage(), extensions: []string{".go"}},
+	{Name: "java", grammar: java.GetLanguage(), extensions: []string{".java"}},
+	{Name: "javascript", grammar: javascript.GetLanguage(), extensions: []string{".js", ".jsx", ".mjs", ".cjs"}},
+	{Name: "python", grammar: python.GetLanguage(), extensions: []string{".py", ".pyi"}},
+	{Name: "ruby", grammar: ruby.GetLanguage(), extensions: []string{".rb"}},
+	{Name: "starlark", grammar: python.GetLanguage(), extensions: []string{".bzl", ".star", ".bazel"}, filenames: []string{"BUILD", "WORKSPACE"}},
+	{Name: "typescript", grammar: tsx.GetLanguage(), extensions: []string{".ts", ".tsx"}},
+}
+
+// languageAliases maps the names of languages in lang: filters to the names
+// of Languages.
+var languageAliases = map[string]string{
+	"c":       "cpp",
+	"c++":     "cpp",
+	"c#":      "csharp",
+	"golang":  "go",
+	"js":      "javascript",
+	"jsx":     "javascript",
+	"ts":      "typescript",
+	"tsx":     "typescript",
+	"bazel":   "starlark",
+	"skylark": "starlark",
+}
+
+// LanguageByName returns the Language called name, as used in lang: filters.
+// It returns nil if the language has no grammar.
+func LanguageByName(name string) *Language {
+	name = strings.ToLower(name)
+	if alias, ok := languageAliases[name]; ok {
+		name = alias
+	}
+	for _, l := range languages {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// LanguageForPath returns the Language of the file at filePath, judging by
+// its name. It returns nil if the language has no grammar.
+func LanguageForPath(filePath string) *Language {
+	base := path.Base(filePath)
+	ext := strings.ToLower(path.Ext(base))
+	for _, l := range languages {
+		for _, e := range l.extensions {
+			if e == ext {
+				return l
+			}
+		}
+		for _, name := range l.filenames {
+			if name == base {
+				return l
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package structural
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// maxMatchSteps bounds the work done matching a template against a single
+// file. Templates with many holes can otherwise take time exponential in the
+// size of the file. Matching stops once the limit is reached, returning the
+// matches found so far.
+const maxMatchSteps = 1 << 22
+
+// Matcher matches a comby match template, optionally constrained by a rule,
+// against files. It is safe for concurrent use.
+type Matcher struct {
+	elements    []element
+	constraints []constraint
+
+	// parsers is a pool of *sitter.Parser, which are not safe for
+	// concurrent use.
+	parsers sync.Pool
+}
+
+// NewMatcher returns a Matcher for the comby match template and rule. It
+// returns an error wrapping ErrUnsupportedRule if the rule uses comby
+// features which aren't supported.
+func NewMatcher(template, rule string) (*Matcher, error) {
+	elements, err := parseTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := parseRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{
+		elements:    elements,
+		constraints: constraints,
+		parsers: sync.Pool{New: func() any {
+			return sitter.NewParser()
+		}},
+	}, nil
+}
+
+// Match is a match of a template in a file.
+type Match struct {
+	// Start and End are the byte offsets of the match in the file.
+	Start, End int
+
+	// Environment contains the text matched by each named hole.
+	Environment map[string]Binding
+}
+
+// Binding is the text matched by a hole.
+type Binding struct {
+	// Start and End are the byte offsets of the text in the file.
+	Start, End int
+	Value      string
+}
+
+// Match returns the non-overlapping matches of the template in src, ordered
+// by offset. lang is the language of src, or nil if it has no grammar, in
+// which case src is matched without knowledge of its syntax.
+func (m *Matcher) Match(ctx context.Context, src []byte, lang *Language) ([]Match, error) {
+	var tokens []token
+	if lang != nil {
+		parser := m.parsers.Get().(*sitter.Parser)
+		var err error
+		tokens, err = tokenizeTree(ctx, parser, lang, src)
+		m.parsers.Put(parser)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tokens = tokenizeGeneric(src)
+	}
+
+	s := &matchState{
+		src:         src,
+		tokens:      tokens,
+		elements:    m.elements,
+		constraints: m.constraints,
+		env:         map[string]span{},
+	}
+
+	// A leading hole matches all of the text before the rest of the
+	// template in its block, so only try the starts of blocks.
+	leadingHole := m.elements[0].kind == elementHole
+
+	var matches []Match
+	lastEnd := 0
+	for ti := 0; ti < len(tokens); ti++ {
+		if ti%1024 == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if leadingHole && ti != lastEnd && !s.isOpen(ti-1) {
+			continue
+		}
+
+		for name := range s.env {
+			delete(s.env, name)
+		}
+		end, ok := s.match(0, ti)
+		if s.steps > maxMatchSteps {
+			break
+		}
+		if !ok || end == ti || !s.literalBalanced(ti, end) {
+			continue
+		}
+
+		match := Match{
+			Start:       int(tokens[ti].start),
+			End:         int(tokens[end-1].end),
+			Environment: make(map[string]Binding, len(s.env)),
+		}
+		for name, sp := range s.env {
+			match.Environment[name] = s.binding(sp)
+		}
+		matches = append(matches, match)
+
+		lastEnd = end
+		ti = end - 1
+	}
+	return matches, nil
+}
+
+// span is a range of tokens matched by a hole. Spans of space holes are
+// empty, and refer to the whitespace before token start.
+type span struct {
+	start, end int
+	space      bool
+}
+
+type matchState struct {
+	src         []byte
+	tokens      []token
+	elements    []element
+	constraints []constraint
+
+	// env maps the names of holes matched so far to their spans.
+	env   map[string]span
+	steps int
+}
+
+// match matches elements[ei:] starting at tokens[ti] and returns the index
+// of the token following the match. It backtracks over the possible spans of
+// holes, trying shorter spans first.
+func (s *matchState) match(ei, ti int) (int, bool) {
+	s.steps++
+	if s.steps > maxMatchSteps {
+		return 0, false
+	}
+	if ei == len(s.elements) {
+		return ti, s.satisfiesConstraints()
+	}
+
+	n := len(s.tokens)
+	e := &s.elements[ei]
+	switch e.kind {
+	case elementLiteral:
+		for k, text := range e.literal {
+			j := ti + k
+			if j >= n || string(s.src[s.tokens[j].start:s.tokens[j].end]) != text || (k > 0 && s.hasSpaceBefore(j)) {
+				return 0, false
+			}
+		}
+		return s.match(ei+1, ti+len(e.literal))
+
+	case elementSpace:
+		if ti < n && !s.hasSpaceBefore(ti) {
+			return 0, false
+		}
+		return s.match(ei+1, ti)
+
+	case elementSpaceHole:
+		return s.bind(e, ei, span{start: ti, end: ti, space: true})
+
+	case elementWordHole:
+		if ti >= n || !isWordByte(s.src[s.tokens[ti].start]) {
+			return 0, false
+		}
+		return s.bind(e, ei, span{start: ti, end: ti + 1})
+
+	case elementLineHole:
+		j := ti
+		for j < n && (j == ti || !s.hasNewlineBefore(j)) {
+			j++
+		}
+		return s.bind(e, ei, span{start: ti, end: j})
+
+	default:
+		return s.matchHole(e, ei, ti)
+	}
+}
+
+// matchHole matches the hole e, which may match a varying number of tokens.
+func (s *matchState) matchHole(e *element, ei, ti int) (int, bool) {
+	// A trailing hole matches as much as possible, otherwise it would
+	// always match nothing.
+	greedy := ei == len(s.elements)-1
+	var ends []int
+
+	depth := 0
+extend:
+	for j := ti; j <= len(s.tokens); j++ {
+		if j > ti {
+			// Extend the span by tokens[j-1].
+			if s.isOpen(j - 1) {
+				depth++
+			} else if s.isClose(j - 1) {
+				depth--
+			}
+			if depth < 0 {
+				break
+			}
+			switch e.kind {
+			case elementPunctuationHole:
+				if j-1 > ti && s.hasSpaceBefore(j-1) {
+					break extend
+				}
+			case elementRegexpHole:
+				if j-1 > ti && s.hasNewlineBefore(j-1) {
+					break extend
+				}
+			}
+		}
+
+		if depth != 0 || !s.literalBalanced(ti, j) {
+			continue
+		}
+		switch e.kind {
+		case elementPunctuationHole:
+			if j == ti {
+				continue
+			}
+		case elementRegexpHole:
+			if j == ti || !e.re.Match(s.src[s.tokens[ti].start:s.tokens[j-1].end]) {
+				continue
+			}
+		}
+
+		if greedy {
+			ends = append(ends, j)
+			continue
+		}
+		if end, ok := s.bind(e, ei, span{start: ti, end: j}); ok {
+			return end, true
+		}
+		if s.steps > maxMatchSteps {
+			return 0, false
+		}
+	}
+
+	for i := len(ends) - 1; i >= 0; i-- {
+		if end, ok := s.bind(e, ei, span{start: ti, end: ends[i]}); ok {
+			return end, true
+		}
+	}
+	return 0, false
+}
+
+// bind matches the rest of the template after the hole e matched sp.
+func (s *matchState) bind(e *element, ei int, sp span) (int, bool) {
+	if e.name == "" || e.name == "_" {
+		return s.match(ei+1, sp.end)
+	}
+	if prev, ok := s.env[e.name]; ok {
+		if s.value(prev) != s.value(sp) {
+			return 0, false
+		}
+		return s.match(ei+1, sp.end)
+	}
+
+	s.env[e.name] = sp
+	end, ok := s.match(ei+1, sp.end)
+	if !ok {
+		delete(s.env, e.name)
+	}
+	return end, ok
+}
+
+func (s *matchState) satisfiesConstraints() bool {
+	for _, c := range s.constraints {
+		sp, ok := s.env[c.name]
+		if !ok {
+			return false
+		}
+		var equal bool
+		if c.other != "" {
+			other, ok := s.env[c.other]
+			if !ok {
+				return false
+			}
+			equal = s.value(sp) == s.value(other)
+		} else {
+			equal = s.binding(sp).Value == c.value
+		}
+		if equal != c.equal {
+			return false
+		}
+	}
+	return true
+}
+
+// value returns the text of sp for comparing holes. Whitespace between
+// tokens is normalized to a single space.
+func (s *matchState) value(sp span) string {
+	if sp.space {
+		return s.binding(sp).Value
+	}
+	var b strings.Builder
+	for j := sp.start; j < sp.end; j++ {
+		if j > sp.start && s.hasSpaceBefore(j) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(s.text(j))
+	}
+	return b.String()
+}
+
+// binding returns the text of the file matched by sp.
+func (s *matchState) binding(sp span) Binding {
+	var start, end int
+	switch {
+	case sp.space:
+		end = len(s.src)
+		if sp.start < len(s.tokens) {
+			end = int(s.tokens[sp.start].start)
+		}
+		if sp.start > 0 {
+			start = int(s.tokens[sp.start-1].end)
+		}
+	case sp.start == sp.end:
+		start = len(s.src)
+		if sp.start < len(s.tokens) {
+			start = int(s.tokens[sp.start].start)
+		}
+		end = start
+	default:
+		start = int(s.tokens[sp.start].start)
+		end = int(s.tokens[sp.end-1].end)
+	}
+	return Binding{Start: start, End: end, Value: string(s.src[start:end])}
+}
+
+func (s *matchState) text(j int) string {
+	return string(s.src[s.tokens[j].start:s.tokens[j].end])
+}
+
+// hasSpaceBefore returns true if there is whitespace (or a comment) between
+// tokens[j-1] and tokens[j].
+func (s *matchState) hasSpaceBefore(j int) bool {
+	return j > 0 && s.tokens[j-1].end < s.tokens[j].start
+}
+
+func (s *matchState) hasNewlineBefore(j int) bool {
+	return j > 0 && bytes.IndexByte(s.src[s.tokens[j-1].end:s.tokens[j].start], '\n') >= 0
+}
+
+func (s *matchState) isOpen(j int) bool {
+	if j < 0 || s.tokens[j].literal != 0 {
+		return false
+	}
+	c := s.src[s.tokens[j].start]
+	return c == '(' || c == '[' || c == '{'
+}
+
+func (s *matchState) isClose(j int) bool {
+	if s.tokens[j].literal != 0 {
+		return false
+	}
+	c := s.src[s.tokens[j].start]
+	return c == ')' || c == ']' || c == '}'
+}
+
+// literalBalanced returns true if tokens[i:j] don't contain only part of a
+// literal, unless they are all strictly inside of the same literal.
+func (s *matchState) literalBalanced(i, j int) bool {
+	if i == j {
+		return true
+	}
+	first, last := s.tokens[i].literal, s.tokens[j-1].literal
+	if first != 0 && first == last {
+		hasStart := i == 0 || s.tokens[i-1].literal != first
+		hasEnd := j == len(s.tokens) || s.tokens[j].literal != first
+		return hasStart == hasEnd
+	}
+	if first != 0 && i > 0 && s.tokens[i-1].literal == first {
+		return false
+	}
+	if last != 0 && j < len(s.tokens) && s.tokens[j].literal == last {
+		return false
+	}
+	return true
+}
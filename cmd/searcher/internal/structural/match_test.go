@@ -0,0 +1,113 @@
+package structural
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMatcher_Generic(t *testing.T) {
+	cases := []struct {
+		name     string
+		template string
+		rule     string
+		src      string
+		want     []string
+	}{
+		{name: "literal", template: "foo(bar)", src: "a foo(bar) b foo( bar )", want: []string{"foo(bar)"}},
+		{name: "hole", template: "foo(:[args])", src: "foo(a, (b, c)) foo()", want: []string{"foo(a, (b, c))", "foo()"}},
+		{name: "balanced", template: "foo(:[x])", src: "foo(a) b)", want: []string{"foo(a)"}},
+		{name: "string delimiters", template: "f(:[x])", src: `f(")") f(a)`, want: []string{`f(")")`, "f(a)"}},
+		{name: "repeated hole", template: ":[[x]] == :[[x]]", src: "a == b; c == c", want: []string{"c == c"}},
+		{name: "word hole", template: "x.:[[name]]", src: "x.foo(bar) x.(y)", want: []string{"x.foo"}},
+		{name: "regexp hole", template: "v = :[n~[0-9]+]", src: "v = 1; v = x; v = 22", want: []string{"v = 1", "v = 22"}},
+		{name: "template string", template: `"hello :[x]"`, src: `"hello world" "goodbye world"`, want: []string{`"hello world"`}},
+		{name: "rule equal", template: "f(:[x])", rule: `where :[x] == "a"`, src: "f(a) f(b)", want: []string{"f(a)"}},
+		{name: "rule not equal", template: ":[[a]] = :[[b]]", rule: "where :[a] != :[b]", src: "x = x; x = y", want: []string{"x = y"}},
+		{name: "ellipsis", template: "if ... {", src: "if a && b {}", want: []string{"if a && b {"}},
+		{name: "no match", template: "foo(:[x])", src: "bar(x)", want: nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewMatcher(tc.template, tc.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			matches, err := m.Match(context.Background(), []byte(tc.src), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, match := range matches {
+				got = append(got, tc.src[match.Start:match.End])
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("want %q, got %q", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("want %q, got %q", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMatcher_Environment(t *testing.T) {
+	m, err := NewMatcher("foo(:[a], :[b])", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := "x := foo(1, bar(2, 3))"
+	matches, err := m.Match(context.Background(), []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(matches))
+	}
+	for name, want := range map[string]string{"a": "1", "b": "bar(2, 3)"} {
+		b := matches[0].Environment[name]
+		if b.Value != want || src[b.Start:b.End] != want {
+			t.Errorf("%s: want %q, got %+v", name, want, b)
+		}
+	}
+}
+
+func TestMatcher_Tree(t *testing.T) {
+	m, err := NewMatcher("fmt.Println(:[x])", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := `package main
+
+func main() {
+	// fmt.Println(commented)
+	fmt.Println(")")
+	fmt.Println('(')
+}
+`
+	matches, err := m.Match(context.Background(), []byte(src), LanguageByName("go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, match := range matches {
+		got = append(got, match.Environment["x"].Value)
+	}
+	want := []string{`")"`, `'('`}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestMatcher_Canceled(t *testing.T) {
+	m, err := NewMatcher("foo", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := m.Match(ctx, []byte("foo"), nil); err == nil {
+		t.Error("expected error")
+	}
+}
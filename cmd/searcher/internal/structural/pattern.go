@@ -0,0 +1,244 @@
+package structural
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/grafana/regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type elementKind int
+
+const (
+	// elementLiteral matches its tokens, without whitespace between them.
+	elementLiteral elementKind = iota
+	// elementSpace is whitespace in the template. It matches whitespace
+	// between tokens.
+	elementSpace
+	// elementHole is :[x] or ... and matches balanced text.
+	elementHole
+	// elementWordHole is :[[x]] and matches a single word.
+	elementWordHole
+	// elementPunctuationHole is :[x.] and matches balanced text without
+	// whitespace.
+	elementPunctuationHole
+	// elementLineHole is :[x\n] and matches the rest of the line.
+	elementLineHole
+	// elementSpaceHole is :[ x] and matches whitespace.
+	elementSpaceHole
+	// elementRegexpHole is :[x~re] and matches text matching re.
+	elementRegexpHole
+)
+
+type element struct {
+	kind elementKind
+
+	// name is the name of a hole. Holes with the same name must match the
+	// same text. The anonymous holes "" and "_" match any text.
+	name string
+
+	// literal is the text of the tokens of an elementLiteral.
+	literal []string
+
+	// re is the regexp of an elementRegexpHole, anchored at both ends.
+	re *regexp.Regexp
+}
+
+var (
+	wordHole        = regexp.MustCompile(`^:\[\[(\w+)\]\]`)
+	spaceHole       = regexp.MustCompile(`^:\[ +(\w*)\]`)
+	punctuationHole = regexp.MustCompile(`^:\[(\w+)\.\]`)
+	lineHole        = regexp.MustCompile(`^:\[(\w+)\\n\]`)
+	hole            = regexp.MustCompile(`^:\[(\w+)\]`)
+	regexpHoleStart = regexp.MustCompile(`^:\[(\w*)~`)
+)
+
+// parseTemplate parses a comby match template.
+func parseTemplate(template string) ([]element, error) {
+	template = strings.TrimSpace(template)
+
+	var (
+		elements []element
+		literal  strings.Builder
+	)
+	flush := func() {
+		if literal.Len() == 0 {
+			return
+		}
+		text := literal.String()
+		t := tokenizer{src: []byte(text)}
+		t.text(0, len(text), false)
+		e := element{kind: elementLiteral}
+		for _, tok := range t.tokens {
+			e.literal = append(e.literal, text[tok.start:tok.end])
+		}
+		elements = append(elements, e)
+		literal.Reset()
+	}
+	add := func(e element) {
+		flush()
+		elements = append(elements, e)
+	}
+
+	for i := 0; i < len(template); {
+		rest := template[i:]
+		if m := wordHole.FindStringSubmatch(rest); m != nil {
+			add(element{kind: elementWordHole, name: m[1]})
+			i += len(m[0])
+			continue
+		}
+		if m := spaceHole.FindStringSubmatch(rest); m != nil {
+			add(element{kind: elementSpaceHole, name: m[1]})
+			i += len(m[0])
+			continue
+		}
+		if m := punctuationHole.FindStringSubmatch(rest); m != nil {
+			add(element{kind: elementPunctuationHole, name: m[1]})
+			i += len(m[0])
+			continue
+		}
+		if m := lineHole.FindStringSubmatch(rest); m != nil {
+			add(element{kind: elementLineHole, name: m[1]})
+			i += len(m[0])
+			continue
+		}
+		if m := hole.FindStringSubmatch(rest); m != nil {
+			add(element{kind: elementHole, name: m[1]})
+			i += len(m[0])
+			continue
+		}
+		if m := regexpHoleStart.FindStringSubmatch(rest); m != nil {
+			expr, n, err := scanRegexpHole(rest[len(m[0]):])
+			if err != nil {
+				return nil, err
+			}
+			re, err := regexp.Compile(`^(?:` + expr + `)$`)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid regular expression in hole %q", rest[:len(m[0])+n])
+			}
+			add(element{kind: elementRegexpHole, name: m[1], re: re})
+			i += len(m[0]) + n
+			continue
+		}
+		if strings.HasPrefix(rest, "...") {
+			add(element{kind: elementHole})
+			i += len("...")
+			continue
+		}
+		if isSpace(rest[0]) {
+			for i < len(template) && isSpace(template[i]) {
+				i++
+			}
+			add(element{kind: elementSpace})
+			continue
+		}
+		literal.WriteByte(rest[0])
+		i++
+	}
+	flush()
+
+	if len(elements) == 0 {
+		return nil, errors.New("empty structural search pattern")
+	}
+	return elements, nil
+}
+
+// scanRegexpHole returns the regular expression at the start of s, up to
+// the "]" closing the hole, and the length of s consumed including that "]".
+// Brackets of character classes like [a-z] are balanced.
+func scanRegexpHole(s string) (string, int, error) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth == 0 {
+				return s[:i], i + 1, nil
+			}
+			depth--
+		}
+	}
+	return "", 0, errors.Errorf("unterminated regular expression hole :[~%s", s)
+}
+
+// ErrUnsupportedRule is returned for rules which are valid for comby, but
+// not supported by this package, like match and rewrite expressions.
+var ErrUnsupportedRule = errors.New(`unsupported structural search rule, only == and != constraints are supported, like where :[x] == "foo", :[y] != :[x]`)
+
+// constraint is a condition of a rule, like :[x] == "foo".
+type constraint struct {
+	name  string
+	equal bool
+
+	// Exactly one of value and other is set. other is the name of another
+	// hole.
+	value string
+	other string
+}
+
+// parseRule parses a comby rule. Only conjunctions of equality and
+// inequality constraints are supported, like
+//
+//	where :[x] == "foo", :[y] != :[x]
+func parseRule(rule string) ([]constraint, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(rule, "where") {
+		return nil, errors.Wrapf(ErrUnsupportedRule, "rule must start with where: %q", rule)
+	}
+
+	var constraints []constraint
+	rest := strings.TrimSpace(strings.TrimPrefix(rule, "where"))
+	for {
+		c, n, err := parseConstraint(rest)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, c)
+		rest = strings.TrimSpace(rest[n:])
+		if rest == "" {
+			return constraints, nil
+		}
+		if rest[0] != ',' {
+			return nil, errors.Wrapf(ErrUnsupportedRule, "expected , in rule at %q", rest)
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+}
+
+var (
+	constraintHole = regexp.MustCompile(`^:\[(\w+)\]\s*(==|!=)\s*`)
+	constraintRHS  = regexp.MustCompile(`^:\[(\w+)\]`)
+)
+
+// parseConstraint parses the constraint at the start of s and returns the
+// number of bytes consumed.
+func parseConstraint(s string) (constraint, int, error) {
+	m := constraintHole.FindStringSubmatch(s)
+	if m == nil {
+		return constraint{}, 0, errors.Wrapf(ErrUnsupportedRule, "unsupported constraint %q", s)
+	}
+	c := constraint{name: m[1], equal: m[2] == "=="}
+	n := len(m[0])
+
+	if rhs := constraintRHS.FindStringSubmatch(s[n:]); rhs != nil {
+		c.other = rhs[1]
+		return c, n + len(rhs[0]), nil
+	}
+	if !strings.HasPrefix(s[n:], `"`) {
+		return constraint{}, 0, errors.Wrapf(ErrUnsupportedRule, "unsupported constraint %q", s)
+	}
+	quoted, err := strconv.QuotedPrefix(s[n:])
+	if err != nil {
+		return constraint{}, 0, errors.Errorf("invalid string in rule: %q", s[n:])
+	}
+	c.value, _ = strconv.Unquote(quoted)
+	return c, n + len(quoted), nil
+}
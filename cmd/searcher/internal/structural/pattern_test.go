@@ -0,0 +1,77 @@
+package structural
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTemplate(t *testing.T) {
+	elements, err := parseTemplate(`foo(:[a], :[[b]], :[c.], :[d\n]:[ e]:[f~\w+]) ...`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var kinds []elementKind
+	for _, e := range elements {
+		kinds = append(kinds, e.kind)
+	}
+	want := []elementKind{
+		elementLiteral, elementHole, elementLiteral, elementSpace, elementWordHole, elementLiteral, elementSpace,
+		elementPunctuationHole, elementLiteral, elementSpace, elementLineHole, elementSpaceHole, elementRegexpHole,
+		elementLiteral, elementSpace, elementHole,
+	}
+	if len(kinds) != len(want) {
+		t.Fatalf("want %v, got %v", want, kinds)
+	}
+	for i := range kinds {
+		if kinds[i] != want[i] {
+			t.Fatalf("want %v, got %v", want, kinds)
+		}
+	}
+
+	for _, template := range []string{"", "   ", ":[x~(]", ":[x~abc"} {
+		if _, err := parseTemplate(template); err == nil {
+			t.Errorf("%q: expected error", template)
+		}
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	constraints, err := parseRule(`where :[x] == "a\"b", :[y] != :[x]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []constraint{{name: "x", equal: true, value: `a"b`}, {name: "y", other: "x"}}
+	if len(constraints) != len(want) || constraints[0] != want[0] || constraints[1] != want[1] {
+		t.Errorf("want %+v, got %+v", want, constraints)
+	}
+
+	for _, rule := range []string{
+		"where match :[x] { | \"a\" -> true }",
+		"where rewrite :[x] { \"a\" -> \"b\" }",
+		"where :[x] == \"a\" && :[y] == \"b\"",
+		"where nested",
+	} {
+		if _, err := parseRule(rule); !errors.Is(err, ErrUnsupportedRule) {
+			t.Errorf("%q: expected ErrUnsupportedRule, got %v", rule, err)
+		}
+	}
+}
+
+func TestLanguageForPath(t *testing.T) {
+	for path, want := range map[string]string{
+		"main.go":           "go",
+		"src/App.TSX":       "typescript",
+		"lib/BUILD":         "starlark",
+		"tools/defs.bzl":    "starlark",
+		"README.md":         "",
+		"include/vector.hh": "cpp",
+	} {
+		got := ""
+		if l := LanguageForPath(path); l != nil {
+			got = l.Name
+		}
+		if got != want {
+			t.Errorf("%s: want %q, got %q", path, want, got)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package structural
+
+import (
+	"bytes"
+
+	"github.com/grafana/regexp"
+)
+
+// rewriteHole matches the holes of a rewrite template: :[x], :[[x]], :[x.],
+// :[x\n] and :[ x].
+var rewriteHole = regexp.MustCompile(`:\[\[(\w+)\]\]|:\[ *(\w+)(?:\.|\\n)?\]`)
+
+// Substitute returns template with its holes replaced by the text they
+// matched in env. Holes which aren't in env are left as is, like comby does.
+func Substitute(template string, env map[string]Binding) string {
+	return rewriteHole.ReplaceAllStringFunc(template, func(hole string) string {
+		m := rewriteHole.FindStringSubmatch(hole)
+		name := m[1]
+		if name == "" {
+			name = m[2]
+		}
+		if b, ok := env[name]; ok {
+			return b.Value
+		}
+		return hole
+	})
+}
+
+// Rewrite returns src with each of matches replaced by template, after
+// substituting the text matched by its holes. matches must be ordered and
+// non-overlapping, like those returned by Matcher.Match.
+func Rewrite(src []byte, matches []Match, template string) []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(src))
+	last := 0
+	for _, m := range matches {
+		buf.Write(src[last:m.Start])
+		buf.WriteString(Substitute(template, m.Environment))
+		last = m.End
+	}
+	buf.Write(src[last:])
+	return buf.Bytes()
+}
@@ -0,0 +1,51 @@
+package structural
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+	env := map[string]Binding{
+		"a": {Value: "x"},
+		"b": {Value: "y + z"},
+	}
+	for template, want := range map[string]string{
+		"f(:[a], :[b])":       "f(x, y + z)",
+		":[[a]].:[b.]":        "x.y + z",
+		":[a\\n]:[ b]":        "xy + z",
+		"g(:[c])":             "g(:[c])",
+		"no holes":            "no holes",
+		":[a]:[a]":            "xx",
+		"foo(:[[a]]) :[[b]]]": "foo(x) y + z]",
+	} {
+		if got := Substitute(template, env); got != want {
+			t.Errorf("Substitute(%q): want %q, got %q", template, want, got)
+		}
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	m, err := NewMatcher("assert.Equal(:[t], :[want], :[got])", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := `func TestFoo(t *testing.T) {
+	assert.Equal(t, 1, foo())
+	assert.Equal(t, "a,b", bar(1, 2))
+}
+`
+	matches, err := m.Match(context.Background(), []byte(src), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(Rewrite([]byte(src), matches, "require.Equal(:[t], :[want], :[got])"))
+	want := `func TestFoo(t *testing.T) {
+	require.Equal(t, 1, foo())
+	require.Equal(t, "a,b", bar(1, 2))
+}
+`
+	if got != want {
+		t.Errorf("unexpected rewrite:\n%s", got)
+	}
+}
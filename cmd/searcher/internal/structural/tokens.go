@@ -0,0 +1,150 @@
+package structural
+
+import (
+	"context"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// token is a word or a single punctuation character of a file. Matching
+// happens on tokens rather than bytes, so whitespace and comments between
+// tokens are skipped.
+type token struct {
+	start, end int32
+
+	// literal identifies the string or character literal the token is part
+	// of, or 0 if it is not part of one. Delimiters inside of literals are
+	// not balanced, and holes may not match part of a literal and text
+	// outside of it.
+	literal int32
+}
+
+// tokenizer splits text into tokens.
+type tokenizer struct {
+	src     []byte
+	tokens  []token
+	literal int32
+}
+
+// text appends the tokens of src[start:end]. If literal is true, they are
+// part of a new string or character literal.
+func (t *tokenizer) text(start, end int, literal bool) {
+	var lit int32
+	if literal {
+		t.literal++
+		lit = t.literal
+	}
+	for i := start; i < end; {
+		c := t.src[i]
+		switch {
+		case isSpace(c):
+			i++
+		case isWordByte(c):
+			j := i + 1
+			for j < end && isWordByte(t.src[j]) {
+				j++
+			}
+			t.tokens = append(t.tokens, token{start: int32(i), end: int32(j), literal: lit})
+			i = j
+		default:
+			t.tokens = append(t.tokens, token{start: int32(i), end: int32(i + 1), literal: lit})
+			i++
+		}
+	}
+}
+
+// tokenizeGeneric tokenizes src without knowledge of its language. Only
+// double quoted strings are recognized as literals.
+func tokenizeGeneric(src []byte) []token {
+	t := tokenizer{src: src}
+	start := 0
+	for i := 0; i < len(src); i++ {
+		if src[i] != '"' {
+			continue
+		}
+		// Find the end of the string on the same line, honoring escapes.
+		j := i + 1
+		for j < len(src) && src[j] != '"' && src[j] != '\n' {
+			if src[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(src) || src[j] != '"' {
+			continue
+		}
+		t.text(start, i, false)
+		t.text(i, j+1, true)
+		start = j + 1
+		i = j
+	}
+	t.text(start, len(src), false)
+	return t.tokens
+}
+
+// tokenizeTree tokenizes src using its tree-sitter syntax tree. Comments are
+// skipped, and string and character literals are recognized as such even if
+// they contain delimiters or quotes.
+func tokenizeTree(ctx context.Context, parser *sitter.Parser, lang *Language, src []byte) ([]token, error) {
+	parser.SetLanguage(lang.grammar)
+	tree, err := parser.ParseCtx(ctx, nil, src)
+	if err != nil {
+		return nil, err
+	}
+	defer tree.Close()
+
+	t := tokenizer{src: src}
+	var walk func(n *sitter.Node)
+	walk = func(n *sitter.Node) {
+		start, end := int(n.StartByte()), int(n.EndByte())
+		if start >= end || end > len(src) {
+			// Missing nodes inserted by error recovery have no text.
+			return
+		}
+		typ := n.Type()
+		switch {
+		case isCommentType(typ):
+			return
+		case isLiteralType(typ):
+			t.text(start, end, true)
+			return
+		case n.ChildCount() == 0:
+			t.text(start, end, false)
+			return
+		}
+		for i := 0; i < int(n.ChildCount()); i++ {
+			walk(n.Child(i))
+		}
+	}
+	walk(tree.RootNode())
+	return t.tokens, nil
+}
+
+// isCommentType returns true for the node types of comments in the supported
+// grammars.
+func isCommentType(typ string) bool {
+	return strings.Contains(typ, "comment")
+}
+
+// isLiteralType returns true for the node types of string and character
+// literals in the supported grammars, eg "interpreted_string_literal" in Go
+// or "template_string" in JavaScript.
+func isLiteralType(typ string) bool {
+	return strings.Contains(typ, "string") ||
+		strings.Contains(typ, "char") ||
+		strings.Contains(typ, "rune") ||
+		strings.Contains(typ, "heredoc") ||
+		typ == "regex"
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+
+// isWordByte returns true for the bytes of words. Bytes of multi-byte UTF-8
+// characters are treated as word bytes, so that those characters aren't
+// split.
+func isWordByte(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c >= 0x80
+}
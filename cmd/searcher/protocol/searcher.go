@@ -118,10 +118,20 @@ type PatternInfo struct {
 	// file list in the frontend and passes it to searcher.
 	CombyRule string
 
+	// Rewrite is a template for rewriting the matches of a structural
+	// search. Holes of the pattern in it are replaced by the text they
+	// matched. If set, the FileMatch of each file has a Diff.
+	Rewrite string
+
 	// Select is the value of the the select field in the query. It is not necessary to
 	// use it since selection is done after the query completes, but exposing it can enable
 	// optimizations.
 	Select string
+
+	// SearchArchives if true will also search the files inside of archives,
+	// like zip, jar and tar.gz files. Their paths look like
+	// "lib/foo.jar!/com/x/Y.java".
+	SearchArchives bool
 }
 
 func (p *PatternInfo) String() string {
@@ -135,6 +145,9 @@ func (p *PatternInfo) String() string {
 		} else {
 			args = append(args, "comby")
 		}
+		if p.Rewrite != "" {
+			args = append(args, fmt.Sprintf("rewrite:%s", p.Rewrite))
+		}
 	}
 	if p.IsWordMatch {
 		args = append(args, "word")
@@ -157,6 +170,9 @@ func (p *PatternInfo) String() string {
 	if p.Select != "" {
 		args = append(args, fmt.Sprintf("select:%s", p.Select))
 	}
+	if p.SearchArchives {
+		args = append(args, "archives")
+	}
 
 	path := "f"
 	if p.PathPatternsAreCaseSensitive {
@@ -194,8 +210,10 @@ func (r *Request) ToProto() *proto.SearchRequest {
 			PatternMatchesContent:        r.PatternInfo.PatternMatchesContent,
 			PatternMatchesPath:           r.PatternInfo.PatternMatchesPath,
 			CombyRule:                    r.PatternInfo.CombyRule,
+			Rewrite:                      r.PatternInfo.Rewrite,
 			Languages:                    r.PatternInfo.Languages,
 			Select:                       r.PatternInfo.Select,
+			SearchArchives:               r.PatternInfo.SearchArchives,
 		},
 		FetchTimeout: durationpb.New(r.FetchTimeout),
 	}
@@ -223,7 +241,9 @@ func (r *Request) FromProto(req *proto.SearchRequest) {
 			PatternMatchesPath:           req.PatternInfo.PatternMatchesPath,
 			Languages:                    req.PatternInfo.Languages,
 			CombyRule:                    req.PatternInfo.CombyRule,
+			Rewrite:                      req.PatternInfo.Rewrite,
 			Select:                       req.PatternInfo.Select,
+			SearchArchives:               req.PatternInfo.SearchArchives,
 		},
 		FetchTimeout: req.FetchTimeout.AsDuration(),
 		Indexed:      req.Indexed,
@@ -249,6 +269,10 @@ type FileMatch struct {
 
 	// LimitHit is true if LineMatches may not include all LineMatches.
 	LimitHit bool
+
+	// Diff is a unified diff of the file with the matches rewritten, for
+	// structural searches with a Rewrite.
+	Diff string
 }
 
 func (fm *FileMatch) ToProto() *proto.FileMatch {
@@ -260,6 +284,7 @@ func (fm *FileMatch) ToProto() *proto.FileMatch {
 		Path:         []byte(fm.Path),
 		ChunkMatches: chunkMatches,
 		LimitHit:     fm.LimitHit,
+		Diff:         []byte(fm.Diff),
 	}
 }
 
@@ -272,6 +297,7 @@ func (fm *FileMatch) FromProto(pm *proto.FileMatch) {
 		Path:         string(pm.GetPath()), // WARNING: It is not safe to assume that Path is utf-8 encoded.
 		ChunkMatches: chunkMatches,
 		LimitHit:     pm.GetLimitHit(),
+		Diff:         string(pm.GetDiff()),
 	}
 }
 
@@ -47,6 +47,9 @@ var (
 	backgroundTimeout = env.MustGetDuration("PROCESSING_TIMEOUT", 2*time.Hour, "maximum time to spend processing a repository")
 
 	maxTotalPathsLengthRaw = env.Get("MAX_TOTAL_PATHS_LENGTH", "100000", "maximum sum of lengths of all paths in a single call to git archive")
+
+	trigramIndex             = env.MustGetBool("SEARCHER_TRIGRAM_INDEX", true, "build trigram indexes of cached archives to speed up repeated searches of unindexed revisions")
+	trigramIndexMaxZipSizeMB = env.MustGetInt("SEARCHER_TRIGRAM_INDEX_MAX_ZIP_SIZE_MB", 1000, "maximum size in megabytes of a cached archive to build a trigram index for")
 )
 
 const port = "3181"
@@ -80,15 +83,11 @@ func shutdownOnSignal(ctx context.Context, server *http.Server) error {
 // setupTmpDir sets up a temporary directory on the same volume as the
 // cacheDir.
 //
-// Structural search relies on temporary files created from zoekt responses.
-// Additionally we shell out to programs that may or may not need a temporary
-// directory.
+// We shell out to programs that may or may not need a temporary directory.
 //
 // search.Store will also take into account the files in tmp when deciding on
 // evicting items due to disk pressure. It won't delete those files unless
-// they are zip files. In the case of comby the files are temporary so them
-// being deleted while read by comby is fine since it will maintain an open
-// FD.
+// they are zip files.
 func setupTmpDir() error {
 	tmpRoot := filepath.Join(cacheDir, ".searcher.tmp")
 	if err := os.MkdirAll(tmpRoot, 0o755); err != nil {
@@ -160,6 +159,9 @@ func Start(ctx context.Context, observationCtx *observation.Context, ready servi
 			BackgroundTimeout: backgroundTimeout,
 			Log:               storeObservationCtx.Logger,
 			ObservationCtx:    storeObservationCtx,
+			TrigramIndex:      trigramIndex,
+
+			TrigramIndexMaxZipSizeBytes: int64(trigramIndexMaxZipSizeMB) * 1000 * 1000,
 		},
 
 		Indexed: sharedsearch.Indexed(),
@@ -72,7 +72,37 @@ func (h *exhaustiveSearchRepoRevHandler) Handle(ctx context.Context, logger log.
 		return err
 	}
 
-	csvWriter := service.NewBlobstoreCSVWriter(ctx, h.uploadStore, fmt.Sprintf("%d-%d", jobID, record.ID))
+	commitID, err := q.ResolveCommit(ctx, repoRev)
+	if err != nil {
+		return err
+	}
+	if err := h.store.SetRepoRevisionJobCommit(ctx, record.ID, commitID); err != nil {
+		return err
+	}
+
+	prefix := fmt.Sprintf("%d-%d", jobID, record.ID)
+
+	if commitID != "" {
+		// If this job is a re-run and the previous job searched the same
+		// commit, the results can't have changed. Reuse them instead of
+		// searching again.
+		prev, err := h.store.GetPreviousRepoRevisionJob(ctx, record)
+		if err != nil {
+			return err
+		}
+		if prev != nil && prev.CommitID == commitID {
+			logger.Debug("reusing results of previous search job",
+				log.Int64("previousSearchJobID", prev.SearchJobID),
+				log.String("commitID", string(commitID)))
+			return service.CopyBlobstoreCSV(ctx, h.uploadStore, fmt.Sprintf("%d-%d", prev.SearchJobID, prev.ID), prefix)
+		}
+
+		// Search the commit we recorded rather than the revision, which may
+		// have moved on since we resolved it.
+		repoRev.Revision = string(commitID)
+	}
+
+	csvWriter := service.NewBlobstoreCSVWriter(ctx, h.uploadStore, prefix)
 
 	err = q.Search(ctx, repoRev, csvWriter)
 	if closeErr := csvWriter.Close(); closeErr != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/store"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
@@ -61,13 +62,14 @@ func TestExhaustiveSearch(t *testing.T) {
 	query := "1@rev1 1@rev2 2@rev3"
 
 	// Create a job
-	job, err := svc.CreateSearchJob(userCtx, query)
+	job, err := svc.CreateSearchJob(userCtx, query, types.ResultFormatCSV)
 	require.NoError(err)
 
 	// Do some assertions on the job before it runs
 	{
 		require.Equal(userID, job.InitiatorID)
 		require.Equal(query, job.Query)
+		require.Equal(types.ResultFormatCSV, job.ResultFormat)
 		require.Equal(types.JobStateQueued, job.State)
 		require.NotZero(job.CreatedAt)
 		require.NotZero(job.UpdatedAt)
@@ -196,6 +198,97 @@ func TestExhaustiveSearch(t *testing.T) {
 	}
 }
 
+func TestExhaustiveSearch_rerun(t *testing.T) {
+	enabled := true
+	conf.Mock(&conf.Unified{
+		SiteConfiguration: schema.SiteConfiguration{
+			ExperimentalFeatures: &schema.ExperimentalFeatures{SearchJobs: &enabled}}})
+	defer conf.Mock(nil)
+
+	require := require.New(t)
+	observationCtx := observation.TestContextTB(t)
+	logger := observationCtx.Logger
+
+	mockUploadStore, bucket := newMockUploadStore(t)
+	db := database.NewDB(logger, dbtest.NewDB(t))
+	s := store.New(db, observation.TestContextTB(t))
+	svc := service.New(observationCtx, s, mockUploadStore, service.NewSearcherFake())
+
+	userID := insertRow(t, s.Store, "users", "username", "alice")
+	insertRow(t, s.Store, "repo", "id", 1, "name", "repoa")
+	insertRow(t, s.Store, "repo", "id", 2, "name", "repob")
+
+	workerCtx, cancel1 := context.WithCancel(actor.WithInternalActor(context.Background()))
+	defer cancel1()
+	userCtx, cancel2 := context.WithCancel(actor.WithActor(context.Background(), actor.FromUser(userID)))
+	defer cancel2()
+
+	searchJob := &searchJob{
+		workerDB: db,
+		config: config{
+			WorkerInterval: 10 * time.Millisecond,
+		},
+	}
+	newSearcherFactory := func(_ *observation.Context, _ database.DB) service.NewSearcher {
+		return service.NewSearcherFake()
+	}
+	routines, err := searchJob.newSearchJobRoutines(workerCtx, observationCtx, mockUploadStore, newSearcherFactory)
+	require.NoError(err)
+	for _, routine := range routines {
+		go routine.Start()
+		defer routine.Stop()
+	}
+	waitForJobs := func() {
+		require.Eventually(func() bool {
+			return !searchJob.hasWork(workerCtx)
+		}, tTimeout(t, 10*time.Second), 10*time.Millisecond)
+	}
+
+	job, err := svc.CreateSearchJob(userCtx, "1@rev1 2@rev2", types.ResultFormatCSV)
+	require.NoError(err)
+	waitForJobs()
+
+	// The job is finished, so we can re-run it.
+	rerun, err := svc.RerunSearchJob(userCtx, job.ID)
+	require.NoError(err)
+	require.Equal(job.ID, rerun.PreviousJobID)
+	require.Equal(job.Query, rerun.Query)
+	require.Equal(job.ResultFormat, rerun.ResultFormat)
+	waitForJobs()
+
+	// The fake searcher resolves every revision to the same commit, so the
+	// re-run copies the results of the previous job rather than searching.
+	{
+		require.Equal(4, len(bucket))
+		require.Equal(2, len(mockUploadStore.GetFunc.History()))
+
+		repoRevs, err := s.ListSearchedRepoRevisions(userCtx, rerun.ID)
+		require.NoError(err)
+		var commits []string
+		for _, repoRev := range repoRevs {
+			commits = append(commits, string(repoRev.CommitID))
+		}
+		sort.Strings(commits)
+		require.Equal([]string{"rev1", "rev2"}, commits)
+	}
+
+	// Nothing changed, so the diff is empty.
+	{
+		writerTo, err := svc.GetSearchJobDiffWriterTo(userCtx, rerun.ID)
+		require.NoError(err)
+		var buf bytes.Buffer
+		_, err = writerTo.WriteTo(&buf)
+		require.NoError(err)
+		require.Empty(buf.String())
+	}
+
+	// Only re-runs have a diff.
+	{
+		_, err := svc.GetSearchJobDiffWriterTo(userCtx, job.ID)
+		require.Error(err)
+	}
+}
+
 // insertRow is a helper for inserting a row into a table. It assumes the
 // table has an autogenerated column called id and it will return that value.
 func insertRow(t testing.TB, store *basestore.Store, table string, keyValues ...any) int32 {
@@ -263,11 +356,23 @@ func newMockUploadStore(t *testing.T) (*mocks.MockStore, map[string]string) {
 		var keys []string
 		mu.Lock()
 		for k := range bucket {
-			keys = append(keys, k)
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
 		}
 		mu.Unlock()
 		return iterator.From(keys), nil
 	})
 
+	mockStore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		v, ok := bucket[key]
+		if !ok {
+			return nil, errors.Newf("key %q not found", key)
+		}
+		return io.NopCloser(strings.NewReader(v)), nil
+	})
+
 	return mockStore, bucket
 }
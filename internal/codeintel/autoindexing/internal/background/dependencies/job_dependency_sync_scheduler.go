@@ -70,6 +70,10 @@ var schemeToExternalService = map[string]string{
 	dependencies.PythonPackagesScheme: extsvc.KindPythonPackages,
 	dependencies.RustPackagesScheme:   extsvc.KindRustPackages,
 	dependencies.RubyPackagesScheme:   extsvc.KindRubyPackages,
+	dependencies.DotnetPackagesScheme: extsvc.KindNugetPackages,
+	dependencies.PhpPackagesScheme:    extsvc.KindPackagistPackages,
+	dependencies.ElixirPackagesScheme: extsvc.KindHexPackages,
+	dependencies.DartPackagesScheme:   extsvc.KindPubPackages,
 }
 
 func (h *dependencySyncSchedulerHandler) Handle(ctx context.Context, logger log.Logger, job dependencySyncingJob) error {
@@ -284,6 +288,9 @@ func (h *dependencySyncSchedulerHandler) shouldIndexDependencies(ctx context.Con
 		upload.Indexer == "lsif-typescript" ||
 		upload.Indexer == "scip-python" ||
 		upload.Indexer == "scip-ruby" ||
+		upload.Indexer == "scip-dotnet" ||
+		upload.Indexer == "scip-php" ||
+		upload.Indexer == "scip-dart" ||
 		upload.Indexer == "rust-analyzer", nil
 }
 
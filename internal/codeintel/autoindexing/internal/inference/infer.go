@@ -19,6 +19,10 @@ func InferRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (
 		inferRustRepositoryAndRevision,
 		inferPythonRepositoryAndRevision,
 		inferRubyRepositoryAndRevision,
+		inferDotnetRepositoryAndRevision,
+		inferPhpRepositoryAndRevision,
+		inferElixirRepositoryAndRevision,
+		inferDartRepositoryAndRevision,
 	} {
 		if repoName, gitTagOrCommit, ok := fn(pkg); ok {
 			return repoName, gitTagOrCommit, true
@@ -99,3 +103,47 @@ func inferRubyRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRep
 
 	return rubyPkg.RepoName(), pkg.Version, true
 }
+
+func inferDotnetRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.DotnetPackagesScheme {
+		return "", "", false
+	}
+
+	dotnetPkg := reposource.ParseDotnetPackageFromName(pkg.Name)
+	dotnetPkg.Version = pkg.Version
+
+	return dotnetPkg.RepoName(), dotnetPkg.GitTagFromVersion(), true
+}
+
+func inferPhpRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.PhpPackagesScheme {
+		return "", "", false
+	}
+
+	phpPkg := reposource.ParsePhpPackageFromName(pkg.Name)
+	phpPkg.Version = pkg.Version
+
+	return phpPkg.RepoName(), phpPkg.GitTagFromVersion(), true
+}
+
+func inferElixirRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.ElixirPackagesScheme {
+		return "", "", false
+	}
+
+	elixirPkg := reposource.ParseElixirPackageFromName(pkg.Name)
+	elixirPkg.Version = pkg.Version
+
+	return elixirPkg.RepoName(), elixirPkg.GitTagFromVersion(), true
+}
+
+func inferDartRepositoryAndRevision(pkg dependencies.MinimialVersionedPackageRepo) (api.RepoName, string, bool) {
+	if pkg.Scheme != dependencies.DartPackagesScheme {
+		return "", "", false
+	}
+
+	dartPkg := reposource.ParseDartPackageFromName(pkg.Name)
+	dartPkg.Version = pkg.Version
+
+	return dartPkg.RepoName(), dartPkg.GitTagFromVersion(), true
+}
@@ -58,6 +58,42 @@ func TestInferRepositoryAndRevision(t *testing.T) {
 				repoName: "npm/myscope/mypackage",
 				revision: "v1.0.0",
 			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "scip-dotnet",
+					Name:    "Newtonsoft.Json",
+					Version: "13.0.3",
+				},
+				repoName: "nuget/newtonsoft.json",
+				revision: "v13.0.3",
+			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "scip-php",
+					Name:    "monolog/monolog",
+					Version: "3.4.0",
+				},
+				repoName: "packagist/monolog/monolog",
+				revision: "v3.4.0",
+			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "hex",
+					Name:    "jason",
+					Version: "1.4.1",
+				},
+				repoName: "hex/jason",
+				revision: "v1.4.1",
+			},
+			{
+				pkg: dependencies.MinimialVersionedPackageRepo{
+					Scheme:  "scip-dart",
+					Name:    "http",
+					Version: "1.1.0",
+				},
+				repoName: "pub/http",
+				revision: "v1.1.0",
+			},
 		}
 
 		for _, testCase := range testCases {
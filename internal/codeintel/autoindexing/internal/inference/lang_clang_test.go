@@ -0,0 +1,40 @@
+package inference
+
+import (
+	"testing"
+)
+
+func TestClangGenerator(t *testing.T) {
+	mockIndexerMap(t, map[string]string{"clang": "sourcegraph/scip-clang:latest"})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "clang CMake projects",
+			repositoryContents: map[string]string{
+				"app/CMakeLists.txt":              "",
+				"app/main.cc":                     "",
+				"lib/CMakeLists.txt":              "",
+				"lib/src/CMakeLists.txt":          "",
+				"lib/src/lib.cc":                  "",
+				"third_party/zlib/CMakeLists.txt": "",
+			},
+		},
+		generatorTestCase{
+			description: "clang Bazel workspace",
+			repositoryContents: map[string]string{
+				"WORKSPACE":      `http_archive(name = "hedron_compile_commands")`,
+				"BUILD.bazel":    "",
+				"CMakeLists.txt": "",
+				"main.cc":        "",
+			},
+		},
+		generatorTestCase{
+			description: "clang Bazel workspace without compile commands extractor",
+			repositoryContents: map[string]string{
+				"WORKSPACE":   "",
+				"BUILD.bazel": "",
+				"main.cc":     "",
+			},
+		},
+	)
+}
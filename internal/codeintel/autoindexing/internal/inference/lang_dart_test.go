@@ -0,0 +1,20 @@
+package inference
+
+import (
+	"testing"
+)
+
+func TestDartGenerator(t *testing.T) {
+	mockIndexerMap(t, map[string]string{"dart": "sourcegraph/scip-dart:latest"})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "scip-dart",
+			repositoryContents: map[string]string{
+				"app/pubspec.yaml":             "",
+				"packages/models/pubspec.yaml": "",
+				"examples/demo/pubspec.yaml":   "",
+			},
+		},
+	)
+}
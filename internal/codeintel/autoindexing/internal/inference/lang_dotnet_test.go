@@ -0,0 +1,28 @@
+package inference
+
+import (
+	"testing"
+)
+
+func TestDotnetGenerator(t *testing.T) {
+	mockIndexerMap(t, map[string]string{"dotnet": "sourcegraph/scip-dotnet:latest"})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "dotnet solutions and projects",
+			repositoryContents: map[string]string{
+				"src/App.sln":                  "",
+				"src/App/App.csproj":           "",
+				"src/App/bin/Debug/App.csproj": "",
+				"tools/Cli/Cli.csproj":         "",
+			},
+		},
+		generatorTestCase{
+			description: "dotnet projects without solution",
+			repositoryContents: map[string]string{
+				"src/Api/Api.csproj":   "",
+				"src/Core/Core.csproj": "",
+			},
+		},
+	)
+}
@@ -22,6 +22,14 @@ func TestJavaGenerator(t *testing.T) {
 				"src/java/com/sourcegraph/codeintel/fun.scala": "",
 			},
 		},
+		generatorTestCase{
+			description: "JVM project with Gradle Kotlin DSL",
+			repositoryContents: map[string]string{
+				"settings.gradle.kts":  "",
+				"app/build.gradle.kts": "",
+				"app/src/main/kotlin/com/sourcegraph/codeintel/Dumb.kt": "",
+			},
+		},
 		generatorTestCase{
 			description: "JVM project with SBT",
 			repositoryContents: map[string]string{
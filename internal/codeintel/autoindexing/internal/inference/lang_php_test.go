@@ -0,0 +1,33 @@
+package inference
+
+import (
+	"testing"
+)
+
+func TestPHPGenerator(t *testing.T) {
+	mockIndexerMap(t, map[string]string{"php": "sourcegraph/scip-php:latest"})
+
+	testGenerators(t,
+		generatorTestCase{
+			description: "scip-php",
+			repositoryContents: map[string]string{
+				"composer.json":                     "",
+				"packages/api/composer.json":        "",
+				"vendor/symfony/yaml/composer.json": "",
+			},
+		},
+	)
+}
+
+func TestPHPGeneratorWithoutIndexer(t *testing.T) {
+	// scip-php has no default image, so no jobs are inferred unless one is
+	// configured.
+	testGenerators(t,
+		generatorTestCase{
+			description: "scip-php without indexer",
+			repositoryContents: map[string]string{
+				"composer.json": "",
+			},
+		},
+	)
+}
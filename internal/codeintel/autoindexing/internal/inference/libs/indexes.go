@@ -15,6 +15,11 @@ var Indexes = indexesAPI{}
 
 type indexesAPI struct{}
 
+// Every default indexer must have a pinned SHA in defaultIndexerSHAs.
+// scip-clang, scip-dart, scip-dotnet and scip-php aren't pinned yet, so C/C++,
+// Dart, C# and PHP are only indexed if site admins configure an image with the
+// codeIntelAutoIndexing.indexerMap setting. Add them here once update-shas.sh
+// has pinned them.
 var defaultIndexers = map[string]string{
 	"go":         "sourcegraph/scip-go",
 	"java":       "sourcegraph/scip-java",
@@ -49,22 +54,37 @@ func DefaultIndexerForLang(language string) (string, bool) {
 	return fmt.Sprintf("%s@%s", indexer, sha), true
 }
 
+// indexerForLang returns the indexer configured in the site config for
+// language, or its default indexer.
+func indexerForLang(language string) (string, bool) {
+	if indexer, ok := conf.SiteConfig().CodeIntelAutoIndexingIndexerMap[language]; ok {
+		return indexer, true
+	}
+	return DefaultIndexerForLang(language)
+}
+
 func (api indexesAPI) LuaAPI() map[string]lua.LGFunction {
 	return map[string]lua.LGFunction{
 		"get": util.WrapLuaFunction(func(state *lua.LState) error {
 			language := state.CheckString(1)
 
-			if indexer, ok := conf.SiteConfig().CodeIntelAutoIndexingIndexerMap[language]; ok {
+			if indexer, ok := indexerForLang(language); ok {
 				state.Push(luar.New(state, indexer))
 				return nil
 			}
 
-			if indexer, ok := DefaultIndexerForLang(language); ok {
+			return errors.Newf("no indexer is registered for %q", language)
+		}),
+		// find is like get, but returns nil for languages without an indexer.
+		"find": util.WrapLuaFunction(func(state *lua.LState) error {
+			language := state.CheckString(1)
+
+			if indexer, ok := indexerForLang(language); ok {
 				state.Push(luar.New(state, indexer))
-				return nil
+			} else {
+				state.Push(lua.LNil)
 			}
-
-			return errors.Newf("no indexer is registered for %q", language)
+			return nil
 		}),
 	}
 }
@@ -15,7 +15,7 @@ fi
 
 SCRIPT_DIR="$(dirname "${BASH_SOURCE[0]}")"
 
-for indexer in lsif-clang scip-go lsif-rust scip-rust scip-java scip-python scip-typescript scip-ruby; do
+for indexer in scip-clang scip-dart scip-dotnet scip-go lsif-rust scip-rust scip-java scip-php scip-python scip-typescript scip-ruby; do
   tag="latest"
   if [[ "${indexer}" = "scip-python" ]] || [[ "${indexer}" = "scip-typescript" || "${indexer}" = "scip-ruby" ]]; then
     tag="autoindex"
@@ -23,6 +23,13 @@ for indexer in lsif-clang scip-go lsif-rust scip-rust scip-java scip-python scip
 
   sha=$(docker buildx imagetools inspect sourcegraph/${indexer}:${tag} --raw | sha256sum | awk '{print "\"" "sha256:" $1 "\""}')
 
+  # Indexers that aren't pinned yet are added to defaultIndexerSHAs.
+  if ! grep -q "\"sourcegraph/${indexer}\":" "$SCRIPT_DIR/indexes.go"; then
+    sed -i.bak \
+      "s|^var defaultIndexerSHAs = map\[string\]string{|&\n\t\"sourcegraph/${indexer}\": \"\",|" \
+      "$SCRIPT_DIR/indexes.go"
+  fi
+
   sed -i.bak \
     "s|\("'"'"sourcegraph/${indexer}"'"'":\).*|\1${sha},|g" \
     "$SCRIPT_DIR/indexes.go"
@@ -0,0 +1,122 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- There is no default indexer until its image is pinned, so jobs are only
+-- generated if site admins configure one.
+local indexer = require("sg.autoindex.indexes").find "clang"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine {
+  shared.exclude_paths,
+  pattern.new_path_segment "third_party",
+  pattern.new_path_segment "vendor",
+}
+
+local bazel_workspace_files = {
+  "MODULE.bazel",
+  "WORKSPACE",
+  "WORKSPACE.bazel",
+}
+
+-- scip-clang needs a compilation database. Bazel workspaces can only generate
+-- one if they depend on https://github.com/hedronvision/bazel-compile-commands-extractor.
+local has_compile_commands_extractor = function(contents_by_path)
+  for i = 1, #bazel_workspace_files do
+    local content = contents_by_path[bazel_workspace_files[i]]
+    if content and string.find(content, "hedron_compile_commands", 1, true) then
+      return true
+    end
+  end
+
+  return false
+end
+
+local bazel_job = {
+  steps = {
+    {
+      root = "",
+      image = indexer,
+      commands = { "bazel run @hedron_compile_commands//:refresh_all" },
+    },
+  },
+  root = "",
+  indexer = indexer,
+  indexer_args = { "scip-clang", "--compdb-path=compile_commands.json" },
+  outfile = outfile,
+}
+
+local cmake_job = function(root)
+  return {
+    steps = {
+      {
+        root = root,
+        image = indexer,
+        commands = { "cmake -B build -DCMAKE_EXPORT_COMPILE_COMMANDS=ON" },
+      },
+    },
+    root = root,
+    indexer = indexer,
+    indexer_args = { "scip-clang", "--compdb-path=build/compile_commands.json" },
+    outfile = outfile,
+  }
+end
+
+local workspace_patterns = {}
+for i = 1, #bazel_workspace_files do
+  table.insert(workspace_patterns, pattern.new_path_literal(bazel_workspace_files[i]))
+end
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_combine(workspace_patterns),
+    pattern.new_path_basename "CMakeLists.txt",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  patterns_for_content = workspace_patterns,
+
+  generate = function(_, paths, contents_by_path)
+    if not indexer then
+      return {}
+    end
+
+    local jobs = {}
+    local is_bazel = has_compile_commands_extractor(contents_by_path)
+    if is_bazel then
+      table.insert(jobs, bazel_job)
+    end
+
+    local cmake_roots = {}
+    for i = 1, #paths do
+      if path.basename(paths[i]) == "CMakeLists.txt" then
+        cmake_roots[path.dirname(paths[i])] = true
+      end
+    end
+
+    for root in pairs(cmake_roots) do
+      -- Nested CMakeLists.txt files are added to the build of their parent with
+      -- add_subdirectory and can't be configured on their own, so we only index
+      -- the outermost projects.
+      local is_nested = false
+      if root ~= "" then
+        local ancestors = path.ancestors(root)
+        for i = 1, #ancestors do
+          if cmake_roots[ancestors[i]] then
+            is_nested = true
+            break
+          end
+        end
+      end
+
+      -- The Bazel job already covers the whole repository.
+      if not is_nested and not (is_bazel and root == "") then
+        table.insert(jobs, cmake_job(root))
+      end
+    end
+
+    return jobs
+  end,
+}
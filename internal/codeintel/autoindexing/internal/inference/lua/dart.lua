@@ -0,0 +1,50 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- There is no default indexer until its image is pinned, so jobs are only
+-- generated if site admins configure one.
+local indexer = require("sg.autoindex.indexes").find "dart"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine {
+  shared.exclude_paths,
+  pattern.new_path_segment ".dart_tool",
+}
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "pubspec.yaml",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when pubspec.yaml files exist
+  generate = function(_, paths)
+    if not indexer then
+      return {}
+    end
+
+    local jobs = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            commands = { "dart pub get" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "dart", "pub", "global", "run", "scip_dart", "./" },
+        outfile = outfile,
+      })
+    end
+
+    return jobs
+  end,
+}
@@ -0,0 +1,84 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- There is no default indexer until its image is pinned, so jobs are only
+-- generated if site admins configure one.
+local indexer = require("sg.autoindex.indexes").find "dotnet"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine {
+  shared.exclude_paths,
+  pattern.new_path_segment "bin",
+  pattern.new_path_segment "obj",
+}
+
+local is_solution = function(filepath)
+  return string.sub(filepath, -4) == ".sln"
+end
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_extension "sln",
+    pattern.new_path_extension "csproj",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  generate = function(_, paths)
+    if not indexer then
+      return {}
+    end
+
+    local jobs = {}
+
+    -- Solutions reference the projects below them, so we index every solution
+    -- on its own and only index projects that aren't covered by a solution.
+    local solution_roots = {}
+    for i = 1, #paths do
+      if is_solution(paths[i]) then
+        local root = path.dirname(paths[i])
+        solution_roots[root] = true
+
+        table.insert(jobs, {
+          steps = {},
+          root = root,
+          indexer = indexer,
+          indexer_args = { "scip-dotnet", "index", path.basename(paths[i]) },
+          outfile = outfile,
+        })
+      end
+    end
+
+    local project_roots = {}
+    for i = 1, #paths do
+      if not is_solution(paths[i]) then
+        local root = path.dirname(paths[i])
+        local ancestors = path.ancestors(paths[i])
+
+        local is_covered = false
+        for j = 1, #ancestors do
+          if solution_roots[ancestors[j]] then
+            is_covered = true
+            break
+          end
+        end
+
+        if not is_covered and not project_roots[root] then
+          project_roots[root] = true
+
+          table.insert(jobs, {
+            steps = {},
+            root = root,
+            indexer = indexer,
+            indexer_args = { "scip-dotnet", "index" },
+            outfile = outfile,
+          })
+        end
+      end
+    end
+
+    return jobs
+  end,
+}
@@ -0,0 +1,51 @@
+local path = require "path"
+local pattern = require "sg.autoindex.patterns"
+local recognizer = require "sg.autoindex.recognizer"
+
+local shared = require "sg.autoindex.shared"
+
+-- There is no default indexer until its image is pinned, so jobs are only
+-- generated if site admins configure one.
+local indexer = require("sg.autoindex.indexes").find "php"
+local outfile = "index.scip"
+
+local exclude_paths = pattern.new_path_combine {
+  shared.exclude_paths,
+  pattern.new_path_segment "vendor",
+}
+
+return recognizer.new_path_recognizer {
+  patterns = {
+    pattern.new_path_basename "composer.json",
+    pattern.new_path_exclude(exclude_paths),
+  },
+
+  -- Invoked when composer.json files exist
+  generate = function(_, paths)
+    if not indexer then
+      return {}
+    end
+
+    local jobs = {}
+    for i = 1, #paths do
+      local root = path.dirname(paths[i])
+
+      table.insert(jobs, {
+        steps = {
+          {
+            root = root,
+            image = indexer,
+            -- scip-php reads the autoloader that composer generates.
+            commands = { "composer install --no-interaction --no-scripts" },
+          },
+        },
+        root = root,
+        indexer = indexer,
+        indexer_args = { "scip-php" },
+        outfile = outfile,
+      })
+    end
+
+    return jobs
+  end,
+}
@@ -1,8 +1,12 @@
 local config = require("sg.autoindex.config").new {}
 
 for _, name in ipairs {
+  "clang",
+  "dart",
+  "dotnet",
   "go",
   "java",
+  "php",
   "python",
   "ruby",
   "rust",
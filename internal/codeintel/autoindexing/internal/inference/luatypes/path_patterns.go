@@ -54,16 +54,20 @@ func FlattenPatterns(pathPatterns []*PathPattern, inverted bool) (patterns []Glo
 }
 
 // FlattenPattern returns the set of patterns matching the given inverted flag on this
-// path pattern or any of its descendants.
-func FlattenPattern(pathPattern *PathPattern, inverted bool) (patterns []GlobAndPathspecPattern) {
-	if pathPattern.invert == inverted {
-		if pathPattern.pattern.Glob != "" {
-			patterns = append(patterns, pathPattern.pattern)
-		}
+// path pattern or any of its descendants. Patterns nested under an exclude pattern are
+// inverted, including those grouped by a combined pattern within it.
+func FlattenPattern(pathPattern *PathPattern, inverted bool) []GlobAndPathspecPattern {
+	return flattenPattern(pathPattern, false, inverted)
+}
+
+func flattenPattern(pathPattern *PathPattern, parentInverted, inverted bool) (patterns []GlobAndPathspecPattern) {
+	effectiveInverted := parentInverted != pathPattern.invert
+	if effectiveInverted == inverted && pathPattern.pattern.Glob != "" {
+		patterns = append(patterns, pathPattern.pattern)
+	}
 
-		for _, child := range pathPattern.children {
-			patterns = append(patterns, FlattenPattern(child, inverted)...)
-		}
+	for _, child := range pathPattern.children {
+		patterns = append(patterns, flattenPattern(child, effectiveInverted, inverted)...)
 	}
 
 	return
@@ -15,7 +15,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"gopkg.in/yaml.v3"
 
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestMain(m *testing.M) {
@@ -128,6 +130,15 @@ type generatorTestCase struct {
 	repositoryContents map[string]string
 }
 
+// mockIndexerMap configures the images of indexers that have no default, like
+// site admins do with the codeIntelAutoIndexing.indexerMap setting.
+func mockIndexerMap(t *testing.T, indexerMap map[string]string) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		CodeIntelAutoIndexingIndexerMap: indexerMap,
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+}
+
 func testGenerators(t *testing.T, testCases ...generatorTestCase) {
 	for _, testCase := range testCases {
 		testGenerator(t, testCase)
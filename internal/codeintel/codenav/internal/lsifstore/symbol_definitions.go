@@ -0,0 +1,139 @@
+package lsifstore
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// GetSymbolDefinitions returns the non-local symbols defined within the given uploads, along
+// with the location of each of their definitions. The uploads are given as a map from their
+// identifier to their root, which is used to match paths against opts.Paths. Returned paths
+// are relative to the upload's root.
+func (s *store) GetSymbolDefinitions(ctx context.Context, roots map[int]string, opts shared.SymbolDefinitionsOptions) (_ []shared.SymbolDefinition, err error) {
+	uploadIDs := make([]int, 0, len(roots))
+	for id := range roots {
+		uploadIDs = append(uploadIDs, id)
+	}
+	sort.Ints(uploadIDs)
+
+	ctx, trace, endObservation := s.operations.getSymbolDefinitions.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("numUploadIDs", len(uploadIDs)),
+		attribute.IntSlice("uploadIDs", uploadIDs),
+		attribute.String("name", opts.Name),
+		attribute.StringSlice("paths", opts.Paths),
+		attribute.Int("limit", opts.Limit),
+		attribute.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(uploadIDs) == 0 {
+		return nil, nil
+	}
+
+	uploads := make([]*sqlf.Query, 0, len(uploadIDs))
+	for _, id := range uploadIDs {
+		uploads = append(uploads, sqlf.Sprintf("(%s::integer, %s::text)", id, roots[id]))
+	}
+	pathConds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	for _, path := range opts.Paths {
+		pathConds = append(pathConds, sqlf.Sprintf("strpos(lower(u.root || sid.document_path), %s) > 0", strings.ToLower(path)))
+	}
+	var limit any
+	if opts.Limit > 0 {
+		limit = opts.Limit
+	}
+
+	locationData, err := s.scanQualifiedMonikerLocations(s.db.Query(ctx, sqlf.Sprintf(
+		symbolDefinitionsQuery,
+		sqlf.Join(uploads, ", "),
+		sqlf.Join(pathConds, " AND "),
+		strings.ToLower(opts.Name),
+		limit,
+		opts.Offset,
+	)))
+	if err != nil {
+		return nil, err
+	}
+
+	var definitions []shared.SymbolDefinition
+	for _, monikerLocations := range locationData {
+		for _, row := range monikerLocations.Locations {
+			definitions = append(definitions, shared.SymbolDefinition{
+				Symbol: monikerLocations.Identifier,
+				Location: shared.Location{
+					DumpID: monikerLocations.DumpID,
+					Path:   row.URI,
+					Range:  newRange(row.StartLine, row.StartCharacter, row.EndLine, row.EndCharacter),
+				},
+			})
+		}
+	}
+	trace.AddEvent("TODO Domain Owner", attribute.Int("numDefinitions", len(definitions)))
+
+	return definitions, nil
+}
+
+const symbolDefinitionsQuery = `
+WITH RECURSIVE
+uploads(upload_id, root) AS (
+	VALUES %s
+),
+defined_symbols AS (
+	SELECT
+		ss.upload_id,
+		ss.symbol_id,
+		ss.definition_ranges,
+		sid.document_path
+	FROM uploads u
+	JOIN codeintel_scip_symbols ss ON ss.upload_id = u.upload_id
+	JOIN codeintel_scip_document_lookup sid ON sid.id = ss.document_lookup_id
+	WHERE
+		ss.definition_ranges IS NOT NULL AND
+		%s
+),
+-- Reconstruct the names of the defined symbols by walking their tries from the
+-- leaves up to the roots, prepending the name segments along the way. We only
+-- visit the trie paths of defined symbols, not every symbol of the uploads.
+symbol_names(upload_id, id, prefix_id, symbol_name) AS (
+	(
+		SELECT
+			ssn.upload_id,
+			ssn.id,
+			ssn.prefix_id,
+			ssn.name_segment
+		FROM codeintel_scip_symbol_names ssn
+		WHERE (ssn.upload_id, ssn.id) IN (SELECT ds.upload_id, ds.symbol_id FROM defined_symbols ds)
+	) UNION (
+		SELECT
+			sn.upload_id,
+			sn.id,
+			ssn.prefix_id,
+			ssn.name_segment || sn.symbol_name
+		FROM symbol_names sn
+		JOIN codeintel_scip_symbol_names ssn ON
+			ssn.upload_id = sn.upload_id AND
+			ssn.id = sn.prefix_id
+	)
+)
+SELECT
+	ds.upload_id,
+	'' AS scheme,
+	sn.symbol_name,
+	ds.definition_ranges,
+	ds.document_path
+FROM defined_symbols ds
+JOIN symbol_names sn ON sn.upload_id = ds.upload_id AND sn.id = ds.symbol_id
+WHERE
+	sn.prefix_id IS NULL AND
+	sn.symbol_name NOT LIKE 'local %%' AND
+	strpos(lower(sn.symbol_name), %s) > 0
+ORDER BY ds.upload_id, ds.document_path, sn.symbol_name
+LIMIT %s OFFSET %s
+`
@@ -0,0 +1,99 @@
+package lsifstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+)
+
+func TestGetSymbolDefinitions(t *testing.T) {
+	store := populateTestStore(t)
+	roots := map[int]string{testSCIPUploadID: ""}
+
+	// `export function nonEmpty<T>(value: T | T[] | null | undefined): value is T | T[] {`
+	//                  ^^^^^^^^
+	symbol := "scip-typescript npm template 0.0.0-DEVELOPMENT src/util/`helpers.ts`/nonEmpty()."
+	expectedLocations := []shared.Location{
+		{DumpID: testSCIPUploadID, Path: "template/src/util/helpers.ts", Range: newRange(15, 16, 15, 24)},
+	}
+
+	locationsOf := func(definitions []shared.SymbolDefinition) (locations []shared.Location) {
+		for _, definition := range definitions {
+			if definition.Symbol == symbol {
+				locations = append(locations, definition.Location)
+			}
+		}
+		return locations
+	}
+
+	definitions, err := store.GetSymbolDefinitions(context.Background(), roots, shared.SymbolDefinitionsOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error querying symbol definitions: %s", err)
+	}
+	if diff := cmp.Diff(expectedLocations, locationsOf(definitions)); diff != "" {
+		t.Errorf("unexpected locations (-want +got):\n%s", diff)
+	}
+	for _, definition := range definitions {
+		if strings.HasPrefix(definition.Symbol, "local ") {
+			t.Errorf("unexpected local symbol %q", definition.Symbol)
+		}
+	}
+
+	t.Run("filters", func(t *testing.T) {
+		opts := shared.SymbolDefinitionsOptions{Name: "NONEMPTY", Paths: []string{"util/", "helpers.ts"}}
+		filtered, err := store.GetSymbolDefinitions(context.Background(), roots, opts)
+		if err != nil {
+			t.Fatalf("unexpected error querying symbol definitions: %s", err)
+		}
+		if diff := cmp.Diff(expectedLocations, locationsOf(filtered)); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+		if len(filtered) >= len(definitions) {
+			t.Errorf("expected fewer definitions than the %d unfiltered ones, have %d", len(definitions), len(filtered))
+		}
+
+		// Paths are matched against the path relative to the repository root
+		opts = shared.SymbolDefinitionsOptions{Name: "nonEmpty", Paths: []string{"root/template/"}}
+		filtered, err = store.GetSymbolDefinitions(context.Background(), map[int]string{testSCIPUploadID: "root/"}, opts)
+		if err != nil {
+			t.Fatalf("unexpected error querying symbol definitions: %s", err)
+		}
+		if diff := cmp.Diff(expectedLocations, locationsOf(filtered)); diff != "" {
+			t.Errorf("unexpected locations (-want +got):\n%s", diff)
+		}
+
+		opts = shared.SymbolDefinitionsOptions{Name: "nonEmpty", Paths: []string{"missing"}}
+		if filtered, err := store.GetSymbolDefinitions(context.Background(), roots, opts); err != nil {
+			t.Fatalf("unexpected error querying symbol definitions: %s", err)
+		} else if len(filtered) != 0 {
+			t.Errorf("unexpected definitions: %v", filtered)
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		var paged []shared.SymbolDefinition
+		for offset := 0; ; offset += 2 {
+			page, err := store.GetSymbolDefinitions(context.Background(), roots, shared.SymbolDefinitionsOptions{Limit: 2, Offset: offset})
+			if err != nil {
+				t.Fatalf("unexpected error querying symbol definitions: %s", err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			paged = append(paged, page...)
+		}
+		if diff := cmp.Diff(definitions, paged); diff != "" {
+			t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+		}
+	})
+
+	if definitions, err := store.GetSymbolDefinitions(context.Background(), nil, shared.SymbolDefinitionsOptions{}); err != nil {
+		t.Fatalf("unexpected error querying symbol definitions: %s", err)
+	} else if len(definitions) != 0 {
+		t.Errorf("unexpected definitions for no uploads: %v", definitions)
+	}
+}
@@ -23,6 +23,7 @@ type operations struct {
 	getClosestDumpsForBlob *observation.Operation
 	snapshotForDocument    *observation.Operation
 	visibleUploadsForPath  *observation.Operation
+	getSymbolDefinitions   *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -57,6 +58,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		getClosestDumpsForBlob: op("GetClosestDumpsForBlob"),
 		snapshotForDocument:    op("SnapshotForDocument"),
 		visibleUploadsForPath:  op("VisibleUploadsForPath"),
+		getSymbolDefinitions:   op("GetSymbolDefinitions"),
 	}
 }
 
@@ -1102,3 +1102,48 @@ func (s *Service) SnapshotForDocument(ctx context.Context, repositoryID int, com
 
 	return
 }
+
+// GetSymbolDefinitions returns the symbols defined by the precise indexes uploaded for the given
+// commit which satisfy opts, with paths relative to the repository root. Indexes of other commits
+// are ignored even if they're visible from the given commit, as their ranges may not line up with
+// the files of the given commit. A false-valued flag is returned if the commit has no precise index.
+func (s *Service) GetSymbolDefinitions(ctx context.Context, repositoryID int, commit string, opts shared.SymbolDefinitionsOptions) (_ []shared.SymbolDefinition, _ bool, err error) {
+	ctx, trace, endObservation := s.operations.getSymbolDefinitions.With(ctx, &err, observation.Args{Attrs: []attribute.KeyValue{
+		attribute.Int("repositoryID", repositoryID),
+		attribute.String("commit", commit),
+		attribute.Int("limit", opts.Limit),
+		attribute.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	candidates, err := s.uploadSvc.InferClosestUploads(ctx, repositoryID, commit, "", false, "")
+	if err != nil {
+		return nil, false, err
+	}
+
+	roots := make(map[int]string, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Commit != commit {
+			continue
+		}
+
+		roots[candidate.ID] = candidate.Root
+	}
+	trace.AddEvent("TODO Domain Owner",
+		attribute.Int("numCandidates", len(candidates)),
+		attribute.Int("numUploads", len(roots)))
+
+	if len(roots) == 0 {
+		return nil, false, nil
+	}
+
+	definitions, err := s.lsifstore.GetSymbolDefinitions(ctx, roots, opts)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "lsifstore.GetSymbolDefinitions")
+	}
+	for i := range definitions {
+		definitions[i].Path = roots[definitions[i].DumpID] + definitions[i].Path
+	}
+
+	return definitions, true, nil
+}
@@ -0,0 +1,69 @@
+package codenav
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/codenav/shared"
+	uploadsshared "github.com/sourcegraph/sourcegraph/internal/codeintel/uploads/shared"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestGetSymbolDefinitions(t *testing.T) {
+	// Set up mocks
+	mockRepoStore := defaultMockRepoStore()
+	mockLsifStore := NewMockLsifStore()
+	mockUploadSvc := NewMockUploadService()
+	mockGitserverClient := gitserver.NewMockClient()
+
+	// Init service
+	svc := newService(&observation.TestContext, mockRepoStore, mockLsifStore, mockUploadSvc, mockGitserverClient)
+
+	mockUploadSvc.InferClosestUploadsFunc.SetDefaultReturn([]uploadsshared.Dump{
+		{ID: 50, Commit: "deadbeef", Root: ""},
+		{ID: 51, Commit: "deadbeef", Root: "sub/"},
+		{ID: 52, Commit: "cafebabe", Root: "other/"},
+	}, nil)
+	mockLsifStore.GetSymbolDefinitionsFunc.SetDefaultReturn([]shared.SymbolDefinition{
+		{Symbol: "scip-go gomod example.com/a v1 `example.com/a`/A#", Location: shared.Location{DumpID: 50, Path: "a.go", Range: testRange1}},
+		{Symbol: "scip-go gomod example.com/b v1 `example.com/b`/B().", Location: shared.Location{DumpID: 51, Path: "b.go", Range: testRange2}},
+	}, nil)
+
+	opts := shared.SymbolDefinitionsOptions{Name: "b", Paths: []string{".go"}, Limit: 10}
+	definitions, ok, err := svc.GetSymbolDefinitions(context.Background(), 42, "deadbeef", opts)
+	if err != nil {
+		t.Fatalf("unexpected error getting symbol definitions: %s", err)
+	}
+	if !ok {
+		t.Fatalf("expected precise data")
+	}
+
+	expectedDefinitions := []shared.SymbolDefinition{
+		{Symbol: "scip-go gomod example.com/a v1 `example.com/a`/A#", Location: shared.Location{DumpID: 50, Path: "a.go", Range: testRange1}},
+		{Symbol: "scip-go gomod example.com/b v1 `example.com/b`/B().", Location: shared.Location{DumpID: 51, Path: "sub/b.go", Range: testRange2}},
+	}
+	if diff := cmp.Diff(expectedDefinitions, definitions); diff != "" {
+		t.Errorf("unexpected definitions (-want +got):\n%s", diff)
+	}
+
+	if history := mockLsifStore.GetSymbolDefinitionsFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected call count. want=%d have=%d", 1, len(history))
+	} else {
+		if diff := cmp.Diff(map[int]string{50: "", 51: "sub/"}, history[0].Arg1); diff != "" {
+			t.Errorf("unexpected upload roots (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(opts, history[0].Arg2); diff != "" {
+			t.Errorf("unexpected options (-want +got):\n%s", diff)
+		}
+	}
+
+	// Uploads of other commits don't count as precise data
+	if _, ok, err := svc.GetSymbolDefinitions(context.Background(), 42, "c0ffee", opts); err != nil {
+		t.Fatalf("unexpected error getting symbol definitions: %s", err)
+	} else if ok {
+		t.Errorf("expected no precise data")
+	}
+}
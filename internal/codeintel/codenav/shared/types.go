@@ -12,6 +12,28 @@ type Location struct {
 	Range  Range
 }
 
+// SymbolDefinition is the location of the definition of a precise symbol
+// within a dump.
+type SymbolDefinition struct {
+	Symbol string
+	Location
+}
+
+// SymbolDefinitionsOptions narrows the symbol definitions read for a commit.
+// Name and Paths are matched as case-insensitive substrings, so callers with
+// more specific filters are expected to filter the definitions further.
+type SymbolDefinitionsOptions struct {
+	// Name must occur in the symbol name.
+	Name string
+
+	// Paths must each occur in the path of the definition, relative to the
+	// root of the repository.
+	Paths []string
+
+	Limit  int
+	Offset int
+}
+
 // Diagnostic describes diagnostic information attached to a location within a
 // particular dump.
 type Diagnostic struct {
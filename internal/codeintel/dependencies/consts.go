@@ -9,4 +9,8 @@ const (
 	PythonPackagesScheme = shared.PythonPackagesScheme
 	RustPackagesScheme   = shared.RustPackagesScheme
 	RubyPackagesScheme   = shared.RubyPackagesScheme
+	DotnetPackagesScheme = shared.DotnetPackagesScheme
+	PhpPackagesScheme    = shared.PhpPackagesScheme
+	ElixirPackagesScheme = shared.ElixirPackagesScheme
+	DartPackagesScheme   = shared.DartPackagesScheme
 )
@@ -7,4 +7,8 @@ const (
 	PythonPackagesScheme = "python"
 	RustPackagesScheme   = "rust-analyzer"
 	RubyPackagesScheme   = "scip-ruby"
+	DotnetPackagesScheme = "scip-dotnet"
+	PhpPackagesScheme    = "scip-php"
+	ElixirPackagesScheme = "hex"
+	DartPackagesScheme   = "scip-dart"
 )
@@ -3,6 +3,7 @@ package background
 import (
 	"net/url"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -18,4 +19,26 @@ type actionArgs struct {
 	Query          string
 	Results        []*result.CommitMatch
 	IncludeResults bool
+
+	// ContentChanges are set instead of Results for monitors with a content
+	// search query.
+	ContentChanges []database.ContentChange
+}
+
+// truncateContentChanges returns at most maxChanges of changes, as well as the
+// total count and the number of changes which were cut off.
+func truncateContentChanges(changes []database.ContentChange, maxChanges int) (_ []database.ContentChange, totalCount, truncatedCount int) {
+	totalCount = len(changes)
+	if totalCount > maxChanges {
+		changes = changes[:maxChanges]
+	}
+	return changes, totalCount, totalCount - len(changes)
+}
+
+// contentChangeType returns the label we show for change, e.g. "Added".
+func contentChangeType(change database.ContentChange) string {
+	if change.Change == database.ContentChangeRemoved {
+		return "Removed"
+	}
+	return "Added"
 }
@@ -0,0 +1,285 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func sendChatOpsNotification(ctx context.Context, provider database.ChatOpsProvider, url string, args actionArgs) error {
+	payload, err := chatOpsPayload(provider, newChatOpsMessage(args))
+	if err != nil {
+		return err
+	}
+	return postChatOpsWebhook(ctx, httpcli.ExternalDoer, url, payload)
+}
+
+// chatOpsPayload returns the body of the request we post to the incoming
+// webhook of provider. Plain webhooks can't render our notifications in these
+// tools, so every provider gets its own message format.
+func chatOpsPayload(provider database.ChatOpsProvider, msg chatOpsMessage) (any, error) {
+	switch provider {
+	case database.ChatOpsProviderTeams:
+		return teamsPayload(msg), nil
+	case database.ChatOpsProviderMattermost:
+		return mattermostPayload(msg), nil
+	default:
+		return nil, errors.Errorf("unknown chat-ops provider %q", provider)
+	}
+}
+
+// chatOpsMessage is the content of a notification, independent of the chat tool
+// it is sent to. Text fields are Markdown, which all providers render.
+type chatOpsMessage struct {
+	Header  string
+	Matches []chatOpsMatch
+	// Footer links to the search results, either because results aren't
+	// included or because some of them were cut off.
+	Footer  string
+	EditURL string
+}
+
+type chatOpsMatch struct {
+	Title   string
+	URL     string
+	Content string
+}
+
+func newChatOpsMessage(args actionArgs) chatOpsMessage {
+	msg := chatOpsMessage{
+		EditURL: getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+	}
+	searchURL := getSearchURL(args.ExternalURL, args.Query, args.UTMSource)
+
+	var totalCount, truncatedCount int
+	if len(args.ContentChanges) > 0 {
+		var truncatedChanges []database.ContentChange
+		truncatedChanges, totalCount, truncatedCount = truncateContentChanges(args.ContentChanges, 5)
+
+		added := 0
+		for _, change := range args.ContentChanges {
+			if change.Change == database.ContentChangeAdded {
+				added++
+			}
+		}
+		msg.Header = fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, **%s**, detected **%d** added and **%d** removed matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			added,
+			totalCount-added,
+		)
+
+		for _, change := range truncatedChanges {
+			msg.Matches = append(msg.Matches, chatOpsMatch{
+				Title:   fmt.Sprintf("%s match: %s/%s", contentChangeType(change), change.RepoName, change.Path),
+				URL:     getFileURL(args.ExternalURL, string(change.RepoName), change.Path, args.UTMSource),
+				Content: change.Line,
+			})
+		}
+	} else {
+		truncatedResults, total, truncated := truncateResults(args.Results, 5)
+		totalCount, truncatedCount = total, truncated
+		msg.Header = fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, **%s**, detected **%d** new matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			totalCount,
+		)
+
+		for _, result := range truncatedResults {
+			resultType := "Message"
+			if result.DiffPreview != nil {
+				resultType = "Diff"
+			}
+			msg.Matches = append(msg.Matches, chatOpsMatch{
+				Title:   fmt.Sprintf("%s match: %s@%s", resultType, result.Repo.Name, result.Commit.ID.Short()),
+				URL:     getCommitURL(args.ExternalURL, string(result.Repo.Name), string(result.Commit.ID), args.UTMSource),
+				Content: truncateMatchContent(result),
+			})
+		}
+	}
+
+	if !args.IncludeResults {
+		msg.Matches = nil
+		msg.Footer = fmt.Sprintf("[View results](%s)", searchURL)
+	} else if truncatedCount > 0 {
+		msg.Footer = fmt.Sprintf("...and [%d more matches](%s).", truncatedCount, searchURL)
+	}
+	return msg
+}
+
+// teamsMessage is a Microsoft Teams message with an Adaptive Card attachment.
+// See https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/connectors-using.
+type teamsMessage struct {
+	Type        string            `json:"type"`
+	Attachments []teamsAttachment `json:"attachments"`
+}
+
+type teamsAttachment struct {
+	ContentType string        `json:"contentType"`
+	Content     teamsCardBody `json:"content"`
+}
+
+type teamsCardBody struct {
+	Schema  string             `json:"$schema"`
+	Type    string             `json:"type"`
+	Version string             `json:"version"`
+	Body    []teamsTextBlock   `json:"body"`
+	Actions []teamsOpenURLItem `json:"actions,omitempty"`
+}
+
+type teamsTextBlock struct {
+	Type     string `json:"type"`
+	Text     string `json:"text"`
+	Wrap     bool   `json:"wrap"`
+	FontType string `json:"fontType,omitempty"`
+	Spacing  string `json:"spacing,omitempty"`
+}
+
+type teamsOpenURLItem struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func newTeamsMessage(body []teamsTextBlock, actions []teamsOpenURLItem) *teamsMessage {
+	return &teamsMessage{
+		Type: "message",
+		Attachments: []teamsAttachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content: teamsCardBody{
+				Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+				Type:    "AdaptiveCard",
+				Version: "1.4",
+				Body:    body,
+				Actions: actions,
+			},
+		}},
+	}
+}
+
+func teamsPayload(msg chatOpsMessage) *teamsMessage {
+	newTextBlock := func(s string) teamsTextBlock {
+		return teamsTextBlock{Type: "TextBlock", Text: s, Wrap: true}
+	}
+
+	body := []teamsTextBlock{newTextBlock(msg.Header)}
+	for _, match := range msg.Matches {
+		title := newTextBlock(fmt.Sprintf("[%s](%s)", match.Title, match.URL))
+		title.Spacing = "Large"
+		body = append(body, title)
+		if match.Content != "" {
+			code := newTextBlock(match.Content)
+			code.FontType = "Monospace"
+			body = append(body, code)
+		}
+	}
+	if msg.Footer != "" {
+		body = append(body, newTextBlock(msg.Footer))
+	}
+
+	return newTeamsMessage(body, []teamsOpenURLItem{{
+		Type:  "Action.OpenUrl",
+		Title: "Edit code monitor",
+		URL:   msg.EditURL,
+	}})
+}
+
+// mattermostMessage is a Mattermost incoming webhook message.
+// See https://developers.mattermost.com/integrate/webhooks/incoming/.
+type mattermostMessage struct {
+	Text        string                 `json:"text"`
+	Attachments []mattermostAttachment `json:"attachments,omitempty"`
+}
+
+type mattermostAttachment struct {
+	Fallback  string `json:"fallback"`
+	Title     string `json:"title"`
+	TitleLink string `json:"title_link"`
+	Text      string `json:"text,omitempty"`
+}
+
+func mattermostPayload(msg chatOpsMessage) *mattermostMessage {
+	text := msg.Header
+	if msg.Footer != "" {
+		text += "\n" + msg.Footer
+	}
+	text += fmt.Sprintf("\n[Edit code monitor](%s)", msg.EditURL)
+
+	p := &mattermostMessage{Text: text}
+	for _, match := range msg.Matches {
+		attachment := mattermostAttachment{
+			Fallback:  match.Title,
+			Title:     match.Title,
+			TitleLink: match.URL,
+		}
+		if match.Content != "" {
+			attachment.Text = formatMattermostCodeBlock(match.Content)
+		}
+		p.Attachments = append(p.Attachments, attachment)
+	}
+	return p
+}
+
+func formatMattermostCodeBlock(s string) string {
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	return fmt.Sprintf("```\n%s```", strings.ReplaceAll(s, "```", "\\`\\`\\`"))
+}
+
+// adapted from postSlackWebhook
+func postChatOpsWebhook(ctx context.Context, doer httpcli.Doer, url string, payload any) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+func SendTestChatOpsWebhook(ctx context.Context, doer httpcli.Doer, provider database.ChatOpsProvider, description, url string) error {
+	text := fmt.Sprintf("Test message for Code Monitor '%s'", description)
+
+	var payload any
+	switch provider {
+	case database.ChatOpsProviderTeams:
+		payload = newTeamsMessage([]teamsTextBlock{{Type: "TextBlock", Text: text, Wrap: true}}, nil)
+	case database.ChatOpsProviderMattermost:
+		payload = &mattermostMessage{Text: text}
+	default:
+		return errors.Errorf("unknown chat-ops provider %q", provider)
+	}
+
+	return postChatOpsWebhook(ctx, doer, url, payload)
+}
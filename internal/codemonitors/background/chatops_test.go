@@ -0,0 +1,117 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestChatOpsWebhook(t *testing.T) {
+	t.Parallel()
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	action := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     false,
+	}
+
+	t.Run("teams without results", func(t *testing.T) {
+		p := teamsPayload(newChatOpsMessage(action))
+		require.Len(t, p.Attachments, 1)
+		card := p.Attachments[0].Content
+		require.Equal(t, "AdaptiveCard", card.Type)
+		require.Len(t, card.Body, 2)
+		require.Equal(t, "Camden Cheek's Sourcegraph Code monitor, **My test monitor**, detected **3** new matches.", card.Body[0].Text)
+		require.Contains(t, card.Body[1].Text, "[View results](https://sourcegraph.com/search?")
+		require.Len(t, card.Actions, 1)
+	})
+
+	t.Run("teams with results", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		p := teamsPayload(newChatOpsMessage(actionCopy))
+		body := p.Attachments[0].Content.Body
+		// The header, and a title and a code block per result.
+		require.Len(t, body, 5)
+		require.Contains(t, body[1].Text, "[Diff match: github.com/test/test@")
+		require.Equal(t, "Monospace", body[2].FontType)
+	})
+
+	t.Run("mattermost with truncated results", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		// quadruple the number of results
+		actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+		actionCopy.Results = append(actionCopy.Results, actionCopy.Results...)
+		p := mattermostPayload(newChatOpsMessage(actionCopy))
+		require.Len(t, p.Attachments, 3)
+		require.Contains(t, p.Text, "...and [7 more matches](")
+		require.Contains(t, p.Attachments[0].Text, "```\n")
+	})
+
+	t.Run("mattermost with content changes", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		actionCopy.Results = nil
+		actionCopy.ContentChanges = []database.ContentChange{{
+			ContentMatch: database.ContentMatch{RepoID: 1, RepoName: "github.com/test/test", Path: "main.go", Line: "foo()"},
+			Change:       database.ContentChangeAdded,
+		}}
+		p := mattermostPayload(newChatOpsMessage(actionCopy))
+		require.Contains(t, p.Text, "detected **1** added and **0** removed matches.")
+		require.Len(t, p.Attachments, 1)
+		require.Equal(t, "Added match: github.com/test/test/main.go", p.Attachments[0].Title)
+		require.Equal(t, "https://sourcegraph.com/github.com/test/test/-/blob/main.go?utm_source=", p.Attachments[0].TitleLink)
+		require.Equal(t, "```\nfoo()\n```", p.Attachments[0].Text)
+	})
+
+	t.Run("unknown provider", func(t *testing.T) {
+		_, err := chatOpsPayload("irc", newChatOpsMessage(action))
+		require.Error(t, err)
+	})
+
+	t.Run("error is returned", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		}))
+		defer s.Close()
+
+		client := s.Client()
+		err := postChatOpsWebhook(context.Background(), client, s.URL, mattermostPayload(newChatOpsMessage(action)))
+		require.Error(t, err)
+	})
+}
+
+func TestTriggerTestChatOpsWebhookAction(t *testing.T) {
+	for _, provider := range []database.ChatOpsProvider{database.ChatOpsProviderTeams, database.ChatOpsProviderMattermost} {
+		t.Run(string(provider), func(t *testing.T) {
+			var got map[string]any
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.NoError(t, json.Unmarshal(b, &got))
+				w.WriteHeader(200)
+			}))
+			defer s.Close()
+
+			client := s.Client()
+			err := SendTestChatOpsWebhook(context.Background(), client, provider, "My test monitor", s.URL)
+			require.NoError(t, err)
+			require.NotEmpty(t, got)
+		})
+	}
+}
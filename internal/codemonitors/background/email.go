@@ -75,11 +75,24 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 		priority = ""
 	}
 
-	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
-
-	displayResults := make([]*DisplayResult, len(truncatedResults))
-	for i, result := range truncatedResults {
-		displayResults[i] = toDisplayResult(result, args.ExternalURL)
+	var (
+		displayResults             []*DisplayResult
+		totalCount, truncatedCount int
+		resultWord                 = "result"
+	)
+	if len(args.ContentChanges) > 0 {
+		var truncatedChanges []database.ContentChange
+		truncatedChanges, totalCount, truncatedCount = truncateContentChanges(args.ContentChanges, 5)
+		for _, change := range truncatedChanges {
+			displayResults = append(displayResults, toContentDisplayResult(change, args.ExternalURL))
+		}
+		resultWord = "change"
+	} else {
+		var truncatedResults []*searchresult.CommitMatch
+		truncatedResults, totalCount, truncatedCount = truncateResults(args.Results, 5)
+		for _, result := range truncatedResults {
+			displayResults = append(displayResults, toDisplayResult(result, args.ExternalURL))
+		}
 	}
 
 	return &TemplateDataNewSearchResults{
@@ -91,8 +104,8 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *database.EmailAc
 		TruncatedResults:          displayResults,
 		TotalCount:                totalCount,
 		TruncatedCount:            truncatedCount,
-		ResultPluralized:          pluralize("result", totalCount),
-		TruncatedResultPluralized: pluralize("result", truncatedCount),
+		ResultPluralized:          pluralize(resultWord, totalCount),
+		TruncatedResultPluralized: pluralize(resultWord, truncatedCount),
 		DisplayMoreLink:           args.IncludeResults && truncatedCount > 0,
 	}, nil
 }
@@ -152,6 +165,10 @@ func getCommitURL(externalURL *url.URL, repoName, oid, utmSource string) string
 	return sourcegraphURL(externalURL, fmt.Sprintf("%s/-/commit/%s", repoName, oid), "", utmSource)
 }
 
+func getFileURL(externalURL *url.URL, repoName, path, utmSource string) string {
+	return sourcegraphURL(externalURL, fmt.Sprintf("%s/-/blob/%s", repoName, path), "", utmSource)
+}
+
 func sourcegraphURL(externalURL *url.URL, path, query, utmSource string) string {
 	// Construct URL to the search query.
 	u := externalURL.ResolveReference(&url.URL{Path: path})
@@ -178,6 +195,11 @@ type DisplayResult struct {
 	RepoName   string
 	CommitID   string
 	Content    string
+
+	// Path and FileURL are set instead of CommitURL and CommitID for the
+	// changes of a content search query.
+	Path    string
+	FileURL string
 }
 
 func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *DisplayResult {
@@ -195,3 +217,13 @@ func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *Di
 		Content:    content,
 	}
 }
+
+func toContentDisplayResult(change database.ContentChange, externalURL *url.URL) *DisplayResult {
+	return &DisplayResult{
+		ResultType: contentChangeType(change),
+		RepoName:   string(change.RepoName),
+		Path:       change.Path,
+		FileURL:    getFileURL(externalURL, string(change.RepoName), change.Path, utmSourceEmail),
+		Content:    change.Line,
+	}
+}
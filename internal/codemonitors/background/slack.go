@@ -11,6 +11,7 @@ import (
 
 	"github.com/slack-go/slack"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	searchresult "github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -25,6 +26,10 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 		return slack.NewSectionBlock(slack.NewTextBlockObject("mrkdwn", s, false, false), nil, nil)
 	}
 
+	if len(args.ContentChanges) > 0 {
+		return slackContentPayload(args, newMarkdownSection)
+	}
+
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
 
 	blocks := []slack.Block{
@@ -77,6 +82,65 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
 }
 
+// slackContentPayload is the slackPayload for the changes of a monitor with a
+// content search query.
+func slackContentPayload(args actionArgs, newMarkdownSection func(string) slack.Block) *slack.WebhookMessage {
+	truncatedChanges, totalCount, truncatedCount := truncateContentChanges(args.ContentChanges, 5)
+
+	added := 0
+	for _, change := range args.ContentChanges {
+		if change.Change == database.ContentChangeAdded {
+			added++
+		}
+	}
+
+	blocks := []slack.Block{
+		newMarkdownSection(fmt.Sprintf(
+			"%s's Sourcegraph Code monitor, *%s*, detected *%d* added and *%d* removed matches.",
+			args.MonitorOwnerName,
+			args.MonitorDescription,
+			added,
+			totalCount-added,
+		)),
+	}
+
+	if args.IncludeResults {
+		for _, change := range truncatedChanges {
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
+				"%s match: <%s|%s/%s>",
+				contentChangeType(change),
+				getFileURL(args.ExternalURL, string(change.RepoName), change.Path, args.UTMSource),
+				change.RepoName,
+				change.Path,
+			)))
+			if change.Line != "" {
+				blocks = append(blocks, newMarkdownSection(formatCodeBlock(change.Line)))
+			}
+		}
+		if truncatedCount > 0 {
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
+				"...and <%s|%d more changes>.",
+				getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+				truncatedCount,
+			)))
+		}
+	} else {
+		blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
+			"<%s|View results>",
+			getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		)))
+	}
+
+	blocks = append(blocks,
+		newMarkdownSection(fmt.Sprintf(
+			`If you are %s, you can <%s|edit your code monitor>`,
+			args.MonitorOwnerName,
+			getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+		)),
+	)
+	return &slack.WebhookMessage{Blocks: &slack.Blocks{BlockSet: blocks}}
+}
+
 func formatCodeBlock(s string) string {
 	return fmt.Sprintf("```%s```", strings.ReplaceAll(s, "```", "\\`\\`\\`"))
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -61,6 +62,9 @@ type webhookPayload struct {
 	MonitorURL         string          `json:"monitorURL"`
 	Query              string          `json:"query"`
 	Results            []webhookResult `json:"results,omitempty"`
+	// ContentChanges is set instead of Results for monitors with a content
+	// search query.
+	ContentChanges []webhookContentChange `json:"contentChanges,omitempty"`
 }
 
 func generateWebhookPayload(args actionArgs) webhookPayload {
@@ -71,7 +75,11 @@ func generateWebhookPayload(args actionArgs) webhookPayload {
 	}
 
 	if args.IncludeResults {
-		p.Results = generateResults(args.Results)
+		if len(args.ContentChanges) > 0 {
+			p.ContentChanges = generateContentChanges(args.ContentChanges)
+		} else {
+			p.Results = generateResults(args.Results)
+		}
 	}
 
 	return p
@@ -106,6 +114,26 @@ func generateResults(in []*result.CommitMatch) []webhookResult {
 	return out
 }
 
+type webhookContentChange struct {
+	Change     string `json:"change"`
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	Line       string `json:"line,omitempty"`
+}
+
+func generateContentChanges(in []database.ContentChange) []webhookContentChange {
+	out := make([]webhookContentChange, len(in))
+	for i, change := range in {
+		out[i] = webhookContentChange{
+			Change:     change.Change,
+			Repository: string(change.RepoName),
+			Path:       change.Path,
+			Line:       change.Line,
+		}
+	}
+	return out
+}
+
 func rangesToInts(ranges result.Ranges) [][2]int {
 	out := make([][2]int, len(ranges))
 	for i, r := range ranges {
@@ -12,6 +12,7 @@ import (
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -54,6 +55,24 @@ func TestWebhook(t *testing.T) {
 		autogold.ExpectFile(t, autogold.Raw(j))
 	})
 
+	t.Run("golden with content changes", func(t *testing.T) {
+		actionCopy := action
+		actionCopy.IncludeResults = true
+		actionCopy.Results = nil
+		actionCopy.ContentChanges = []database.ContentChange{{
+			ContentMatch: database.ContentMatch{RepoID: 1, RepoName: "github.com/test/test", Path: "main.go", Line: "foo()"},
+			Change:       database.ContentChangeAdded,
+		}, {
+			ContentMatch: database.ContentMatch{RepoID: 1, RepoName: "github.com/test/test", Path: "README.md"},
+			Change:       database.ContentChangeRemoved,
+		}}
+
+		j, err := json.Marshal(generateWebhookPayload(actionCopy))
+		require.NoError(t, err)
+
+		autogold.ExpectFile(t, autogold.Raw(j))
+	})
+
 	t.Run("error is returned", func(t *testing.T) {
 		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			b, err := io.ReadAll(r.Body)
@@ -181,6 +181,10 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	ctx = actor.WithActor(ctx, actor.FromUser(m.UserID))
 	ctx = featureflag.WithFlags(ctx, r.db.FeatureFlags())
 
+	if codemonitors.IsContentQuery(q.QueryString) {
+		return r.handleContentQuery(ctx, logger, cm, q, m, triggerJob)
+	}
+
 	results, searchErr := codemonitors.Search(ctx, logger, r.db, q.QueryString, m.ID)
 
 	// Log next_run and latest_result to table cm_queries.
@@ -210,6 +214,41 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	return nil
 }
 
+// handleContentQuery runs a monitor with a content search query, which notifies
+// about the matches which were added or removed since the previous run.
+func (r *queryRunner) handleContentQuery(ctx context.Context, logger log.Logger, cm database.CodeMonitorStore, q *database.QueryTrigger, m *database.Monitor, triggerJob *database.TriggerJob) error {
+	changes, searchErr := codemonitors.SearchContent(ctx, logger, r.db, q.QueryString, m.ID)
+
+	// Log next_run and latest_result to table cm_queries.
+	newLatestResult := time.Now()
+	if (searchErr != nil || len(changes) == 0) && q.LatestResult != nil {
+		newLatestResult = *q.LatestResult
+	}
+	err := cm.SetQueryTriggerNextRun(ctx, q.ID, cm.Clock()().Add(5*time.Minute), newLatestResult.UTC())
+	if err != nil {
+		return err
+	}
+
+	// After setting the next run, check the error value
+	if searchErr != nil {
+		return errors.Wrap(searchErr, "execute search")
+	}
+
+	// Log the actual query we ran and the matches which changed.
+	err = cm.UpdateTriggerJobWithContentChanges(ctx, triggerJob.ID, q.QueryString, changes)
+	if err != nil {
+		return errors.Wrap(err, "UpdateTriggerJobWithContentChanges")
+	}
+
+	if len(changes) > 0 {
+		_, err := cm.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
+		if err != nil {
+			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+		}
+	}
+	return nil
+}
+
 type actionRunner struct {
 	database.CodeMonitorStore
 }
@@ -223,8 +262,10 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *databas
 		return errors.Wrap(r.handleWebhook(ctx, j), "Webhook")
 	case j.SlackWebhook != nil:
 		return errors.Wrap(r.handleSlackWebhook(ctx, j), "SlackWebhook")
+	case j.ChatOpsWebhook != nil:
+		return errors.Wrap(r.handleChatOpsWebhook(ctx, j), "ChatOpsWebhook")
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, or chat-ops webhook")
 	}
 }
 
@@ -263,6 +304,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *database.ActionJob) e
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     e.IncludeResults,
 	}
 
@@ -316,6 +358,7 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *database.ActionJob)
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
@@ -352,12 +395,50 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *database.Actio
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
 	return sendSlackNotification(ctx, w.URL, args)
 }
 
+func (r *actionRunner) handleChatOpsWebhook(ctx context.Context, j *database.ActionJob) error {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
+	w, err := s.GetChatOpsWebhookAction(ctx, *j.ChatOpsWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetChatOpsWebhookAction")
+	}
+
+	externalURL, err := url.Parse(conf.Get().ExternalURL)
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          w.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          fmt.Sprintf("code-monitor-%s-webhook", w.Provider),
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
+		IncludeResults:     w.IncludeResults,
+	}
+
+	return sendChatOpsNotification(ctx, w.Provider, w.URL, args)
+}
+
 type StatusCodeError struct {
 	Code   int
 	Status string
@@ -0,0 +1,189 @@
+package codemonitors
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// maxContentMatches is the maximum number of matched lines we store in the
+// snapshot of a content search query.
+const maxContentMatches = 10_000
+
+var (
+	ErrContentQueryLimitHit       = errors.New("the content search of this code monitor hit the result limit, so added and removed matches can't be detected. Add count:all to the query or make it more specific")
+	ErrContentQueryTooManyMatches = errors.Newf("the content search of this code monitor matched more than %d lines. Make the query more specific", maxContentMatches)
+)
+
+// IsContentQuery returns true if q searches file contents rather than commits
+// or diffs. Code monitors with a content search query notify about the matches
+// which were added or removed since the previous run.
+func IsContentQuery(q string) bool {
+	parsed, err := query.ParseStandard(q)
+	if err != nil {
+		// Let the commit search report the error.
+		return false
+	}
+	isContent := true
+	query.VisitField(parsed, query.FieldType, func(value string, _ bool, _ query.Annotation) {
+		if value == "commit" || value == "diff" {
+			isContent = false
+		}
+	})
+	return isContent
+}
+
+// SearchContent runs the content search query of a code monitor and returns
+// the matches which were added or removed since the previous run. The current
+// matches are stored as the snapshot for the next run. There is nothing to
+// compare against on the first run, or after the query changed, so no changes
+// are returned then.
+func SearchContent(ctx context.Context, logger log.Logger, db database.DB, q string, monitorID int64) ([]database.ContentChange, error) {
+	matches, err := searchContentMatches(ctx, logger, db, q)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := db.CodeMonitors()
+	snapshot, err := cm.GetContentSnapshot(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []database.ContentChange
+	if snapshot != nil && snapshot.Query == q {
+		changes = diffContentMatches(snapshot.Matches, matches)
+	}
+
+	if err := cm.UpsertContentSnapshot(ctx, monitorID, q, matches); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func searchContentMatches(ctx context.Context, logger log.Logger, db database.DB, q string) ([]database.ContentMatch, error) {
+	searchClient := client.New(logger, db, gitserver.NewClient("monitors.search.content"))
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		q,
+		search.Precise,
+		search.Streaming,
+	)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	agg := streaming.NewAggregatingStream()
+	if _, err := searchClient.Execute(ctx, agg, inputs); err != nil {
+		return nil, err
+	}
+
+	// A partial result set would report every match we didn't get to as
+	// removed.
+	if agg.Stats.IsLimitHit {
+		return nil, errcode.MakeNonRetryable(ErrContentQueryLimitHit)
+	}
+
+	matches := toContentMatches(agg.Results)
+	if len(matches) > maxContentMatches {
+		return nil, errcode.MakeNonRetryable(ErrContentQueryTooManyMatches)
+	}
+	return matches, nil
+}
+
+// toContentMatches returns a ContentMatch for every matched line of the file
+// matches in results, sorted by repository, path and line. Symbol matches use
+// the symbol name as the line. Other result types are ignored.
+func toContentMatches(results result.Matches) []database.ContentMatch {
+	var matches []database.ContentMatch
+	for _, res := range results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+
+		match := database.ContentMatch{
+			RepoID:   fm.Repo.ID,
+			RepoName: fm.Repo.Name,
+			Path:     fm.Path,
+		}
+		switch {
+		case len(fm.Symbols) > 0:
+			for _, sym := range fm.Symbols {
+				match.Line = sym.Symbol.Name
+				matches = append(matches, match)
+			}
+		case len(fm.ChunkMatches) > 0:
+			for _, lm := range fm.ChunkMatches.AsLineMatches() {
+				match.Line = lm.Preview
+				matches = append(matches, match)
+			}
+		default:
+			matches = append(matches, match)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.RepoName != b.RepoName {
+			return a.RepoName < b.RepoName
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Line < b.Line
+	})
+	return matches
+}
+
+// diffContentMatches returns the matches in cur which are not in prev as
+// added, followed by the matches in prev which are not in cur as removed.
+// Matches are compared by repository, path and line content. We ignore line
+// numbers so that unrelated edits above a match don't report it as changed.
+func diffContentMatches(prev, cur []database.ContentMatch) []database.ContentChange {
+	keyOf := func(m database.ContentMatch) database.ContentMatch {
+		// The repository name can change, the ID doesn't.
+		m.RepoName = ""
+		return m
+	}
+
+	// Count rather than use a set so that a line which occurs more often
+	// than before is reported.
+	prevCount := make(map[database.ContentMatch]int, len(prev))
+	for _, m := range prev {
+		prevCount[keyOf(m)]++
+	}
+
+	var changes []database.ContentChange
+	for _, m := range cur {
+		k := keyOf(m)
+		if prevCount[k] > 0 {
+			prevCount[k]--
+			continue
+		}
+		changes = append(changes, database.ContentChange{ContentMatch: m, Change: database.ContentChangeAdded})
+	}
+
+	for _, m := range prev {
+		k := keyOf(m)
+		if prevCount[k] > 0 {
+			prevCount[k]--
+			changes = append(changes, database.ContentChange{ContentMatch: m, Change: database.ContentChangeRemoved})
+		}
+	}
+
+	return changes
+}
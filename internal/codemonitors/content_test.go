@@ -0,0 +1,105 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestIsContentQuery(t *testing.T) {
+	cases := []struct {
+		query string
+		want  bool
+	}{
+		{query: "foo", want: true},
+		{query: "repo:foo type:file bar", want: true},
+		{query: "type:symbol bar", want: true},
+		{query: "type:path bar", want: true},
+		{query: "type:commit foo", want: false},
+		{query: "type:diff foo", want: false},
+		{query: "(type:diff foo) or bar", want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.query, func(t *testing.T) {
+			require.Equal(t, tc.want, IsContentQuery(tc.query))
+		})
+	}
+}
+
+func TestToContentMatches(t *testing.T) {
+	repoA := types.MinimalRepo{ID: 1, Name: "a"}
+	repoB := types.MinimalRepo{ID: 2, Name: "b"}
+
+	results := result.Matches{
+		&result.FileMatch{
+			File: result.File{Repo: repoB, Path: "main.go"},
+			ChunkMatches: result.ChunkMatches{{
+				Content:      "foo()\nbar()",
+				ContentStart: result.Location{Line: 3},
+				Ranges: result.Ranges{{
+					Start: result.Location{Line: 3, Column: 0},
+					End:   result.Location{Line: 4, Column: 3},
+				}},
+			}},
+		},
+		&result.FileMatch{
+			File:    result.File{Repo: repoA, Path: "lib.go"},
+			Symbols: []*result.SymbolMatch{{Symbol: result.Symbol{Name: "Foo"}}},
+		},
+		&result.FileMatch{
+			File: result.File{Repo: repoA, Path: "foo.go"},
+		},
+		&result.RepoMatch{Name: "c", ID: 3},
+	}
+
+	want := []database.ContentMatch{
+		{RepoID: 1, RepoName: "a", Path: "foo.go"},
+		{RepoID: 1, RepoName: "a", Path: "lib.go", Line: "Foo"},
+		{RepoID: 2, RepoName: "b", Path: "main.go", Line: "bar()"},
+		{RepoID: 2, RepoName: "b", Path: "main.go", Line: "foo()"},
+	}
+	require.Equal(t, want, toContentMatches(results))
+}
+
+func TestDiffContentMatches(t *testing.T) {
+	match := func(repoID int, repoName, line string) database.ContentMatch {
+		return database.ContentMatch{RepoID: api.RepoID(repoID), RepoName: api.RepoName(repoName), Path: "main.go", Line: line}
+	}
+	added := func(m database.ContentMatch) database.ContentChange {
+		return database.ContentChange{ContentMatch: m, Change: database.ContentChangeAdded}
+	}
+	removed := func(m database.ContentMatch) database.ContentChange {
+		return database.ContentChange{ContentMatch: m, Change: database.ContentChangeRemoved}
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		matches := []database.ContentMatch{match(1, "a", "foo"), match(1, "a", "bar")}
+		require.Empty(t, diffContentMatches(matches, matches))
+	})
+
+	t.Run("added and removed", func(t *testing.T) {
+		prev := []database.ContentMatch{match(1, "a", "foo"), match(1, "a", "bar")}
+		cur := []database.ContentMatch{match(1, "a", "foo"), match(2, "b", "baz")}
+		want := []database.ContentChange{added(match(2, "b", "baz")), removed(match(1, "a", "bar"))}
+		require.Equal(t, want, diffContentMatches(prev, cur))
+	})
+
+	t.Run("repeated line", func(t *testing.T) {
+		prev := []database.ContentMatch{match(1, "a", "foo")}
+		cur := []database.ContentMatch{match(1, "a", "foo"), match(1, "a", "foo")}
+		want := []database.ContentChange{added(match(1, "a", "foo"))}
+		require.Equal(t, want, diffContentMatches(prev, cur))
+	})
+
+	t.Run("renamed repository", func(t *testing.T) {
+		prev := []database.ContentMatch{match(1, "a", "foo")}
+		cur := []database.ContentMatch{match(1, "renamed", "foo")}
+		require.Empty(t, diffContentMatches(prev, cur))
+	})
+}
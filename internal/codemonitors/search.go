@@ -92,6 +92,12 @@ func Snapshot(ctx context.Context, logger log.Logger, db database.DB, query stri
 		return nil, err
 	}
 
+	// Content search queries don't search commits, the first run records
+	// their matches instead.
+	if IsContentQuery(query) {
+		return nil, nil
+	}
+
 	clients := searchClient.JobClients()
 	planJob, err := jobutil.NewPlanJob(inputs, inputs.Plan)
 	if err != nil {
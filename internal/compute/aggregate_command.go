@@ -0,0 +1,115 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// Aggregate counts the matches of SearchPattern, grouped by the value of the
+// GroupBy template. The template may reference capture groups of the search
+// pattern (e.g., $1) as well as metavariables like $repo, $path or $author.
+type Aggregate struct {
+	SearchPattern MatchPattern
+	GroupBy       string
+	TypeValue     string
+}
+
+func (c *Aggregate) ToSearchPattern() string {
+	return c.SearchPattern.String()
+}
+
+func (c *Aggregate) String() string {
+	return fmt.Sprintf("Aggregate: (%s) -> count by (%s)", c.SearchPattern.String(), c.GroupBy)
+}
+
+// Run returns the counts for a single search result. Counts of different
+// results are combined with an Aggregator.
+func (c *Aggregate) Run(_ context.Context, _ gitserver.Client, r result.Match) (Result, error) {
+	onlyPath := c.TypeValue == "path"
+	counts := make(map[string]int)
+	for _, content := range resultChunks(r, "aggregate", onlyPath) {
+		env := NewMetaEnvironment(r, content)
+		groupBy, err := substituteMetaVariables(c.GroupBy, env)
+		if err != nil {
+			return nil, err
+		}
+
+		match := c.SearchPattern.(*Regexp).Value
+		for _, submatches := range match.FindAllStringSubmatchIndex(content, -1) {
+			key := match.ExpandString([]byte{}, groupBy, content, submatches)
+			counts[string(key)]++
+		}
+	}
+	return newAggregation(counts), nil
+}
+
+// Group is the number of matches that have the same Value.
+type Group struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// Aggregation is a list of groups, sorted by descending count.
+type Aggregation struct {
+	Groups []Group `json:"groups"`
+	Kind   string  `json:"kind"`
+}
+
+func newAggregation(counts map[string]int) *Aggregation {
+	groups := make([]Group, 0, len(counts))
+	for value, count := range counts {
+		groups = append(groups, Group{Value: value, Count: count})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Value < groups[j].Value
+	})
+	return &Aggregation{Groups: groups, Kind: "aggregate"}
+}
+
+// Aggregator combines the aggregations of individual search results. It is not
+// safe for concurrent use.
+type Aggregator struct {
+	counts map[string]int
+	// maxGroups bounds the memory used for high cardinality groups. Groups
+	// that are first seen after the limit is reached are counted in
+	// OtherCount.
+	maxGroups int
+	// OtherCount is the number of matches that don't belong to any of the
+	// tracked groups.
+	OtherCount int
+	dirty      bool
+}
+
+func NewAggregator(maxGroups int) *Aggregator {
+	return &Aggregator{counts: make(map[string]int), maxGroups: maxGroups}
+}
+
+// Add merges the counts of a into the aggregator.
+func (a *Aggregator) Add(agg *Aggregation) {
+	for _, g := range agg.Groups {
+		if _, ok := a.counts[g.Value]; !ok && len(a.counts) >= a.maxGroups {
+			a.OtherCount += g.Count
+		} else {
+			a.counts[g.Value] += g.Count
+		}
+		a.dirty = true
+	}
+}
+
+// Dirty reports whether the counts changed since the last call to Snapshot.
+func (a *Aggregator) Dirty() bool {
+	return a.dirty
+}
+
+// Snapshot returns the current counts of all groups.
+func (a *Aggregator) Snapshot() *Aggregation {
+	a.dirty = false
+	return newAggregation(a.counts)
+}
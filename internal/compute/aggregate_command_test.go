@@ -0,0 +1,72 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestAggregate(t *testing.T) {
+	test := func(q string, m result.Match) any {
+		computeQuery, err := Parse(q)
+		if err != nil {
+			return err.Error()
+		}
+		commandResult, err := computeQuery.Command.Run(context.Background(), gitserver.NewMockClient(), m)
+		if err != nil {
+			return err.Error()
+		}
+		return commandResult.(*Aggregation).Groups
+	}
+
+	autogold.Expect([]Group{
+		{
+			Value: "ERROR",
+			Count: 2,
+		},
+		{
+			Value: "WARN",
+			Count: 1,
+		},
+	}).Equal(t, test(`content:aggregate(level=(\w+) -> count by $1)`, fileMatch("level=ERROR", "level=WARN", "level=ERROR")))
+
+	autogold.Expect([]Group{{
+		Value: "my/awesome/repo: ERROR",
+		Count: 1,
+	}}).Equal(t, test(`content:aggregate(level=(\w+) -> count by $repo: $1)`, fileMatch("level=ERROR")))
+
+	autogold.Expect([]Group{{
+		Value: "bob",
+		Count: 3,
+	}}).Equal(t, test(`content:aggregate(\d -> count by $author)`, commitMatch("a 1 b 2 c 3")))
+
+	autogold.Expect(`aggregate command expects 'count by <template>' after '->', got "sum by $1"`).
+		Equal(t, test(`content:aggregate((\d) -> sum by $1)`, fileMatch("1")))
+}
+
+func TestAggregator(t *testing.T) {
+	a := NewAggregator(2)
+	a.Add(&Aggregation{Groups: []Group{{Value: "a", Count: 1}, {Value: "b", Count: 2}}})
+	a.Add(&Aggregation{Groups: []Group{{Value: "a", Count: 3}, {Value: "c", Count: 5}}})
+
+	autogold.Expect(true).Equal(t, a.Dirty())
+	autogold.Expect(&Aggregation{
+		Groups: []Group{
+			{
+				Value: "a",
+				Count: 4,
+			},
+			{
+				Value: "b",
+				Count: 2,
+			},
+		},
+		Kind: "aggregate",
+	}).Equal(t, a.Snapshot())
+	autogold.Expect(5).Equal(t, a.OtherCount)
+	autogold.Expect(false).Equal(t, a.Dirty())
+}
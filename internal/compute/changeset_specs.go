@@ -0,0 +1,134 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ChangesetTemplate describes the changesets that are created for the diffs of
+// a replace command.
+type ChangesetTemplate struct {
+	Title         string
+	Body          string
+	Branch        string
+	CommitMessage string
+}
+
+// DefaultChangesetTemplate returns the template used for c when the user
+// doesn't provide one.
+func (c *Replace) DefaultChangesetTemplate() ChangesetTemplate {
+	title := fmt.Sprintf("Replace %s with %s", c.SearchPattern.String(), c.ReplacePattern)
+	return ChangesetTemplate{
+		Title:         title,
+		Body:          fmt.Sprintf("This changeset was created from the compute query `content:replace(%s -> %s)`.", c.SearchPattern.String(), c.ReplacePattern),
+		Branch:        "compute/replace",
+		CommitMessage: title,
+	}
+}
+
+// NewChangesetSpecs turns the diffs of a replace command into changeset specs,
+// one per repository, which can be passed to the createChangesetSpecs
+// mutation to create a batch change. The changesets are based on the branch
+// that was searched, which is the default branch unless the query has a rev:
+// filter. Revisions other than branches are rejected.
+func NewChangesetSpecs(ctx context.Context, gitserverClient gitserver.Client, diffs []*FileDiff, tmpl ChangesetTemplate) ([]*batcheslib.ChangesetSpec, error) {
+	type repoKey struct {
+		id     int32
+		name   string
+		commit string
+		rev    string
+	}
+	byRepo := make(map[repoKey][]*FileDiff)
+	for _, d := range diffs {
+		k := repoKey{id: d.RepositoryID, name: d.Repository, commit: d.Commit, rev: d.Rev}
+		byRepo[k] = append(byRepo[k], d)
+	}
+
+	keys := make([]repoKey, 0, len(byRepo))
+	for k := range byRepo {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].name != keys[j].name {
+			return keys[i].name < keys[j].name
+		}
+		if keys[i].commit != keys[j].commit {
+			return keys[i].commit < keys[j].commit
+		}
+		return keys[i].rev < keys[j].rev
+	})
+
+	headRef := tmpl.Branch
+	if !strings.HasPrefix(headRef, "refs/heads/") {
+		headRef = "refs/heads/" + headRef
+	}
+
+	specs := make([]*batcheslib.ChangesetSpec, 0, len(keys))
+	for i, k := range keys {
+		if i > 0 && keys[i-1].id == k.id {
+			return nil, errors.Errorf("results for repository %s are from more than one revision, which is not supported for changesets", k.name)
+		}
+
+		baseRef, err := changesetBaseRef(ctx, gitserverClient, api.RepoName(k.name), k.rev)
+		if err != nil {
+			return nil, err
+		}
+
+		repoDiffs := byRepo[k]
+		sort.Slice(repoDiffs, func(i, j int) bool { return repoDiffs[i].Path < repoDiffs[j].Path })
+		var diff strings.Builder
+		for _, d := range repoDiffs {
+			diff.WriteString(d.Value)
+		}
+
+		repoID := string(relay.MarshalID("Repository", api.RepoID(k.id)))
+		specs = append(specs, &batcheslib.ChangesetSpec{
+			BaseRepository: repoID,
+			BaseRef:        baseRef,
+			BaseRev:        k.commit,
+			HeadRepository: repoID,
+			HeadRef:        headRef,
+			Title:          tmpl.Title,
+			Body:           tmpl.Body,
+			Commits: []batcheslib.GitCommitDescription{{
+				Message:     tmpl.CommitMessage,
+				Diff:        []byte(diff.String()),
+				AuthorName:  "Sourcegraph",
+				AuthorEmail: "batch-changes@sourcegraph.com",
+			}},
+		})
+	}
+	return specs, nil
+}
+
+// changesetBaseRef returns the ref of the branch a changeset for the search of
+// rev in repo is based on.
+func changesetBaseRef(ctx context.Context, gitserverClient gitserver.Client, repo api.RepoName, rev string) (string, error) {
+	// HEAD is the default branch of the mirrored repository.
+	if rev == "" || rev == "HEAD" {
+		ref, _, err := gitserverClient.GetDefaultBranch(ctx, repo, false)
+		return ref, err
+	}
+
+	ref := rev
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		ref = "refs/heads/" + ref
+	}
+	if _, err := gitserverClient.ResolveRevision(ctx, repo, ref, gitserver.ResolveRevisionOptions{NoEnsureRevision: true}); err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return "", errors.Errorf("results for repository %s are from revision %s, which is not a branch. Changesets can only be created for branches", repo, rev)
+		}
+		return "", err
+	}
+	return ref, nil
+}
@@ -0,0 +1,140 @@
+package compute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/grafana/regexp"
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestReplaceDiff(t *testing.T) {
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.ReadFileFunc.SetDefaultReturn([]byte("needs more queryrunner\n"), nil)
+
+	cmd := &Replace{
+		SearchPattern:  &Regexp{Value: regexp.MustCompile(`more (\w+)`)},
+		ReplacePattern: "a bit more $1",
+		Diff:           true,
+	}
+	match := &result.FileMatch{File: result.File{
+		Repo:     types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/sourcegraph"},
+		CommitID: "deadbeef",
+		Path:     "README.md",
+	}}
+
+	got, err := cmd.Run(context.Background(), gitserverClient, match)
+	if err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect(&FileDiff{
+		Value: `diff --git README.md README.md
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-needs more queryrunner
++needs a bit more queryrunner
+`,
+		Kind:         "replace-diff",
+		Path:         "README.md",
+		Commit:       "deadbeef",
+		RepositoryID: 1,
+		Repository:   "github.com/sourcegraph/sourcegraph",
+	}).Equal(t, got)
+
+	// Files without changes don't produce a diff.
+	gitserverClient.ReadFileFunc.SetDefaultReturn([]byte("needs a bit more queryrunner\n"), nil)
+	cmd.SearchPattern = &Regexp{Value: regexp.MustCompile(`nothing`)}
+	got, err = cmd.Run(context.Background(), gitserverClient, match)
+	if err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect(nil).Equal(t, got)
+}
+
+func TestNewChangesetSpecs(t *testing.T) {
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, _ bool) (string, api.CommitID, error) {
+		return "refs/heads/main", "", nil
+	})
+
+	cmd := &Replace{
+		SearchPattern:  &Regexp{Value: regexp.MustCompile(`foo`)},
+		ReplacePattern: "bar",
+	}
+	diffs := []*FileDiff{
+		{Value: "diff b.go\n", Path: "b.go", Commit: "c1", RepositoryID: 1, Repository: "a"},
+		{Value: "diff main.go\n", Path: "main.go", Commit: "c2", RepositoryID: 2, Repository: "b"},
+		{Value: "diff a.go\n", Path: "a.go", Commit: "c1", RepositoryID: 1, Repository: "a"},
+	}
+
+	specs, err := NewChangesetSpecs(context.Background(), gitserverClient, diffs, cmd.DefaultChangesetTemplate())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		BaseRepository, BaseRef, BaseRev, HeadRef, Title, Diff string
+	}
+	var got []summary
+	for _, spec := range specs {
+		diff, _ := spec.Diff()
+		got = append(got, summary{spec.BaseRepository, spec.BaseRef, spec.BaseRev, spec.HeadRef, spec.Title, string(diff)})
+	}
+	autogold.Expect([]summary{
+		{
+			BaseRepository: "UmVwb3NpdG9yeTox",
+			BaseRef:        "refs/heads/main",
+			BaseRev:        "c1",
+			HeadRef:        "refs/heads/compute/replace",
+			Title:          "Replace foo with bar",
+			Diff:           "diff a.go\ndiff b.go\n",
+		},
+		{
+			BaseRepository: "UmVwb3NpdG9yeToy",
+			BaseRef:        "refs/heads/main",
+			BaseRev:        "c2",
+			HeadRef:        "refs/heads/compute/replace",
+			Title:          "Replace foo with bar",
+			Diff:           "diff main.go\n",
+		},
+	}).Equal(t, got)
+
+	// A repository can only be changed at a single revision.
+	diffs = append(diffs, &FileDiff{Value: "diff c.go\n", Path: "c.go", Commit: "c3", RepositoryID: 1, Repository: "a"})
+	_, err = NewChangesetSpecs(context.Background(), gitserverClient, diffs, cmd.DefaultChangesetTemplate())
+	autogold.Expect("results for repository a are from more than one revision, which is not supported for changesets").Equal(t, err.Error())
+
+	// Changesets for searches of another branch are based on that branch.
+	gitserverClient.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if spec != "refs/heads/feature" {
+			return "", &gitdomain.RevisionNotFoundError{Repo: "a", Spec: spec}
+		}
+		return "c4", nil
+	})
+	diffs = []*FileDiff{{Value: "diff a.go\n", Path: "a.go", Commit: "c4", Rev: "feature", RepositoryID: 1, Repository: "a"}}
+	specs, err = NewChangesetSpecs(context.Background(), gitserverClient, diffs, cmd.DefaultChangesetTemplate())
+	if err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect([]string{"refs/heads/feature", "c4"}).Equal(t, []string{specs[0].BaseRef, specs[0].BaseRev})
+
+	// Other revisions can't be the base of a changeset.
+	diffs[0].Rev = "v1.0.0"
+	_, err = NewChangesetSpecs(context.Background(), gitserverClient, diffs, cmd.DefaultChangesetTemplate())
+	autogold.Expect("results for repository a are from revision v1.0.0, which is not a branch. Changesets can only be created for branches").Equal(t, err.Error())
+
+	// Searches of HEAD are searches of the default branch.
+	diffs[0].Rev = "HEAD"
+	specs, err = NewChangesetSpecs(context.Background(), gitserverClient, diffs, cmd.DefaultChangesetTemplate())
+	if err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect("refs/heads/main").Equal(t, specs[0].BaseRef)
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	streamapi "github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
+
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming/http"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ComputeAggregateStreamDecoder decodes the stream of the aggregate command.
+// Every aggregation passed to OnResult contains the counts of all results seen
+// so far and supersedes the previous one.
+type ComputeAggregateStreamDecoder struct {
+	OnProgress func(progress *streamapi.Progress)
+	OnResult   func(aggregation *compute.Aggregation)
+	OnAlert    func(*http.EventAlert)
+	OnError    func(*http.EventError)
+	OnUnknown  func(event, data []byte)
+}
+
+func (rr ComputeAggregateStreamDecoder) ReadAll(r io.Reader) error {
+	dec := http.NewDecoder(r)
+
+	for dec.Scan() {
+		event := dec.Event()
+		data := dec.Data()
+
+		if bytes.Equal(event, []byte("results")) {
+			if rr.OnResult == nil {
+				continue
+			}
+			var d []compute.Aggregation
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode compute aggregate payload: %w", err)
+			}
+			if len(d) > 0 {
+				// Only the latest snapshot of a batch is relevant.
+				rr.OnResult(&d[len(d)-1])
+			}
+		} else if bytes.Equal(event, []byte("progress")) {
+			if rr.OnProgress == nil {
+				continue
+			}
+			var d streamapi.Progress
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode progress payload: %w", err)
+			}
+			rr.OnProgress(&d)
+		} else if bytes.Equal(event, []byte("alert")) {
+			if rr.OnAlert == nil {
+				continue
+			}
+			var d http.EventAlert
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode alert payload: %w", err)
+			}
+			rr.OnAlert(&d)
+		} else if bytes.Equal(event, []byte("error")) {
+			if rr.OnError == nil {
+				continue
+			}
+			var d http.EventError
+			if err := json.Unmarshal(data, &d); err != nil {
+				return errors.Errorf("failed to decode error payload: %w", err)
+			}
+			rr.OnError(&d)
+		} else if bytes.Equal(event, []byte("done")) {
+			// Always the last event
+			break
+		} else {
+			if rr.OnUnknown == nil {
+				continue
+			}
+			rr.OnUnknown(event, data)
+		}
+	}
+	return dec.Err()
+}
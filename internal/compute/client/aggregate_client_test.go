@@ -0,0 +1,49 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/internal/compute"
+)
+
+func TestComputeAggregateStreamDecoder_ReadAll(t *testing.T) {
+	raw := `event: results
+data: [{"groups":[{"value":"ERROR","count":2}],"kind":"aggregate"}]
+
+event: results
+data: [{"groups":[{"value":"ERROR","count":3},{"value":"WARN","count":1}],"kind":"aggregate"}]
+
+event: done
+data: {}`
+
+	resultCount := 0
+	var latest *compute.Aggregation
+	decoder := ComputeAggregateStreamDecoder{
+		OnResult: func(aggregation *compute.Aggregation) {
+			resultCount++
+			latest = aggregation
+		},
+	}
+
+	err := decoder.ReadAll(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect(2).Equal(t, resultCount)
+	autogold.Expect(&compute.Aggregation{
+		Groups: []compute.Group{
+			{
+				Value: "ERROR",
+				Count: 3,
+			},
+			{
+				Value: "WARN",
+				Count: 1,
+			},
+		},
+		Kind: "aggregate",
+	}).Equal(t, latest)
+}
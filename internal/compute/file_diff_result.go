@@ -0,0 +1,27 @@
+package compute
+
+import (
+	"fmt"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+)
+
+// FileDiff is the change a replace command makes to a file. Value is a unified
+// diff without path prefixes, so it can be applied with `git apply -p0` like
+// the diffs of batch changes.
+type FileDiff struct {
+	Value  string `json:"value"`
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Commit string `json:"commit"`
+	// Rev is the revision that was searched, or empty for the default branch.
+	Rev          string `json:"-"`
+	RepositoryID int32  `json:"repositoryID"`
+	Repository   string `json:"repository"`
+}
+
+func unifiedDiff(path, before, after string) string {
+	edits := myers.ComputeEdits("", before, after)
+	return fmt.Sprintf("diff --git %s %s\n%s", path, path, gotextdiff.ToUnified(path, path, before, edits))
+}
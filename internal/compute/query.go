@@ -104,6 +104,7 @@ var ComputePredicateRegistry = query.PredicateRegistry{
 		"output.regexp":      func() query.Predicate { return query.EmptyPredicate{} },
 		"output.structural":  func() query.Predicate { return query.EmptyPredicate{} },
 		"output.extra":       func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate":          func() query.Predicate { return query.EmptyPredicate{} },
 	},
 }
 
@@ -220,6 +221,49 @@ func parseOutput(q *query.Basic) (Command, bool, error) {
 	}, true, nil
 }
 
+var countBySyntax = lazyregexp.New(`^count\s+by(\s+|$)`)
+
+func parseAggregate(q *query.Basic) (Command, bool, error) {
+	pattern, err := extractPattern(q)
+	if err != nil {
+		return nil, false, err
+	}
+
+	name, args, ok := parseContentPredicate(pattern)
+	if !ok || name != "aggregate" {
+		return nil, false, nil
+	}
+	left, right, err := parseArrowSyntax(args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	matchPattern, err := toRegexpPattern(left)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "aggregate command")
+	}
+
+	loc := countBySyntax.FindStringIndex(right)
+	if loc == nil {
+		return nil, false, errors.Errorf("aggregate command expects 'count by <template>' after '->', got %q", right)
+	}
+	groupBy := right[loc[1]:]
+	if groupBy == "" {
+		return nil, false, errors.New("aggregate command expects a nonempty template after 'count by'")
+	}
+
+	var typeValue string
+	query.VisitField(q.ToParseTree(), query.FieldType, func(value string, _ bool, _ query.Annotation) {
+		typeValue = value
+	})
+
+	return &Aggregate{
+		SearchPattern: matchPattern,
+		GroupBy:       groupBy,
+		TypeValue:     typeValue,
+	}, true, nil
+}
+
 func parseMatchOnly(q *query.Basic) (Command, bool, error) {
 	pattern, err := extractPattern(q)
 	if err != nil {
@@ -263,6 +307,7 @@ func first(parsers ...commandParser) commandParser {
 var parseCommand = first(
 	parseReplace,
 	parseOutput,
+	parseAggregate,
 	parseMatchOnly,
 )
 
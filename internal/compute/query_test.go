@@ -41,6 +41,12 @@ func TestParse(t *testing.T) {
 
 	autogold.Expect("Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Expect("Command: `Aggregate: (level=(\\w+)) -> count by ($repo $1)`").
+		Equal(t, test(`content:aggregate(level=(\w+) -> count by $repo $1)`))
+
+	autogold.Expect("aggregate command expects a nonempty template after 'count by'").
+		Equal(t, test(`content:aggregate(level=(\w+) -> count by )`))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -15,6 +15,10 @@ import (
 type Replace struct {
 	SearchPattern  MatchPattern
 	ReplacePattern string
+
+	// Diff, if set, makes Run return the changes to a file as a *FileDiff
+	// instead of the new file contents.
+	Diff bool
 }
 
 func (c *Replace) ToSearchPattern() string {
@@ -57,7 +61,31 @@ func (c *Replace) Run(ctx context.Context, gitserverClient gitserver.Client, r r
 		if err != nil {
 			return nil, err
 		}
-		return replace(ctx, content, c.SearchPattern, c.ReplacePattern)
+		newContent, err := replace(ctx, content, c.SearchPattern, c.ReplacePattern)
+		if err != nil {
+			return nil, err
+		}
+		if !c.Diff {
+			return newContent, nil
+		}
+		if newContent.Value == string(content) {
+			// Nothing to change, e.g. when a case insensitive search matched
+			// but the case sensitive replacement didn't.
+			return nil, nil
+		}
+		var rev string
+		if m.InputRev != nil {
+			rev = *m.InputRev
+		}
+		return &FileDiff{
+			Value:        unifiedDiff(m.Path, string(content), newContent.Value),
+			Kind:         "replace-diff",
+			Path:         m.Path,
+			Commit:       string(m.CommitID),
+			Rev:          rev,
+			RepositoryID: int32(m.Repo.ID),
+			Repository:   string(m.Repo.Name),
+		}, nil
 	}
 	return nil, nil
 }
@@ -0,0 +1,89 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const dartPackagesPrefix = "pub/"
+
+type DartVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewDartVersionedPackage(name PackageName, version string) *DartVersionedPackage {
+	return &DartVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParseDartVersionedPackage parses a string in a '<name>(@<version>)?' format into a
+// DartVersionedPackage.
+func ParseDartVersionedPackage(dependency string) *DartVersionedPackage {
+	var dep DartVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(dependency)
+	} else {
+		dep.Name = PackageName(strings.TrimSpace(dependency[:i]))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParseDartPackageFromName(name PackageName) *DartVersionedPackage {
+	return ParseDartVersionedPackage(string(name))
+}
+
+// ParseDartPackageFromRepoName is a convenience function to parse a repo name in a
+// 'pub/<name>(@<version>)?' format into a DartVersionedPackage.
+func ParseDartPackageFromRepoName(name api.RepoName) (*DartVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), dartPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid Dart dependency repo name, missing %s prefix '%s'", dartPackagesPrefix, name)
+	}
+	return ParseDartVersionedPackage(dependency), nil
+}
+
+func (p *DartVersionedPackage) Scheme() string {
+	return "scip-dart"
+}
+
+func (p *DartVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *DartVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *DartVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *DartVersionedPackage) Description() string { return "" }
+
+func (p *DartVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(dartPackagesPrefix + p.Name)
+}
+
+func (p *DartVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *DartVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*DartVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
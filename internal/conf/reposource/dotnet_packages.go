@@ -0,0 +1,91 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const dotnetPackagesPrefix = "nuget/"
+
+type DotnetVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewDotnetVersionedPackage(name PackageName, version string) *DotnetVersionedPackage {
+	return &DotnetVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParseDotnetVersionedPackage parses a string in a '<name>(@<version>)?' format into a
+// DotnetVersionedPackage.
+// NuGet package IDs are case-insensitive, so they are normalized to lower case to
+// map every spelling of an ID to the same package.
+func ParseDotnetVersionedPackage(dependency string) *DotnetVersionedPackage {
+	var dep DotnetVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(strings.ToLower(dependency))
+	} else {
+		dep.Name = PackageName(strings.ToLower(strings.TrimSpace(dependency[:i])))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParseDotnetPackageFromName(name PackageName) *DotnetVersionedPackage {
+	return ParseDotnetVersionedPackage(string(name))
+}
+
+// ParseDotnetPackageFromRepoName is a convenience function to parse a repo name in a
+// 'nuget/<name>(@<version>)?' format into a DotnetVersionedPackage.
+func ParseDotnetPackageFromRepoName(name api.RepoName) (*DotnetVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), dotnetPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid .NET dependency repo name, missing %s prefix '%s'", dotnetPackagesPrefix, name)
+	}
+	return ParseDotnetVersionedPackage(dependency), nil
+}
+
+func (p *DotnetVersionedPackage) Scheme() string {
+	return "scip-dotnet"
+}
+
+func (p *DotnetVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *DotnetVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *DotnetVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *DotnetVersionedPackage) Description() string { return "" }
+
+func (p *DotnetVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(dotnetPackagesPrefix + p.Name)
+}
+
+func (p *DotnetVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *DotnetVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*DotnetVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
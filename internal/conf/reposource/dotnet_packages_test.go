@@ -0,0 +1,40 @@
+package reposource
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseDotnetVersionedPackage(t *testing.T) {
+	pkg := ParseDotnetVersionedPackage("Newtonsoft.Json@13.0.3")
+	assert.Equal(t, PackageName("newtonsoft.json"), pkg.PackageSyntax())
+	assert.Equal(t, "13.0.3", pkg.PackageVersion())
+	assert.Equal(t, api.RepoName("nuget/newtonsoft.json"), pkg.RepoName())
+	assert.Equal(t, "v13.0.3", pkg.GitTagFromVersion())
+
+	fromRepoName, err := ParseDotnetPackageFromRepoName("nuget/Newtonsoft.Json")
+	assert.NoError(t, err)
+	assert.Equal(t, pkg.PackageSyntax(), fromRepoName.PackageSyntax())
+}
+
+func TestDotnetVersionedPackage_Less(t *testing.T) {
+	packages := []*DotnetVersionedPackage{
+		ParseDotnetVersionedPackage("serilog@2.12.0"),
+		ParseDotnetVersionedPackage("serilog@3.0.1"),
+		ParseDotnetVersionedPackage("newtonsoft.json@13.0.3"),
+		ParseDotnetVersionedPackage("serilog@2.9.0"),
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].Less(packages[j])
+	})
+
+	var got []string
+	for _, pkg := range packages {
+		got = append(got, pkg.VersionedPackageSyntax())
+	}
+	assert.Equal(t, []string{"serilog@3.0.1", "serilog@2.12.0", "serilog@2.9.0", "newtonsoft.json@13.0.3"}, got)
+}
@@ -0,0 +1,89 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const elixirPackagesPrefix = "hex/"
+
+type ElixirVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewElixirVersionedPackage(name PackageName, version string) *ElixirVersionedPackage {
+	return &ElixirVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParseElixirVersionedPackage parses a string in a '<name>(@<version>)?' format into a
+// ElixirVersionedPackage.
+func ParseElixirVersionedPackage(dependency string) *ElixirVersionedPackage {
+	var dep ElixirVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(dependency)
+	} else {
+		dep.Name = PackageName(strings.TrimSpace(dependency[:i]))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParseElixirPackageFromName(name PackageName) *ElixirVersionedPackage {
+	return ParseElixirVersionedPackage(string(name))
+}
+
+// ParseElixirPackageFromRepoName is a convenience function to parse a repo name in a
+// 'hex/<name>(@<version>)?' format into a ElixirVersionedPackage.
+func ParseElixirPackageFromRepoName(name api.RepoName) (*ElixirVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), elixirPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid Elixir dependency repo name, missing %s prefix '%s'", elixirPackagesPrefix, name)
+	}
+	return ParseElixirVersionedPackage(dependency), nil
+}
+
+func (p *ElixirVersionedPackage) Scheme() string {
+	return "hex"
+}
+
+func (p *ElixirVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *ElixirVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *ElixirVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *ElixirVersionedPackage) Description() string { return "" }
+
+func (p *ElixirVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(elixirPackagesPrefix + p.Name)
+}
+
+func (p *ElixirVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *ElixirVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*ElixirVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
@@ -0,0 +1,91 @@
+package reposource
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const phpPackagesPrefix = "packagist/"
+
+type PhpVersionedPackage struct {
+	Name    PackageName
+	Version string
+}
+
+func NewPhpVersionedPackage(name PackageName, version string) *PhpVersionedPackage {
+	return &PhpVersionedPackage{
+		Name:    name,
+		Version: version,
+	}
+}
+
+// ParsePhpVersionedPackage parses a string in a '<vendor>/<name>(@<version>)?' format into a
+// PhpVersionedPackage.
+// Composer package names are case-insensitive, so they are normalized to lower case
+// to map every spelling of a name to the same package.
+func ParsePhpVersionedPackage(dependency string) *PhpVersionedPackage {
+	var dep PhpVersionedPackage
+	if i := strings.LastIndex(dependency, "@"); i == -1 {
+		dep.Name = PackageName(strings.ToLower(dependency))
+	} else {
+		dep.Name = PackageName(strings.ToLower(strings.TrimSpace(dependency[:i])))
+		dep.Version = strings.TrimSpace(dependency[i+1:])
+	}
+	return &dep
+}
+
+func ParsePhpPackageFromName(name PackageName) *PhpVersionedPackage {
+	return ParsePhpVersionedPackage(string(name))
+}
+
+// ParsePhpPackageFromRepoName is a convenience function to parse a repo name in a
+// 'packagist/<vendor>/<name>(@<version>)?' format into a PhpVersionedPackage.
+func ParsePhpPackageFromRepoName(name api.RepoName) (*PhpVersionedPackage, error) {
+	dependency := strings.TrimPrefix(string(name), phpPackagesPrefix)
+	if len(dependency) == len(name) {
+		return nil, errors.Newf("invalid PHP dependency repo name, missing %s prefix '%s'", phpPackagesPrefix, name)
+	}
+	return ParsePhpVersionedPackage(dependency), nil
+}
+
+func (p *PhpVersionedPackage) Scheme() string {
+	return "scip-php"
+}
+
+func (p *PhpVersionedPackage) PackageSyntax() PackageName {
+	return p.Name
+}
+
+func (p *PhpVersionedPackage) VersionedPackageSyntax() string {
+	if p.Version == "" {
+		return string(p.Name)
+	}
+	return string(p.Name) + "@" + p.Version
+}
+
+func (p *PhpVersionedPackage) PackageVersion() string {
+	return p.Version
+}
+
+func (p *PhpVersionedPackage) Description() string { return "" }
+
+func (p *PhpVersionedPackage) RepoName() api.RepoName {
+	return api.RepoName(phpPackagesPrefix + p.Name)
+}
+
+func (p *PhpVersionedPackage) GitTagFromVersion() string {
+	version := strings.TrimPrefix(p.Version, "v")
+	return "v" + version
+}
+
+func (p *PhpVersionedPackage) Less(other VersionedPackage) bool {
+	o := other.(*PhpVersionedPackage)
+
+	if p.Name == o.Name {
+		return versionGreaterThan(p.Version, o.Version)
+	}
+
+	return p.Name > o.Name
+}
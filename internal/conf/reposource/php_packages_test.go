@@ -0,0 +1,37 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParsePhpPackageFromRepoName(t *testing.T) {
+	table := []struct {
+		repoName string
+		name     PackageName
+		version  string
+	}{
+		{"packagist/monolog/monolog", "monolog/monolog", ""},
+		{"packagist/Symfony/Console@v6.3.4", "symfony/console", "v6.3.4"},
+	}
+	for _, entry := range table {
+		pkg, err := ParsePhpPackageFromRepoName(api.RepoName(entry.repoName))
+		require.NoError(t, err)
+		assert.Equal(t, entry.name, pkg.PackageSyntax())
+		assert.Equal(t, entry.version, pkg.PackageVersion())
+	}
+
+	_, err := ParsePhpPackageFromRepoName("github.com/monolog/monolog")
+	assert.Error(t, err)
+}
+
+func TestPhpVersionedPackage_RepoName(t *testing.T) {
+	pkg := ParsePhpVersionedPackage("Monolog/Monolog@3.4.0")
+	assert.Equal(t, api.RepoName("packagist/monolog/monolog"), pkg.RepoName())
+	assert.Equal(t, "monolog/monolog@3.4.0", pkg.VersionedPackageSyntax())
+	assert.Equal(t, "v3.4.0", pkg.GitTagFromVersion())
+}
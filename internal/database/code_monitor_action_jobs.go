@@ -14,11 +14,12 @@ import (
 )
 
 type ActionJob struct {
-	ID           int32
-	Email        *int64
-	Webhook      *int64
-	SlackWebhook *int64
-	TriggerEvent int32
+	ID             int32
+	Email          *int64
+	Webhook        *int64
+	SlackWebhook   *int64
+	ChatOpsWebhook *int64
+	TriggerEvent   int32
 
 	// Fields demanded by any dbworker.
 	State          string
@@ -45,6 +46,9 @@ type ActionJobMetadata struct {
 	Results     []*result.CommitMatch
 	OwnerName   string
 
+	// ContentChanges are set instead of Results for content search queries.
+	ContentChanges []ContentChange
+
 	// The query with after: filter.
 	Query string
 }
@@ -56,6 +60,7 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.email"),
 	sqlf.Sprintf("cm_action_jobs.webhook"),
 	sqlf.Sprintf("cm_action_jobs.slack_webhook"),
+	sqlf.Sprintf("cm_action_jobs.chatops_webhook"),
 	sqlf.Sprintf("cm_action_jobs.trigger_event"),
 	sqlf.Sprintf("cm_action_jobs.state"),
 	sqlf.Sprintf("cm_action_jobs.failure_message"),
@@ -87,6 +92,11 @@ type ListActionJobsOpts struct {
 	// the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
+	// ChatOpsWebhookID, if set, will filter to only actions jobs that are
+	// executing the given chat-ops webhook action. Refers to
+	// cm_chatops_webhooks(id)
+	ChatOpsWebhookID *int
+
 	// First, if defined, limits the operation to only the first n results
 	First *int
 
@@ -110,6 +120,9 @@ func (o ListActionJobsOpts) Conds() *sqlf.Query {
 	if o.SlackWebhookID != nil {
 		conds = append(conds, sqlf.Sprintf("slack_webhook = %s", *o.SlackWebhookID))
 	}
+	if o.ChatOpsWebhookID != nil {
+		conds = append(conds, sqlf.Sprintf("chatops_webhook = %s", *o.ChatOpsWebhookID))
+	}
 	if o.After != nil {
 		conds = append(conds, sqlf.Sprintf("id > %s", *o.After))
 	}
@@ -197,14 +210,25 @@ WITH due_emails AS (
 	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
 	WHERE state = 'queued'
 		OR state = 'processing'
+), due_chatops_webhooks AS (
+	SELECT id
+	FROM cm_chatops_webhooks
+	WHERE monitor = %s
+		AND enabled = true
+	EXCEPT
+	SELECT DISTINCT chatops_webhook as id FROM cm_action_jobs
+	WHERE state = 'queued'
+		OR state = 'processing'
 )
-INSERT INTO cm_action_jobs (email, webhook, slack_webhook, trigger_event)
-SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, chatops_webhook, trigger_event)
+SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+UNION
+SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_webhooks
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_slack_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_slack_webhooks
-ORDER BY 1, 2, 3
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_chatops_webhooks
+ORDER BY 1, 2, 3, 4
 RETURNING %s
 `
 
@@ -214,6 +238,8 @@ func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, moni
 		monitorID,
 		monitorID,
 		monitorID,
+		monitorID,
+		triggerJobID,
 		triggerJobID,
 		triggerJobID,
 		triggerJobID,
@@ -233,6 +259,7 @@ SELECT
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
+	ctj.content_changes,
 	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
@@ -245,15 +272,20 @@ WHERE caj.id = %s
 // GetActionJobMetada returns the set of fields needed to execute all action jobs
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var resultsJSON, contentChangesJSON []byte
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &contentChangesJSON, &m.OwnerName)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
 		return nil, err
 	}
+	if len(contentChangesJSON) > 0 {
+		if err := json.Unmarshal(contentChangesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
 	return m, nil
 }
 
@@ -288,6 +320,7 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.Email,
 		&aj.Webhook,
 		&aj.SlackWebhook,
+		&aj.ChatOpsWebhook,
 		&aj.TriggerEvent,
 		&aj.State,
 		&aj.FailureMessage,
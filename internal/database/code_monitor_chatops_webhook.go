@@ -0,0 +1,229 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// ChatOpsProvider is the chat tool a ChatOpsWebhookAction posts to. It
+// determines the format of the message we send.
+type ChatOpsProvider string
+
+const (
+	ChatOpsProviderTeams      ChatOpsProvider = "teams"
+	ChatOpsProviderMattermost ChatOpsProvider = "mattermost"
+)
+
+// ChatOpsWebhookAction is an action which posts to the incoming webhook of a
+// chat tool other than Slack.
+type ChatOpsWebhookAction struct {
+	ID             int64
+	Monitor        int64
+	Enabled        bool
+	Provider       ChatOpsProvider
+	URL            string
+	IncludeResults bool
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+const updateChatOpsWebhookActionQuery = `
+UPDATE cm_chatops_webhooks
+SET enabled = %s,
+	include_results = %s,
+	provider = %s,
+	url = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_chatops_webhooks.monitor
+			AND %s
+	)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateChatOpsWebhookAction(ctx context.Context, id int64, enabled, includeResults bool, provider ChatOpsProvider, url string) (*ChatOpsWebhookAction, error) {
+	a := actor.FromContext(ctx)
+
+	user, err := a.User(ctx, s.userStore)
+	if err != nil {
+		return nil, err
+	}
+
+	q := sqlf.Sprintf(
+		updateChatOpsWebhookActionQuery,
+		enabled,
+		includeResults,
+		provider,
+		url,
+		a.UID,
+		s.Now(),
+		id,
+		namespaceScopeQuery(user),
+		sqlf.Join(chatOpsWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanChatOpsWebhookAction(row)
+}
+
+const createChatOpsWebhookActionQuery = `
+INSERT INTO cm_chatops_webhooks
+(monitor, enabled, include_results, provider, url, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateChatOpsWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, provider ChatOpsProvider, url string) (*ChatOpsWebhookAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createChatOpsWebhookActionQuery,
+		monitorID,
+		enabled,
+		includeResults,
+		provider,
+		url,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(chatOpsWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanChatOpsWebhookAction(row)
+}
+
+const deleteChatOpsWebhookActionQuery = `
+DELETE FROM cm_chatops_webhooks
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteChatOpsWebhookActions(ctx context.Context, monitorID int64, webhookIDs ...int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(webhookIDs))
+	for _, ids := range webhookIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteChatOpsWebhookActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countChatOpsWebhookActionsQuery = `
+SELECT COUNT(*)
+FROM cm_chatops_webhooks
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountChatOpsWebhookActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countChatOpsWebhookActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getChatOpsWebhookActionQuery = `
+SELECT %s -- ChatOpsWebhookActionColumns
+FROM cm_chatops_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetChatOpsWebhookAction(ctx context.Context, id int64) (*ChatOpsWebhookAction, error) {
+	q := sqlf.Sprintf(
+		getChatOpsWebhookActionQuery,
+		sqlf.Join(chatOpsWebhookActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanChatOpsWebhookAction(row)
+}
+
+const listChatOpsWebhookActionsQuery = `
+SELECT %s -- ChatOpsWebhookActionColumns
+FROM cm_chatops_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListChatOpsWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*ChatOpsWebhookAction, error) {
+	q := sqlf.Sprintf(
+		listChatOpsWebhookActionsQuery,
+		sqlf.Join(chatOpsWebhookActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanChatOpsWebhookActions(rows)
+}
+
+// chatOpsWebhookActionColumns is the set of columns in the cm_chatops_webhooks table
+// This must be kept in sync with scanChatOpsWebhook
+var chatOpsWebhookActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_chatops_webhooks.id"),
+	sqlf.Sprintf("cm_chatops_webhooks.monitor"),
+	sqlf.Sprintf("cm_chatops_webhooks.enabled"),
+	sqlf.Sprintf("cm_chatops_webhooks.provider"),
+	sqlf.Sprintf("cm_chatops_webhooks.url"),
+	sqlf.Sprintf("cm_chatops_webhooks.include_results"),
+	sqlf.Sprintf("cm_chatops_webhooks.created_by"),
+	sqlf.Sprintf("cm_chatops_webhooks.created_at"),
+	sqlf.Sprintf("cm_chatops_webhooks.changed_by"),
+	sqlf.Sprintf("cm_chatops_webhooks.changed_at"),
+}
+
+func scanChatOpsWebhookActions(rows *sql.Rows) ([]*ChatOpsWebhookAction, error) {
+	var ws []*ChatOpsWebhookAction
+	for rows.Next() {
+		w, err := scanChatOpsWebhookAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanChatOpsWebhookAction scans a ChatOpsWebhookAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with chatOpsWebhookActionColumns.
+func scanChatOpsWebhookAction(scanner dbutil.Scanner) (*ChatOpsWebhookAction, error) {
+	var w ChatOpsWebhookAction
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Enabled,
+		&w.Provider,
+		&w.URL,
+		&w.IncludeResults,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	return &w, err
+}
@@ -0,0 +1,175 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreChatOpsWebhooks(t *testing.T) {
+	ctx := context.Background()
+	url1 := "https://icanhazcheezburger.com/chatops_webhook"
+	url2 := "https://icanthazcheezburger.com/chatops_webhook"
+
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		got, err := s.GetChatOpsWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+		require.Equal(t, ChatOpsProviderTeams, got.Provider)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		updated, err := s.UpdateChatOpsWebhookAction(ctx, action.ID, false, false, ChatOpsProviderMattermost, url2)
+		require.NoError(t, err)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, ChatOpsProviderMattermost, updated.Provider)
+		require.Equal(t, url2, updated.URL)
+
+		got, err := s.GetChatOpsWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+	})
+
+	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+
+		_, err := s.UpdateChatOpsWebhookAction(ctx, 383838, false, false, ChatOpsProviderTeams, url2)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action1, err := s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		action2, err := s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		err = s.DeleteChatOpsWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetChatOpsWebhookAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetChatOpsWebhookAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("CountCreateCount", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		count, err := s.CountChatOpsWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		count, err = s.CountChatOpsWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("ListCreateList", func(t *testing.T) {
+		t.Parallel()
+
+		db := NewDB(logger, dbtest.NewDB(t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitorsWith(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		actions, err := s.ListChatOpsWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions, 0)
+
+		_, err = s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url1)
+		require.NoError(t, err)
+
+		_, err = s.CreateChatOpsWebhookAction(ctx, fixtures.monitor.ID, true, false, ChatOpsProviderTeams, url2)
+		require.NoError(t, err)
+
+		actions2, err := s.ListChatOpsWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions2, 2)
+
+		first := 1
+		actions3, err := s.ListChatOpsWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions3, 1)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		uid3 := insertTestUser(ctx, t, db, "u3", true)
+		ctx3 := actor.WithActor(ctx, actor.FromUser(uid3))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+
+		wa, err := s.CreateChatOpsWebhookAction(ctx1, fixtures.monitor.ID, true, true, ChatOpsProviderTeams, "https://true.com")
+		require.NoError(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateChatOpsWebhookAction(ctx1, wa.ID, true, true, ChatOpsProviderTeams, "https://false.com")
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateChatOpsWebhookAction(ctx2, wa.ID, true, true, ChatOpsProviderTeams, "https://truer.com")
+		require.Error(t, err)
+
+		// User3 can update it
+		_, err = s.UpdateChatOpsWebhookAction(ctx3, wa.ID, true, true, ChatOpsProviderTeams, "https://false.com")
+		require.NoError(t, err)
+
+		wa, err = s.GetChatOpsWebhookAction(ctx1, wa.ID)
+		require.NoError(t, err)
+		require.Equal(t, wa.URL, "https://false.com")
+	})
+}
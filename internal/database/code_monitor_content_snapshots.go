@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ContentMatch is a single line matched by a code monitor with a content
+// search query. Path matches have an empty Line.
+type ContentMatch struct {
+	RepoID   api.RepoID   `json:"repositoryID"`
+	RepoName api.RepoName `json:"repository"`
+	Path     string       `json:"path"`
+	Line     string       `json:"line,omitempty"`
+}
+
+const (
+	ContentChangeAdded   = "added"
+	ContentChangeRemoved = "removed"
+)
+
+// ContentChange is a ContentMatch which was added or removed between two runs
+// of a code monitor with a content search query.
+type ContentChange struct {
+	ContentMatch
+	// Change is either ContentChangeAdded or ContentChangeRemoved.
+	Change string `json:"change"`
+}
+
+// ContentSnapshot is the set of matches of the last run of a code monitor with
+// a content search query.
+type ContentSnapshot struct {
+	MonitorID int64
+	// Query is the query the snapshot was taken with.
+	Query   string
+	Matches []ContentMatch
+}
+
+func (s *codeMonitorStore) GetContentSnapshot(ctx context.Context, monitorID int64) (*ContentSnapshot, error) {
+	rawQuery := `
+	SELECT monitor_id, query, matches
+	FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+
+	q := sqlf.Sprintf(rawQuery, monitorID)
+	var (
+		snapshot    ContentSnapshot
+		matchesJSON []byte
+	)
+	err := s.QueryRow(ctx, q).Scan(&snapshot.MonitorID, &snapshot.Query, &matchesJSON)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(matchesJSON, &snapshot.Matches); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (s *codeMonitorStore) UpsertContentSnapshot(ctx context.Context, monitorID int64, query string, matches []ContentMatch) error {
+	rawQuery := `
+	INSERT INTO cm_content_snapshots (monitor_id, query, matches, updated_at)
+	VALUES (%s, %s, %s, %s)
+	ON CONFLICT (monitor_id) DO UPDATE
+	SET query = EXCLUDED.query,
+		matches = EXCLUDED.matches,
+		updated_at = EXCLUDED.updated_at
+	`
+
+	// Appease non-null constraint on column
+	if matches == nil {
+		matches = []ContentMatch{}
+	}
+	matchesJSON, err := json.Marshal(matches)
+	if err != nil {
+		return err
+	}
+	q := sqlf.Sprintf(rawQuery, monitorID, query, matchesJSON, s.Now())
+	return s.Exec(ctx, q)
+}
@@ -22,6 +22,10 @@ type TriggerJob struct {
 
 	SearchResults []*result.CommitMatch
 
+	// ContentChanges are the matches which were added or removed since the
+	// previous run of a content search query.
+	ContentChanges []ContentChange
+
 	// Fields demanded for any dbworker.
 	State          string
 	FailureMessage *string
@@ -90,6 +94,26 @@ func (s *codeMonitorStore) UpdateTriggerJobWithResults(ctx context.Context, trig
 	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, resultsJSON, triggerJobID))
 }
 
+const logContentSearchFmtStr = `
+UPDATE cm_trigger_jobs
+SET query_string = %s,
+    search_results = '[]'::jsonb,
+    content_changes = %s
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) UpdateTriggerJobWithContentChanges(ctx context.Context, triggerJobID int32, queryString string, changes []ContentChange) error {
+	if changes == nil {
+		changes = []ContentChange{}
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	return s.Store.Exec(ctx, sqlf.Sprintf(logContentSearchFmtStr, queryString, changesJSON, triggerJobID))
+}
+
 const deleteOldJobLogsFmtStr = `
 DELETE FROM cm_trigger_jobs
 WHERE finished_at < (NOW() - (%s * '1 day'::interval));
@@ -151,7 +175,7 @@ func (s *codeMonitorStore) ListQueryTriggerJobs(ctx context.Context, opts ListTr
 const totalCountEventsForQueryIDInt64FmtStr = `
 SELECT COUNT(*)
 FROM cm_trigger_jobs
-WHERE ((state = 'completed' AND jsonb_array_length(search_results) > 0) OR (state != 'completed'))
+WHERE ((state = 'completed' AND (jsonb_array_length(search_results) > 0 OR jsonb_array_length(content_changes) > 0)) OR (state != 'completed'))
 AND query = %s
 `
 
@@ -178,13 +202,14 @@ func scanTriggerJobs(rows *sql.Rows) ([]*TriggerJob, error) {
 }
 
 func ScanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
-	var resultsJSON []byte
+	var resultsJSON, contentChangesJSON []byte
 	m := &TriggerJob{}
 	err := scanner.Scan(
 		&m.ID,
 		&m.Query,
 		&m.QueryString,
 		&resultsJSON,
+		&contentChangesJSON,
 		&m.State,
 		&m.FailureMessage,
 		&m.StartedAt,
@@ -204,6 +229,12 @@ func ScanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
 		}
 	}
 
+	if len(contentChangesJSON) > 0 {
+		if err := json.Unmarshal(contentChangesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
+
 	return m, nil
 }
 
@@ -212,6 +243,7 @@ var TriggerJobsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_trigger_jobs.query"),
 	sqlf.Sprintf("cm_trigger_jobs.query_string"),
 	sqlf.Sprintf("cm_trigger_jobs.search_results"),
+	sqlf.Sprintf("cm_trigger_jobs.content_changes"),
 	sqlf.Sprintf("cm_trigger_jobs.state"),
 	sqlf.Sprintf("cm_trigger_jobs.failure_message"),
 	sqlf.Sprintf("cm_trigger_jobs.started_at"),
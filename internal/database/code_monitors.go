@@ -51,6 +51,7 @@ type CodeMonitorStore interface {
 	CountQueryTriggerJobs(ctx context.Context, queryID int64) (int32, error)
 
 	UpdateTriggerJobWithResults(ctx context.Context, triggerJobID int32, queryString string, results []*result.CommitMatch) error
+	UpdateTriggerJobWithContentChanges(ctx context.Context, triggerJobID int32, queryString string, changes []ContentChange) error
 	DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error
 
 	UpdateEmailAction(_ context.Context, id int64, _ *EmailActionArgs) (*EmailAction, error)
@@ -73,6 +74,13 @@ type CodeMonitorStore interface {
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateChatOpsWebhookAction(_ context.Context, id int64, enabled, includeResults bool, provider ChatOpsProvider, url string) (*ChatOpsWebhookAction, error)
+	CreateChatOpsWebhookAction(ctx context.Context, monitorID int64, enabled, includeResults bool, provider ChatOpsProvider, url string) (*ChatOpsWebhookAction, error)
+	DeleteChatOpsWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountChatOpsWebhookActions(ctx context.Context, monitorID int64) (int, error)
+	GetChatOpsWebhookAction(ctx context.Context, id int64) (*ChatOpsWebhookAction, error)
+	ListChatOpsWebhookActions(context.Context, ListActionsOpts) ([]*ChatOpsWebhookAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -91,6 +99,11 @@ type CodeMonitorStore interface {
 	HasAnyLastSearched(ctx context.Context, monitorID int64) (bool, error)
 	UpsertLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID, lastSearched []string) error
 	GetLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID) ([]string, error)
+
+	// GetContentSnapshot returns the matches of the last run of a code monitor
+	// with a content search query, or nil if it has never run.
+	GetContentSnapshot(ctx context.Context, monitorID int64) (*ContentSnapshot, error)
+	UpsertContentSnapshot(ctx context.Context, monitorID int64, query string, matches []ContentMatch) error
 }
 
 // codeMonitorStore exposes methods to read and write codemonitors domain models
@@ -6213,6 +6213,10 @@ type MockCodeMonitorStore struct {
 	// CountActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountActionJobs.
 	CountActionJobsFunc *CodeMonitorStoreCountActionJobsFunc
+	// CountChatOpsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CountChatOpsWebhookActions.
+	CountChatOpsWebhookActionsFunc *CodeMonitorStoreCountChatOpsWebhookActionsFunc
 	// CountMonitorsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountMonitors.
 	CountMonitorsFunc *CodeMonitorStoreCountMonitorsFunc
@@ -6228,6 +6232,10 @@ type MockCodeMonitorStore struct {
 	// CountWebhookActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountWebhookActions.
 	CountWebhookActionsFunc *CodeMonitorStoreCountWebhookActionsFunc
+	// CreateChatOpsWebhookActionFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateChatOpsWebhookAction.
+	CreateChatOpsWebhookActionFunc *CodeMonitorStoreCreateChatOpsWebhookActionFunc
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
@@ -6246,6 +6254,10 @@ type MockCodeMonitorStore struct {
 	// CreateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateWebhookAction.
 	CreateWebhookActionFunc *CodeMonitorStoreCreateWebhookActionFunc
+	// DeleteChatOpsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteChatOpsWebhookActions.
+	DeleteChatOpsWebhookActionsFunc *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
@@ -6284,6 +6296,12 @@ type MockCodeMonitorStore struct {
 	// GetActionJobMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method GetActionJobMetadata.
 	GetActionJobMetadataFunc *CodeMonitorStoreGetActionJobMetadataFunc
+	// GetChatOpsWebhookActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetChatOpsWebhookAction.
+	GetChatOpsWebhookActionFunc *CodeMonitorStoreGetChatOpsWebhookActionFunc
+	// GetContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method GetContentSnapshot.
+	GetContentSnapshotFunc *CodeMonitorStoreGetContentSnapshotFunc
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
@@ -6315,6 +6333,10 @@ type MockCodeMonitorStore struct {
 	// ListActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListActionJobs.
 	ListActionJobsFunc *CodeMonitorStoreListActionJobsFunc
+	// ListChatOpsWebhookActionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListChatOpsWebhookActions.
+	ListChatOpsWebhookActionsFunc *CodeMonitorStoreListChatOpsWebhookActionsFunc
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
@@ -6346,6 +6368,10 @@ type MockCodeMonitorStore struct {
 	// TransactFunc is an instance of a mock function object controlling the
 	// behavior of the method Transact.
 	TransactFunc *CodeMonitorStoreTransactFunc
+	// UpdateChatOpsWebhookActionFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UpdateChatOpsWebhookAction.
+	UpdateChatOpsWebhookActionFunc *CodeMonitorStoreUpdateChatOpsWebhookActionFunc
 	// UpdateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateEmailAction.
 	UpdateEmailActionFunc *CodeMonitorStoreUpdateEmailActionFunc
@@ -6361,6 +6387,10 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTriggerJobWithContentChangesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// UpdateTriggerJobWithContentChanges.
+	UpdateTriggerJobWithContentChangesFunc *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc
 	// UpdateTriggerJobWithResultsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// UpdateTriggerJobWithResults.
@@ -6368,6 +6398,9 @@ type MockCodeMonitorStore struct {
 	// UpdateWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateWebhookAction.
 	UpdateWebhookActionFunc *CodeMonitorStoreUpdateWebhookActionFunc
+	// UpsertContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method UpsertContentSnapshot.
+	UpsertContentSnapshotFunc *CodeMonitorStoreUpsertContentSnapshotFunc
 	// UpsertLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method UpsertLastSearched.
 	UpsertLastSearchedFunc *CodeMonitorStoreUpsertLastSearchedFunc
@@ -6388,6 +6421,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountChatOpsWebhookActionsFunc: &CodeMonitorStoreCountChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (r0 int32, r1 error) {
 				return
@@ -6413,6 +6451,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateChatOpsWebhookActionFunc: &CodeMonitorStoreCreateChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (r0 *database.ChatOpsWebhookAction, r1 error) {
+				return
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6443,6 +6486,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteChatOpsWebhookActionsFunc: &CodeMonitorStoreDeleteChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: func(context.Context, []int64, int64) (r0 error) {
 				return
@@ -6503,6 +6551,16 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetChatOpsWebhookActionFunc: &CodeMonitorStoreGetChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.ChatOpsWebhookAction, r1 error) {
+				return
+			},
+		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (r0 *database.ContentSnapshot, r1 error) {
+				return
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6553,6 +6611,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListChatOpsWebhookActionsFunc: &CodeMonitorStoreListChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.ChatOpsWebhookAction, r1 error) {
+				return
+			},
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) (r0 []*database.EmailAction, r1 error) {
 				return
@@ -6603,6 +6666,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateChatOpsWebhookActionFunc: &CodeMonitorStoreUpdateChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (r0 *database.ChatOpsWebhookAction, r1 error) {
+				return
+			},
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (r0 *database.EmailAction, r1 error) {
 				return
@@ -6628,6 +6696,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []database.ContentChange) (r0 error) {
+				return
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) (r0 error) {
 				return
@@ -6638,6 +6711,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, string, []database.ContentMatch) (r0 error) {
+				return
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) (r0 error) {
 				return
@@ -6660,6 +6738,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountActionJobs")
 			},
 		},
+		CountChatOpsWebhookActionsFunc: &CodeMonitorStoreCountChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountChatOpsWebhookActions")
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, *int32) (int32, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountMonitors")
@@ -6685,6 +6768,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountWebhookActions")
 			},
 		},
+		CreateChatOpsWebhookActionFunc: &CodeMonitorStoreCreateChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateChatOpsWebhookAction")
+			},
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
@@ -6715,6 +6803,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateWebhookAction")
 			},
 		},
+		DeleteChatOpsWebhookActionsFunc: &CodeMonitorStoreDeleteChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteChatOpsWebhookActions")
+			},
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: func(context.Context, []int64, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
@@ -6775,6 +6868,16 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetActionJobMetadata")
 			},
 		},
+		GetChatOpsWebhookActionFunc: &CodeMonitorStoreGetChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64) (*database.ChatOpsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetChatOpsWebhookAction")
+			},
+		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (*database.ContentSnapshot, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetContentSnapshot")
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
@@ -6825,6 +6928,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListActionJobs")
 			},
 		},
+		ListChatOpsWebhookActionsFunc: &CodeMonitorStoreListChatOpsWebhookActionsFunc{
+			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListChatOpsWebhookActions")
+			},
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: func(context.Context, database.ListActionsOpts) ([]*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
@@ -6875,6 +6983,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.Transact")
 			},
 		},
+		UpdateChatOpsWebhookActionFunc: &CodeMonitorStoreUpdateChatOpsWebhookActionFunc{
+			defaultHook: func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateChatOpsWebhookAction")
+			},
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: func(context.Context, int64, *database.EmailActionArgs) (*database.EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateEmailAction")
@@ -6900,6 +7013,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []database.ContentChange) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithContentChanges")
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithResults")
@@ -6910,6 +7028,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateWebhookAction")
 			},
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, string, []database.ContentMatch) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpsertContentSnapshot")
+			},
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID, []string) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpsertLastSearched")
@@ -6929,6 +7052,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountActionJobsFunc: &CodeMonitorStoreCountActionJobsFunc{
 			defaultHook: i.CountActionJobs,
 		},
+		CountChatOpsWebhookActionsFunc: &CodeMonitorStoreCountChatOpsWebhookActionsFunc{
+			defaultHook: i.CountChatOpsWebhookActions,
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: i.CountMonitors,
 		},
@@ -6944,6 +7070,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CountWebhookActionsFunc: &CodeMonitorStoreCountWebhookActionsFunc{
 			defaultHook: i.CountWebhookActions,
 		},
+		CreateChatOpsWebhookActionFunc: &CodeMonitorStoreCreateChatOpsWebhookActionFunc{
+			defaultHook: i.CreateChatOpsWebhookAction,
+		},
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
@@ -6962,6 +7091,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		CreateWebhookActionFunc: &CodeMonitorStoreCreateWebhookActionFunc{
 			defaultHook: i.CreateWebhookAction,
 		},
+		DeleteChatOpsWebhookActionsFunc: &CodeMonitorStoreDeleteChatOpsWebhookActionsFunc{
+			defaultHook: i.DeleteChatOpsWebhookActions,
+		},
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
@@ -6998,6 +7130,12 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		GetActionJobMetadataFunc: &CodeMonitorStoreGetActionJobMetadataFunc{
 			defaultHook: i.GetActionJobMetadata,
 		},
+		GetChatOpsWebhookActionFunc: &CodeMonitorStoreGetChatOpsWebhookActionFunc{
+			defaultHook: i.GetChatOpsWebhookAction,
+		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: i.GetContentSnapshot,
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
@@ -7028,6 +7166,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		ListActionJobsFunc: &CodeMonitorStoreListActionJobsFunc{
 			defaultHook: i.ListActionJobs,
 		},
+		ListChatOpsWebhookActionsFunc: &CodeMonitorStoreListChatOpsWebhookActionsFunc{
+			defaultHook: i.ListChatOpsWebhookActions,
+		},
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
@@ -7058,6 +7199,9 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		TransactFunc: &CodeMonitorStoreTransactFunc{
 			defaultHook: i.Transact,
 		},
+		UpdateChatOpsWebhookActionFunc: &CodeMonitorStoreUpdateChatOpsWebhookActionFunc{
+			defaultHook: i.UpdateChatOpsWebhookAction,
+		},
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: i.UpdateEmailAction,
 		},
@@ -7073,12 +7217,18 @@ func NewMockCodeMonitorStoreFrom(i database.CodeMonitorStore) *MockCodeMonitorSt
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: i.UpdateTriggerJobWithContentChanges,
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: i.UpdateTriggerJobWithResults,
 		},
 		UpdateWebhookActionFunc: &CodeMonitorStoreUpdateWebhookActionFunc{
 			defaultHook: i.UpdateWebhookAction,
 		},
+		UpsertContentSnapshotFunc: &CodeMonitorStoreUpsertContentSnapshotFunc{
+			defaultHook: i.UpsertContentSnapshot,
+		},
 		UpsertLastSearchedFunc: &CodeMonitorStoreUpsertLastSearchedFunc{
 			defaultHook: i.UpsertLastSearched,
 		},
@@ -7295,6 +7445,118 @@ func (c CodeMonitorStoreCountActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountChatOpsWebhookActionsFunc describes the behavior
+// when the CountChatOpsWebhookActions method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreCountChatOpsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountChatOpsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountChatOpsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountChatOpsWebhookActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountChatOpsWebhookActionsFunc.nextHook()(v0, v1)
+	m.CountChatOpsWebhookActionsFunc.appendCall(CodeMonitorStoreCountChatOpsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CountChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreCountChatOpsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCountChatOpsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCountChatOpsWebhookActionsFunc) History() []CodeMonitorStoreCountChatOpsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountChatOpsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountChatOpsWebhookActionsFuncCall is an object that
+// describes an invocation of method CountChatOpsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreCountChatOpsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountChatOpsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountChatOpsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountMonitorsFunc describes the behavior when the
 // CountMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -7851,6 +8113,130 @@ func (c CodeMonitorStoreCountWebhookActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateChatOpsWebhookActionFunc describes the behavior
+// when the CreateChatOpsWebhookAction method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreCreateChatOpsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)
+	history     []CodeMonitorStoreCreateChatOpsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateChatOpsWebhookAction delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateChatOpsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 database.ChatOpsProvider, v5 string) (*database.ChatOpsWebhookAction, error) {
+	r0, r1 := m.CreateChatOpsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.CreateChatOpsWebhookActionFunc.appendCall(CodeMonitorStoreCreateChatOpsWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) SetDefaultReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) PushReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) appendCall(r0 CodeMonitorStoreCreateChatOpsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreCreateChatOpsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreCreateChatOpsWebhookActionFunc) History() []CodeMonitorStoreCreateChatOpsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateChatOpsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateChatOpsWebhookActionFuncCall is an object that
+// describes an invocation of method CreateChatOpsWebhookAction on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreCreateChatOpsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 database.ChatOpsProvider
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatOpsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateChatOpsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateChatOpsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateEmailActionFunc describes the behavior when the
 // CreateEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -8547,6 +8933,125 @@ func (c CodeMonitorStoreCreateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreDeleteChatOpsWebhookActionsFunc describes the behavior
+// when the DeleteChatOpsWebhookActions method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreDeleteChatOpsWebhookActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteChatOpsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteChatOpsWebhookActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteChatOpsWebhookActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteChatOpsWebhookActionsFunc.appendCall(CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeMonitorStoreDeleteChatOpsWebhookActionsFunc) History() []CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall is an object that
+// describes an invocation of method DeleteChatOpsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteChatOpsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreDeleteEmailActionsFunc describes the behavior when the
 // DeleteEmailActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -9872,6 +10377,229 @@ func (c CodeMonitorStoreGetActionJobMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreGetChatOpsWebhookActionFunc describes the behavior when
+// the GetChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreGetChatOpsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64) (*database.ChatOpsWebhookAction, error)
+	hooks       []func(context.Context, int64) (*database.ChatOpsWebhookAction, error)
+	history     []CodeMonitorStoreGetChatOpsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// GetChatOpsWebhookAction delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetChatOpsWebhookAction(v0 context.Context, v1 int64) (*database.ChatOpsWebhookAction, error) {
+	r0, r1 := m.GetChatOpsWebhookActionFunc.nextHook()(v0, v1)
+	m.GetChatOpsWebhookActionFunc.appendCall(CodeMonitorStoreGetChatOpsWebhookActionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64) (*database.ChatOpsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) PushHook(hook func(context.Context, int64) (*database.ChatOpsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) SetDefaultReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) PushReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) nextHook() func(context.Context, int64) (*database.ChatOpsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) appendCall(r0 CodeMonitorStoreGetChatOpsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreGetChatOpsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreGetChatOpsWebhookActionFunc) History() []CodeMonitorStoreGetChatOpsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetChatOpsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetChatOpsWebhookActionFuncCall is an object that
+// describes an invocation of method GetChatOpsWebhookAction on an instance
+// of MockCodeMonitorStore.
+type CodeMonitorStoreGetChatOpsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatOpsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetChatOpsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetChatOpsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetContentSnapshotFunc describes the behavior when the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64) (*database.ContentSnapshot, error)
+	hooks       []func(context.Context, int64) (*database.ContentSnapshot, error)
+	history     []CodeMonitorStoreGetContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// GetContentSnapshot delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetContentSnapshot(v0 context.Context, v1 int64) (*database.ContentSnapshot, error) {
+	r0, r1 := m.GetContentSnapshotFunc.nextHook()(v0, v1)
+	m.GetContentSnapshotFunc.appendCall(CodeMonitorStoreGetContentSnapshotFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetContentSnapshot
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64) (*database.ContentSnapshot, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushHook(hook func(context.Context, int64) (*database.ContentSnapshot, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultReturn(r0 *database.ContentSnapshot, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*database.ContentSnapshot, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushReturn(r0 *database.ContentSnapshot, r1 error) {
+	f.PushHook(func(context.Context, int64) (*database.ContentSnapshot, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) nextHook() func(context.Context, int64) (*database.ContentSnapshot, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetContentSnapshotFunc) appendCall(r0 CodeMonitorStoreGetContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetContentSnapshotFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) History() []CodeMonitorStoreGetContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetContentSnapshotFuncCall is an object that describes an
+// invocation of method GetContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ContentSnapshot
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreGetEmailActionFunc describes the behavior when the
 // GetEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -10971,6 +11699,118 @@ func (c CodeMonitorStoreListActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreListChatOpsWebhookActionsFunc describes the behavior when
+// the ListChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked.
+type CodeMonitorStoreListChatOpsWebhookActionsFunc struct {
+	defaultHook func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error)
+	hooks       []func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error)
+	history     []CodeMonitorStoreListChatOpsWebhookActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListChatOpsWebhookActions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListChatOpsWebhookActions(v0 context.Context, v1 database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error) {
+	r0, r1 := m.ListChatOpsWebhookActionsFunc.nextHook()(v0, v1)
+	m.ListChatOpsWebhookActionsFunc.appendCall(CodeMonitorStoreListChatOpsWebhookActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) SetDefaultHook(hook func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListChatOpsWebhookActions method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) PushHook(hook func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) SetDefaultReturn(r0 []*database.ChatOpsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) PushReturn(r0 []*database.ChatOpsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) nextHook() func(context.Context, database.ListActionsOpts) ([]*database.ChatOpsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) appendCall(r0 CodeMonitorStoreListChatOpsWebhookActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreListChatOpsWebhookActionsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreListChatOpsWebhookActionsFunc) History() []CodeMonitorStoreListChatOpsWebhookActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListChatOpsWebhookActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListChatOpsWebhookActionsFuncCall is an object that
+// describes an invocation of method ListChatOpsWebhookActions on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreListChatOpsWebhookActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 database.ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*database.ChatOpsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListChatOpsWebhookActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListChatOpsWebhookActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreListEmailActionsFunc describes the behavior when the
 // ListEmailActions method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12064,6 +12904,130 @@ func (c CodeMonitorStoreTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateChatOpsWebhookActionFunc describes the behavior
+// when the UpdateChatOpsWebhookAction method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateChatOpsWebhookActionFunc struct {
+	defaultHook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)
+	hooks       []func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)
+	history     []CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateChatOpsWebhookAction delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateChatOpsWebhookAction(v0 context.Context, v1 int64, v2 bool, v3 bool, v4 database.ChatOpsProvider, v5 string) (*database.ChatOpsWebhookAction, error) {
+	r0, r1 := m.UpdateChatOpsWebhookActionFunc.nextHook()(v0, v1, v2, v3, v4, v5)
+	m.UpdateChatOpsWebhookActionFunc.appendCall(CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall{v0, v1, v2, v3, v4, v5, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) SetDefaultHook(hook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateChatOpsWebhookAction method of the parent MockCodeMonitorStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) PushHook(hook func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) SetDefaultReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) PushReturn(r0 *database.ChatOpsWebhookAction, r1 error) {
+	f.PushHook(func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) nextHook() func(context.Context, int64, bool, bool, database.ChatOpsProvider, string) (*database.ChatOpsWebhookAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) appendCall(r0 CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpdateChatOpsWebhookActionFunc) History() []CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall is an object that
+// describes an invocation of method UpdateChatOpsWebhookAction on an
+// instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 bool
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 database.ChatOpsProvider
+	// Arg5 is the value of the 6th argument passed to this method
+	// invocation.
+	Arg5 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *database.ChatOpsWebhookAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4, c.Arg5}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateChatOpsWebhookActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreUpdateEmailActionFunc describes the behavior when the
 // UpdateEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -12638,6 +13602,121 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc describes the
+// behavior when the UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc struct {
+	defaultHook func(context.Context, int32, string, []database.ContentChange) error
+	hooks       []func(context.Context, int32, string, []database.ContentChange) error
+	history     []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTriggerJobWithContentChanges delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTriggerJobWithContentChanges(v0 context.Context, v1 int32, v2 string, v3 []database.ContentChange) error {
+	r0 := m.UpdateTriggerJobWithContentChangesFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateTriggerJobWithContentChangesFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultHook(hook func(context.Context, int32, string, []database.ContentChange) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushHook(hook func(context.Context, int32, string, []database.ContentChange) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, []database.ContentChange) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, []database.ContentChange) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) nextHook() func(context.Context, int32, string, []database.ContentChange) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) History() []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall is an object
+// that describes an invocation of method UpdateTriggerJobWithContentChanges
+// on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []database.ContentChange
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpdateTriggerJobWithResultsFunc describes the behavior
 // when the UpdateTriggerJobWithResults method of the parent
 // MockCodeMonitorStore instance is invoked.
@@ -12873,6 +13952,121 @@ func (c CodeMonitorStoreUpdateWebhookActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpsertContentSnapshotFunc describes the behavior when the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreUpsertContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64, string, []database.ContentMatch) error
+	hooks       []func(context.Context, int64, string, []database.ContentMatch) error
+	history     []CodeMonitorStoreUpsertContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// UpsertContentSnapshot delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpsertContentSnapshot(v0 context.Context, v1 int64, v2 string, v3 []database.ContentMatch) error {
+	r0 := m.UpsertContentSnapshotFunc.nextHook()(v0, v1, v2, v3)
+	m.UpsertContentSnapshotFunc.appendCall(CodeMonitorStoreUpsertContentSnapshotFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64, string, []database.ContentMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpsertContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) PushHook(hook func(context.Context, int64, string, []database.ContentMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, string, []database.ContentMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, string, []database.ContentMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) nextHook() func(context.Context, int64, string, []database.ContentMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) appendCall(r0 CodeMonitorStoreUpsertContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpsertContentSnapshotFuncCall objects describing the
+// invocations of this function.
+func (f *CodeMonitorStoreUpsertContentSnapshotFunc) History() []CodeMonitorStoreUpsertContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpsertContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpsertContentSnapshotFuncCall is an object that describes
+// an invocation of method UpsertContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpsertContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []database.ContentMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpsertContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpsertContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpsertLastSearchedFunc describes the behavior when the
 // UpsertLastSearched method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -37109,6 +38303,9 @@ type MockGitserverRepoStore struct {
 	// LogCorruptionFunc is an instance of a mock function object
 	// controlling the behavior of the method LogCorruption.
 	LogCorruptionFunc *GitserverRepoStoreLogCorruptionFunc
+	// LogMaintenanceFunc is an instance of a mock function object
+	// controlling the behavior of the method LogMaintenance.
+	LogMaintenanceFunc *GitserverRepoStoreLogMaintenanceFunc
 	// SetCloneStatusFunc is an instance of a mock function object
 	// controlling the behavior of the method SetCloneStatus.
 	SetCloneStatusFunc *GitserverRepoStoreSetCloneStatusFunc
@@ -37197,6 +38394,11 @@ func NewMockGitserverRepoStore() *MockGitserverRepoStore {
 				return
 			},
 		},
+		LogMaintenanceFunc: &GitserverRepoStoreLogMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, types.RepoMaintenanceLog) (r0 error) {
+				return
+			},
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) (r0 error) {
 				return
@@ -37305,6 +38507,11 @@ func NewStrictMockGitserverRepoStore() *MockGitserverRepoStore {
 				panic("unexpected invocation of MockGitserverRepoStore.LogCorruption")
 			},
 		},
+		LogMaintenanceFunc: &GitserverRepoStoreLogMaintenanceFunc{
+			defaultHook: func(context.Context, api.RepoName, types.RepoMaintenanceLog) error {
+				panic("unexpected invocation of MockGitserverRepoStore.LogMaintenance")
+			},
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: func(context.Context, api.RepoName, types.CloneStatus, string) error {
 				panic("unexpected invocation of MockGitserverRepoStore.SetCloneStatus")
@@ -37393,6 +38600,9 @@ func NewMockGitserverRepoStoreFrom(i database.GitserverRepoStore) *MockGitserver
 		LogCorruptionFunc: &GitserverRepoStoreLogCorruptionFunc{
 			defaultHook: i.LogCorruption,
 		},
+		LogMaintenanceFunc: &GitserverRepoStoreLogMaintenanceFunc{
+			defaultHook: i.LogMaintenance,
+		},
 		SetCloneStatusFunc: &GitserverRepoStoreSetCloneStatusFunc{
 			defaultHook: i.SetCloneStatus,
 		},
@@ -38530,6 +39740,117 @@ func (c GitserverRepoStoreLogCorruptionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
+// GitserverRepoStoreLogMaintenanceFunc describes the behavior when the
+// LogMaintenance method of the parent MockGitserverRepoStore instance is
+// invoked.
+type GitserverRepoStoreLogMaintenanceFunc struct {
+	defaultHook func(context.Context, api.RepoName, types.RepoMaintenanceLog) error
+	hooks       []func(context.Context, api.RepoName, types.RepoMaintenanceLog) error
+	history     []GitserverRepoStoreLogMaintenanceFuncCall
+	mutex       sync.Mutex
+}
+
+// LogMaintenance delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockGitserverRepoStore) LogMaintenance(v0 context.Context, v1 api.RepoName, v2 types.RepoMaintenanceLog) error {
+	r0 := m.LogMaintenanceFunc.nextHook()(v0, v1, v2)
+	m.LogMaintenanceFunc.appendCall(GitserverRepoStoreLogMaintenanceFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the LogMaintenance
+// method of the parent MockGitserverRepoStore instance is invoked and the
+// hook queue is empty.
+func (f *GitserverRepoStoreLogMaintenanceFunc) SetDefaultHook(hook func(context.Context, api.RepoName, types.RepoMaintenanceLog) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LogMaintenance method of the parent MockGitserverRepoStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverRepoStoreLogMaintenanceFunc) PushHook(hook func(context.Context, api.RepoName, types.RepoMaintenanceLog) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverRepoStoreLogMaintenanceFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, types.RepoMaintenanceLog) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverRepoStoreLogMaintenanceFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoName, types.RepoMaintenanceLog) error {
+		return r0
+	})
+}
+
+func (f *GitserverRepoStoreLogMaintenanceFunc) nextHook() func(context.Context, api.RepoName, types.RepoMaintenanceLog) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverRepoStoreLogMaintenanceFunc) appendCall(r0 GitserverRepoStoreLogMaintenanceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverRepoStoreLogMaintenanceFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverRepoStoreLogMaintenanceFunc) History() []GitserverRepoStoreLogMaintenanceFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverRepoStoreLogMaintenanceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverRepoStoreLogMaintenanceFuncCall is an object that describes an
+// invocation of method LogMaintenance on an instance of
+// MockGitserverRepoStore.
+type GitserverRepoStoreLogMaintenanceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 types.RepoMaintenanceLog
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c GitserverRepoStoreLogMaintenanceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverRepoStoreLogMaintenanceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // GitserverRepoStoreSetCloneStatusFunc describes the behavior when the
 // SetCloneStatus method of the parent MockGitserverRepoStore instance is
 // invoked.
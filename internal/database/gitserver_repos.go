@@ -37,6 +37,9 @@ type GitserverRepoStore interface {
 	// LogCorruption sets the corrupted at value and logs the corruption reason. Reason will be truncated if it exceeds
 	// MaxReasonSizeInMB
 	LogCorruption(ctx context.Context, name api.RepoName, reason string, shardID string) error
+	// LogMaintenance records a git maintenance run performed on the repo. Only the
+	// 10 most recent runs are kept.
+	LogMaintenance(ctx context.Context, name api.RepoName, log types.RepoMaintenanceLog) error
 	// SetCloneStatus will attempt to update ONLY the clone status of a
 	// GitServerRepo. If a matching row does not yet exist a new one will be created.
 	// If the status value hasn't changed, the row will not be updated.
@@ -59,7 +62,8 @@ type GitserverRepoStore interface {
 	// note that this currently filters out any repos which do not have an associated external service where cloud_default = true.
 	ListReposWithLastError(ctx context.Context) ([]api.RepoName, error)
 	// IteratePurgeableRepos iterates over all purgeable repos. These are repos that
-	// are cloned on disk but have been deleted or blocked.
+	// are cloned on disk or archived to cold storage but have been deleted or
+	// blocked.
 	IteratePurgeableRepos(ctx context.Context, options IteratePurgableReposOptions, repoFn func(repo api.RepoName) error) error
 	// TotalErroredCloudDefaultRepos returns the total number of repos which have a non-empty last_error field. Note that this is only
 	// counting repos with an associated cloud_default external service.
@@ -204,7 +208,7 @@ func (s *gitserverRepoStore) IteratePurgeableRepos(ctx context.Context, options
 	if options.Limit > 0 {
 		query = query + fmt.Sprintf(" LIMIT %d", options.Limit)
 	}
-	rows, err := s.Query(ctx, sqlf.Sprintf(query, deletedAtClause, types.CloneStatusCloned))
+	rows, err := s.Query(ctx, sqlf.Sprintf(query, deletedAtClause, types.CloneStatusCloned, types.CloneStatusArchived))
 	if err != nil {
 		return errors.Wrap(err, "fetching repos with nonempty last_error")
 	}
@@ -234,7 +238,7 @@ FROM repo
 JOIN gitserver_repos gr ON repo.id = gr.repo_id
 WHERE
 	(%s OR repo.blocked IS NOT NULL)
-	AND gr.clone_status = %s
+	AND gr.clone_status IN (%s, %s)
 ORDER BY deleted_at ASC
 `
 
@@ -244,8 +248,10 @@ type IterateRepoGitserverStatusOptions struct {
 	// If true, also include deleted repos. Note that their repo name will start with
 	// 'DELETED-'
 	IncludeDeleted bool
-	BatchSize      int
-	NextCursor     int
+	// If set, will only iterate over repos that were fetched after this time
+	FetchedAfter time.Time
+	BatchSize    int
+	NextCursor   int
 }
 
 func (s *gitserverRepoStore) IterateRepoGitserverStatus(ctx context.Context, options IterateRepoGitserverStatusOptions) (rs []types.RepoGitserverStatus, nextCursor int, err error) {
@@ -259,6 +265,10 @@ func (s *gitserverRepoStore) IterateRepoGitserverStatus(ctx context.Context, opt
 		preds = append(preds, sqlf.Sprintf("gr.shard_id = ''"))
 	}
 
+	if !options.FetchedAfter.IsZero() {
+		preds = append(preds, sqlf.Sprintf("gr.last_fetched > %s", options.FetchedAfter))
+	}
+
 	if options.NextCursor > 0 {
 		preds = append(preds, sqlf.Sprintf("gr.repo_id > %s", options.NextCursor))
 		// Performance improvement: Postgres picks a more optimal strategy when we also constrain
@@ -319,7 +329,8 @@ SELECT
 	gr.repo_size_bytes,
 	gr.updated_at,
 	gr.corrupted_at,
-	gr.corruption_logs
+	gr.corruption_logs,
+	gr.maintenance_logs
 FROM gitserver_repos gr
 JOIN repo ON gr.repo_id = repo.id
 WHERE %s
@@ -352,7 +363,8 @@ SELECT
 	gr.repo_size_bytes,
 	gr.updated_at,
 	gr.corrupted_at,
-	gr.corruption_logs
+	gr.corruption_logs,
+	gr.maintenance_logs
 FROM gitserver_repos gr
 WHERE gr.repo_id = %s
 `
@@ -382,7 +394,8 @@ SELECT
 	gr.repo_size_bytes,
 	gr.updated_at,
 	gr.corrupted_at,
-	gr.corruption_logs
+	gr.corruption_logs,
+	gr.maintenance_logs
 FROM gitserver_repos gr
 JOIN repo r ON r.id = gr.repo_id
 WHERE r.name = %s
@@ -406,7 +419,8 @@ SELECT
 	gr.repo_size_bytes,
 	gr.updated_at,
 	gr.corrupted_at,
-	gr.corruption_logs
+	gr.corruption_logs,
+	gr.maintenance_logs
 FROM gitserver_repos gr
 JOIN repo r on r.id = gr.repo_id
 WHERE r.name = ANY (%s)
@@ -435,6 +449,7 @@ func (s *gitserverRepoStore) GetByNames(ctx context.Context, names ...api.RepoNa
 func scanGitserverRepo(scanner dbutil.Scanner) (*types.GitserverRepo, api.RepoName, error) {
 	var gr types.GitserverRepo
 	var rawLogs []byte
+	var rawMaintenanceLogs []byte
 	var cloneStatus string
 	var repoName api.RepoName
 	err := scanner.Scan(
@@ -450,6 +465,7 @@ func scanGitserverRepo(scanner dbutil.Scanner) (*types.GitserverRepo, api.RepoNa
 		&gr.UpdatedAt,
 		&dbutil.NullTime{Time: &gr.CorruptedAt},
 		&rawLogs,
+		&rawMaintenanceLogs,
 	)
 	if err != nil {
 		return nil, "", errors.Wrap(err, "scanning GitserverRepo")
@@ -461,6 +477,11 @@ func scanGitserverRepo(scanner dbutil.Scanner) (*types.GitserverRepo, api.RepoNa
 	if err != nil {
 		return nil, repoName, errors.Wrap(err, "unmarshal of corruption_logs failed")
 	}
+
+	err = json.Unmarshal(rawMaintenanceLogs, &gr.MaintenanceLogs)
+	if err != nil {
+		return nil, repoName, errors.Wrap(err, "unmarshal of maintenance_logs failed")
+	}
 	return &gr, repoName, nil
 }
 
@@ -619,6 +640,26 @@ AND
 	return nil
 }
 
+func (s *gitserverRepoStore) LogMaintenance(ctx context.Context, name api.RepoName, log types.RepoMaintenanceLog) error {
+	rawLog, err := json.Marshal(log)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal maintenance_logs")
+	}
+
+	err = s.Exec(ctx, sqlf.Sprintf(`
+UPDATE gitserver_repos as gtr
+SET
+	-- prepend the json and then ensure we only keep 10 items in the resulting json array
+	maintenance_logs = (SELECT jsonb_path_query_array(%s||gtr.maintenance_logs, '$[0 to 9]')),
+	updated_at = NOW()
+WHERE
+	repo_id = (SELECT id FROM repo WHERE name = %s)`, rawLog, name))
+	if err != nil {
+		return errors.Wrap(err, "logging repo maintenance")
+	}
+	return nil
+}
+
 // GitserverFetchData is the metadata associated with a fetch operation on
 // gitserver.
 type GitserverFetchData struct {
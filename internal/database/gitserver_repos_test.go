@@ -46,10 +46,12 @@ func TestIterateRepoGitserverStatus(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	lastFetched := time.Now().Add(-time.Hour)
 	if err := db.GitserverRepos().Update(ctx, &types.GitserverRepo{
 		RepoID:      repos[0].ID,
 		ShardID:     "shard-0",
 		CloneStatus: types.CloneStatusCloned,
+		LastFetched: lastFetched,
 	}); err != nil {
 		t.Fatal(err)
 	}
@@ -106,6 +108,11 @@ func TestIterateRepoGitserverStatus(t *testing.T) {
 	t.Run("include deleted, but still only without shard", func(t *testing.T) {
 		assert(t, 2, 2, IterateRepoGitserverStatusOptions{OnlyWithoutShard: true, IncludeDeleted: true})
 	})
+	t.Run("only fetched after", func(t *testing.T) {
+		// repo2 has never been fetched, so its last_fetched defaults to its creation time.
+		assert(t, 2, 2, IterateRepoGitserverStatusOptions{FetchedAfter: lastFetched.Add(-time.Minute)})
+		assert(t, 1, 1, IterateRepoGitserverStatusOptions{FetchedAfter: lastFetched.Add(time.Minute)})
+	})
 }
 
 func TestIteratePurgeableRepos(t *testing.T) {
@@ -118,16 +125,19 @@ func TestIteratePurgeableRepos(t *testing.T) {
 	blockedRepo := &types.Repo{Name: "blocked"}
 	deletedRepo := &types.Repo{Name: "deleted"}
 	notCloned := &types.Repo{Name: "notCloned"}
+	archivedRepo := &types.Repo{Name: "archived"}
 
 	createTestRepos(ctx, t, db, types.Repos{
 		normalRepo,
 		blockedRepo,
 		deletedRepo,
 		notCloned,
+		archivedRepo,
 	})
 	for _, repo := range []*types.Repo{normalRepo, blockedRepo, deletedRepo} {
 		updateTestGitserverRepos(ctx, t, db, false, types.CloneStatusCloned, repo.ID)
 	}
+	updateTestGitserverRepos(ctx, t, db, false, types.CloneStatusArchived, archivedRepo.ID)
 	// Delete & load soft-deleted name of repo
 	if err := db.Repos().Delete(ctx, deletedRepo.ID); err != nil {
 		t.Fatal(err)
@@ -139,8 +149,10 @@ func TestIteratePurgeableRepos(t *testing.T) {
 	deletedRepoName := api.RepoName(deletedRepoNameStr)
 
 	// Blocking a repo is currently done manually
-	if _, err := db.ExecContext(ctx, `UPDATE repo set blocked = '{}' WHERE id = $1`, blockedRepo.ID); err != nil {
-		t.Fatal(err)
+	for _, repo := range []*types.Repo{blockedRepo, archivedRepo} {
+		if _, err := db.ExecContext(ctx, `UPDATE repo set blocked = '{}' WHERE id = $1`, repo.ID); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	for _, tt := range []struct {
@@ -154,7 +166,7 @@ func TestIteratePurgeableRepos(t *testing.T) {
 				DeletedBefore: time.Time{},
 				Limit:         0,
 			},
-			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name},
+			wantRepos: []api.RepoName{deletedRepoName, archivedRepo.Name, blockedRepo.Name},
 		},
 		{
 			name: "deletedBefore now",
@@ -163,7 +175,7 @@ func TestIteratePurgeableRepos(t *testing.T) {
 				Limit:         0,
 			},
 
-			wantRepos: []api.RepoName{deletedRepoName, blockedRepo.Name},
+			wantRepos: []api.RepoName{deletedRepoName, archivedRepo.Name, blockedRepo.Name},
 		},
 		{
 			name: "deletedBefore 5 minutes ago",
@@ -171,7 +183,7 @@ func TestIteratePurgeableRepos(t *testing.T) {
 				DeletedBefore: time.Now().Add(-5 * time.Minute),
 				Limit:         0,
 			},
-			wantRepos: []api.RepoName{blockedRepo.Name},
+			wantRepos: []api.RepoName{archivedRepo.Name, blockedRepo.Name},
 		},
 		{
 			name: "test limit",
@@ -429,7 +441,7 @@ func TestGitserverReposGetByID(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 }
@@ -455,7 +467,7 @@ func TestGitserverReposGetByName(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 }
@@ -495,7 +507,7 @@ func TestGitserverReposGetByNames(t *testing.T) {
 		sort.Slice(haveRepos, func(i, j int) bool {
 			return haveRepos[i].RepoID < haveRepos[j].RepoID
 		})
-		if diff := cmp.Diff(gitserverRepos[:i+1], haveRepos, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+		if diff := cmp.Diff(gitserverRepos[:i+1], haveRepos, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 			t.Fatal(diff)
 		}
 	}
@@ -527,7 +539,7 @@ func TestSetCloneStatus(t *testing.T) {
 
 	gitserverRepo.CloneStatus = types.CloneStatusCloned
 	gitserverRepo.ShardID = shardID
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -554,7 +566,7 @@ func TestSetCloneStatus(t *testing.T) {
 		ShardID:     shardID,
 		CloneStatus: types.CloneStatusCloned,
 	}
-	if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "LastFetched", "LastChanged", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "LastFetched", "LastChanged", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -768,6 +780,49 @@ func TestLogCorruption(t *testing.T) {
 	})
 }
 
+func TestLogMaintenance(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+
+	repo, _ := createTestRepo(ctx, t, db, &createTestRepoPayload{
+		Name:          "github.com/sourcegraph/repo",
+		RepoSizeBytes: 100,
+		CloneStatus:   types.CloneStatusCloned,
+	})
+
+	for i := 0; i < 12; i++ {
+		err := db.GitserverRepos().LogMaintenance(ctx, repo.Name, types.RepoMaintenanceLog{
+			Timestamp: time.Now(),
+			Strategy:  "geometric",
+			Reason:    fmt.Sprintf("test %d", i),
+			Duration:  time.Duration(i) * time.Second,
+			Success:   true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fromDB, err := db.GitserverRepos().GetByID(ctx, repo.ID)
+	if err != nil {
+		t.Fatalf("failed to retrieve repo from db: %s", err)
+	}
+
+	// We added 12 entries but we only keep 10, most recent first.
+	if len(fromDB.MaintenanceLogs) != 10 {
+		t.Fatalf("expected 10 maintenance log entries but got %d", len(fromDB.MaintenanceLogs))
+	}
+	got := fromDB.MaintenanceLogs[0]
+	if got.Reason != "test 11" || got.Strategy != "geometric" || got.Duration != 11*time.Second || !got.Success {
+		t.Errorf("unexpected most recent maintenance log entry %+v", got)
+	}
+}
+
 func TestSetLastError(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -799,7 +854,7 @@ func TestSetLastError(t *testing.T) {
 	}
 
 	gitserverRepo.LastError = "oops"
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -816,7 +871,7 @@ func TestSetLastError(t *testing.T) {
 	}
 
 	gitserverRepo.LastError = emptyErr
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -876,7 +931,7 @@ func TestSetRepoSize(t *testing.T) {
 	gitserverRepo.RepoSizeBytes = 200
 	// If we have size, we can assume it's cloned
 	gitserverRepo.CloneStatus = types.CloneStatusCloned
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -907,7 +962,7 @@ func TestSetRepoSize(t *testing.T) {
 		// If we have size, we can assume it's cloned
 		CloneStatus: types.CloneStatusCloned,
 	}
-	if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "LastFetched", "LastChanged", "CloneStatus", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "LastFetched", "LastChanged", "CloneStatus", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -949,7 +1004,7 @@ func TestGitserverRepo_Update(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -970,7 +1025,7 @@ func TestGitserverRepo_Update(t *testing.T) {
 	// Set LastError to the expected error string but without the null character, because we expect
 	// our code to work and strip it before writing to the DB.
 	gitserverRepo.LastError = "Oops"
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
@@ -983,7 +1038,7 @@ func TestGitserverRepo_Update(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(gitserverRepo, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 }
@@ -1022,7 +1077,7 @@ func TestGitserverRepoUpdateMany(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if diff := cmp.Diff(gitserverRepo1, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+		if diff := cmp.Diff(gitserverRepo1, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 			t.Fatal(diff)
 		}
 	})
@@ -1031,7 +1086,7 @@ func TestGitserverRepoUpdateMany(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+		if diff := cmp.Diff(gitserverRepo2, fromDB, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 			t.Fatal(diff)
 		}
 	})
@@ -1103,7 +1158,7 @@ func TestGitserverUpdateRepoSizes(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		if diff := cmp.Diff(repo, reloaded, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs")); diff != "" {
+		if diff := cmp.Diff(repo, reloaded, cmpopts.IgnoreFields(types.GitserverRepo{}, "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 			t.Fatal(diff)
 		}
 		// Separately make sure UpdatedAt has changed, though
@@ -1174,7 +1229,7 @@ func createTestRepo(ctx context.Context, t *testing.T, db DB, payload *createTes
 		CloneStatus:    types.CloneStatusNotCloned,
 		CorruptionLogs: []types.RepoCorruptionLog{},
 	}
-	if diff := cmp.Diff(want, gitserverRepo, cmpopts.IgnoreFields(types.GitserverRepo{}, "LastFetched", "LastChanged", "UpdatedAt", "CorruptionLogs")); diff != "" {
+	if diff := cmp.Diff(want, gitserverRepo, cmpopts.IgnoreFields(types.GitserverRepo{}, "LastFetched", "LastChanged", "UpdatedAt", "CorruptionLogs", "MaintenanceLogs")); diff != "" {
 		t.Fatal(diff)
 	}
 
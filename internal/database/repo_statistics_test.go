@@ -257,6 +257,51 @@ func TestRepoStatistics_RecloneAndCorruption(t *testing.T) {
 	})
 }
 
+func TestRepoStatistics_Archived(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	db := NewDB(logger, dbtest.NewDB(t))
+	ctx := context.Background()
+	s := &repoStatisticsStore{Store: basestore.NewWithHandle(db.Handle())}
+
+	repos := types.Repos{
+		&types.Repo{Name: "repo1"},
+		&types.Repo{Name: "repo2"},
+	}
+
+	createTestRepos(ctx, t, db, repos)
+
+	setCloneStatus(t, db, repos[0].Name, "shard-1", types.CloneStatusCloned)
+	setCloneStatus(t, db, repos[1].Name, "shard-1", types.CloneStatusCloned)
+	assertRepoStatistics(t, ctx, s, RepoStatistics{
+		Total: 2, Cloned: 2,
+	}, []GitserverReposStatistic{
+		{ShardID: ""},
+		{ShardID: "shard-1", Total: 2, Cloned: 2},
+	})
+
+	// Archived repos are no longer on disk, they are counted as not cloned
+	setCloneStatus(t, db, repos[0].Name, "shard-1", types.CloneStatusArchived)
+	assertRepoStatistics(t, ctx, s, RepoStatistics{
+		Total: 2, Cloned: 1, NotCloned: 1,
+	}, []GitserverReposStatistic{
+		{ShardID: ""},
+		{ShardID: "shard-1", Total: 2, Cloned: 1, NotCloned: 1},
+	})
+
+	// Restoring an archived repo clones it again
+	setCloneStatus(t, db, repos[0].Name, "shard-1", types.CloneStatusCloning)
+	assertRepoStatistics(t, ctx, s, RepoStatistics{
+		Total: 2, Cloned: 1, Cloning: 1,
+	}, []GitserverReposStatistic{
+		{ShardID: ""},
+		{ShardID: "shard-1", Total: 2, Cloned: 1, Cloning: 1},
+	})
+}
+
 func TestRepoStatistics_DeleteAndUndelete(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -549,8 +549,14 @@ func scanRepo(logger log.Logger, rows *sql.Rows, r *types.Repo) (err error) {
 		r.Metadata = &struct{}{}
 	case extsvc.TypeRubyPackages:
 		r.Metadata = &struct{}{}
+	case extsvc.TypeNugetPackages, extsvc.TypePackagistPackages, extsvc.TypeHexPackages, extsvc.TypePubPackages:
+		r.Metadata = &struct{}{}
 	case extsvc.VariantLocalGit.AsType():
 		r.Metadata = new(extsvc.LocalGitMetadata)
+	case extsvc.VariantMercurial.AsType():
+		r.Metadata = new(extsvc.MercurialRepoMetadata)
+	case extsvc.VariantSubversion.AsType():
+		r.Metadata = new(extsvc.SubversionRepoMetadata)
 	default:
 		logger.Warn("unknown service type", log.String("type", typ))
 		return nil
@@ -1036,7 +1042,7 @@ func (s *repoStore) listSQL(ctx context.Context, tr trace.Trace, opt ReposListOp
 	// with gitserver_repos table (checking for such repos that are present in repo but absent in gitserver_repos
 	// table) because repo table is strictly consistent with gitserver_repos table.
 	if opt.NoCloned {
-		where = append(where, sqlf.Sprintf("(gr.clone_status IN ('not_cloned', 'cloning'))"))
+		where = append(where, sqlf.Sprintf("(gr.clone_status IN ('not_cloned', 'cloning', 'archived'))"))
 	}
 	if opt.OnlyCloned {
 		where = append(where, sqlf.Sprintf("gr.clone_status = 'cloned'"))
@@ -646,6 +646,7 @@ func TestRepos_List_cloned(t *testing.T) {
 		{repo: &types.Repo{Name: "repo-0"}, cloneStatus: types.CloneStatusNotCloned},
 		{repo: &types.Repo{Name: "repo-1"}, cloneStatus: types.CloneStatusCloned},
 		{repo: &types.Repo{Name: "repo-2"}, cloneStatus: types.CloneStatusCloning},
+		{repo: &types.Repo{Name: "repo-3"}, cloneStatus: types.CloneStatusArchived},
 	} {
 		repo := mustCreate(ctx, t, db, data.repo)
 		setGitserverRepoCloneStatus(t, db, repo.Name, data.cloneStatus)
@@ -658,12 +659,13 @@ func TestRepos_List_cloned(t *testing.T) {
 		want []*types.Repo
 	}{
 		{"OnlyCloned", ReposListOptions{OnlyCloned: true}, []*types.Repo{repos[1]}},
-		{"NoCloned", ReposListOptions{NoCloned: true}, []*types.Repo{repos[0], repos[2]}},
+		{"NoCloned", ReposListOptions{NoCloned: true}, []*types.Repo{repos[0], repos[2], repos[3]}},
 		{"NoCloned && OnlyCloned", ReposListOptions{NoCloned: true, OnlyCloned: true}, nil},
 		{"Default", ReposListOptions{}, repos},
 		{"CloneStatus=Cloned", ReposListOptions{CloneStatus: types.CloneStatusCloned}, []*types.Repo{repos[1]}},
 		{"CloneStatus=NotCloned", ReposListOptions{CloneStatus: types.CloneStatusNotCloned}, []*types.Repo{repos[0]}},
 		{"CloneStatus=Cloning", ReposListOptions{CloneStatus: types.CloneStatusCloning}, []*types.Repo{repos[2]}},
+		{"CloneStatus=Archived", ReposListOptions{CloneStatus: types.CloneStatusArchived}, []*types.Repo{repos[3]}},
 		// These don't make sense, but we test that both conditions are used
 		{"OnlyCloned && CloneStatus=Cloning", ReposListOptions{OnlyCloned: true, CloneStatus: types.CloneStatusCloning}, nil},
 		{"NoCloned && CloneStatus=Cloned", ReposListOptions{NoCloned: true, CloneStatus: types.CloneStatusCloned}, nil},
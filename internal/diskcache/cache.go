@@ -49,8 +49,10 @@ type store struct {
 
 	// beforeEvict, when non-nil, is a function to call before evicting a file.
 	// It is passed the path to the file to be evicted and an observation.TraceLogger
-	// which can be used to attach fields to a Honeycomb event.
-	beforeEvict func(string, observation.TraceLogger)
+	// which can be used to attach fields to a Honeycomb event. It returns the
+	// number of bytes it freed itself, for example by removing files derived
+	// from the evicted one.
+	beforeEvict func(string, observation.TraceLogger) int64
 
 	observe *operations
 }
@@ -84,7 +86,7 @@ func WithBackgroundTimeout(t time.Duration) func(*store) {
 	return func(s *store) { s.backgroundTimeout = t }
 }
 
-func WithBeforeEvict(f func(string, observation.TraceLogger)) func(*store) {
+func WithBeforeEvict(f func(string, observation.TraceLogger) int64) func(*store) {
 	return func(s *store) { s.beforeEvict = f }
 }
 
@@ -357,7 +359,7 @@ func (s *store) Evict(maxCacheSizeBytes int64) (stats EvictStats, err error) {
 		}
 		path := entry.absPath
 		if s.beforeEvict != nil {
-			s.beforeEvict(path, trace)
+			size -= s.beforeEvict(path, trace)
 		}
 		err = os.Remove(path)
 		if err != nil {
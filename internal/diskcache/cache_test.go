@@ -155,3 +155,42 @@ func TestEvict(t *testing.T) {
 	// disckcache.
 	expect(0, 1, 3)
 }
+
+func TestEvict_BeforeEvict(t *testing.T) {
+	dir := t.TempDir()
+
+	// Each entry has a derived file which is removed together with it.
+	store := &store{
+		dir:       dir,
+		component: "test",
+		observe:   newOperations(&observation.TestContext, "test"),
+		beforeEvict: func(path string, _ observation.TraceLogger) int64 {
+			if err := os.Remove(path + ".derived"); err != nil {
+				t.Fatal(err)
+			}
+			return 1
+		},
+	}
+
+	for _, name := range []string{"key-first", "key-second"} {
+		f, err := store.Open(context.Background(), []string{name}, func(ctx context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader([]byte("x"))), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Close()
+		if err := os.WriteFile(f.Path+".derived", []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Evicting the first entry frees 2 bytes, which is enough.
+	stats, err := store.Evict(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.CacheSize != 4 || stats.Evicted != 1 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
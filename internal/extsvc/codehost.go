@@ -15,7 +15,8 @@ type CodeHost struct {
 
 func (c *CodeHost) IsPackageHost() bool {
 	switch c.ServiceType {
-	case TypeNpmPackages, TypeJVMPackages, TypeGoModules, TypePythonPackages, TypeRustPackages, TypeRubyPackages:
+	case TypeNpmPackages, TypeJVMPackages, TypeGoModules, TypePythonPackages, TypeRustPackages, TypeRubyPackages,
+		TypeNugetPackages, TypePackagistPackages, TypeHexPackages, TypePubPackages:
 		return true
 	}
 	return false
@@ -49,6 +50,18 @@ var (
 	RubyURL      = &url.URL{Host: "rubygems"}
 	RubyPackages = NewCodeHost(RubyURL, TypeRubyPackages)
 
+	DotnetURL      = &url.URL{Host: "nuget"}
+	DotnetPackages = NewCodeHost(DotnetURL, TypeNugetPackages)
+
+	PhpURL      = &url.URL{Host: "packagist"}
+	PhpPackages = NewCodeHost(PhpURL, TypePackagistPackages)
+
+	ElixirURL      = &url.URL{Host: "hex"}
+	ElixirPackages = NewCodeHost(ElixirURL, TypeHexPackages)
+
+	DartURL      = &url.URL{Host: "pub"}
+	DartPackages = NewCodeHost(DartURL, TypePubPackages)
+
 	PublicCodeHosts = []*CodeHost{
 		GitHubDotCom,
 		GitLabDotCom,
@@ -58,6 +71,10 @@ var (
 		PythonPackages,
 		RustPackages,
 		RubyPackages,
+		DotnetPackages,
+		PhpPackages,
+		ElixirPackages,
+		DartPackages,
 	}
 )
 
@@ -0,0 +1,50 @@
+package hex
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+)
+
+func newTestClient(t *testing.T, repositoryURL string) *Client {
+	t.Helper()
+	client, err := NewClient("hex_urn", repositoryURL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+	client.limiter = ratelimit.NewInstrumentedLimiter("hex", rate.NewLimiter(100, 10))
+	return client
+}
+
+func TestGetPackageContents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tarballs/phoenix-1.7.7.tar" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("tar"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv.URL+"/")
+	ctx := context.Background()
+
+	body, err := client.GetPackageContents(ctx, reposource.NewElixirVersionedPackage("phoenix", "1.7.7"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "tar", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewElixirVersionedPackage("phoenix", "0.0.0"))
+	require.Error(t, err)
+	require.True(t, errcode.IsNotFound(err))
+}
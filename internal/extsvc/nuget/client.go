@@ -0,0 +1,100 @@
+package nuget
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRegistryURL is the package content resource of nuget.org.
+const DefaultRegistryURL = "https://api.nuget.org/v3-flatcontainer/"
+
+type Client struct {
+	// registryURL is the URL of the package content resource, also known as
+	// the flat container, of a NuGet V3 registry.
+	registryURL string
+
+	uncachedClient httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, registryURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	if registryURL == "" {
+		registryURL = DefaultRegistryURL
+	}
+	return &Client{
+		registryURL:    registryURL,
+		uncachedClient: uncached,
+		limiter:        ratelimit.NewInstrumentedLimiter(urn, ratelimit.NewGlobalRateLimiter(log.Scoped("NuGetClient"), urn)),
+	}, nil
+}
+
+// GetPackageContents downloads the .nupkg archive of the given package version.
+// The package content resource only serves lower case IDs and versions.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	id := strings.ToLower(string(dep.PackageSyntax()))
+	version := strings.ToLower(dep.PackageVersion())
+	url := fmt.Sprintf("%s/%s/%s/%s.%s.nupkg", strings.TrimSuffix(c.registryURL, "/"), id, version, id, version)
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-nuget-syncer (sourcegraph.com)")
+
+	body, err = c.do(c.uncachedClient, req)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,50 @@
+package nuget
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+)
+
+func newTestClient(t *testing.T, registryURL string) *Client {
+	t.Helper()
+	client, err := NewClient("nuget_urn", registryURL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+	client.limiter = ratelimit.NewInstrumentedLimiter("nuget", rate.NewLimiter(100, 10))
+	return client
+}
+
+func TestGetPackageContents(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v3-flatcontainer/newtonsoft.json/13.0.3-beta1/newtonsoft.json.13.0.3-beta1.nupkg" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("nupkg"))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv.URL+"/v3-flatcontainer/")
+	ctx := context.Background()
+
+	body, err := client.GetPackageContents(ctx, reposource.NewDotnetVersionedPackage("Newtonsoft.Json", "13.0.3-Beta1"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "nupkg", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewDotnetVersionedPackage("newtonsoft.json", "0.0.0"))
+	require.Error(t, err)
+	require.True(t, errcode.IsNotFound(err))
+}
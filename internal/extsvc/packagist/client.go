@@ -0,0 +1,189 @@
+package packagist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the Composer repository of packagist.org.
+const DefaultRepositoryURL = "https://repo.packagist.org/"
+
+type Client struct {
+	repositoryURL string
+
+	uncachedClient httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+	return &Client{
+		repositoryURL:  repositoryURL,
+		uncachedClient: uncached,
+		limiter:        ratelimit.NewInstrumentedLimiter(urn, ratelimit.NewGlobalRateLimiter(log.Scoped("PackagistClient"), urn)),
+	}, nil
+}
+
+// Dist is the distribution archive of a package version.
+type Dist struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// Version is a single version of a package, as listed in the Composer v2
+// metadata of the package.
+type Version struct {
+	Version           string `json:"version"`
+	VersionNormalized string `json:"version_normalized"`
+	Dist              *Dist  `json:"dist"`
+}
+
+// Versions returns the tagged versions of the package with the given name.
+func (c *Client) Versions(ctx context.Context, name reposource.PackageName) ([]Version, error) {
+	url := fmt.Sprintf("%s/p2/%s.json", strings.TrimSuffix(c.repositoryURL, "/"), name)
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var metadata struct {
+		Packages map[string][]map[string]json.RawMessage `json:"packages"`
+		Minified string                                  `json:"minified"`
+	}
+	if err := json.NewDecoder(body).Decode(&metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode metadata of package %q", name)
+	}
+
+	entries := metadata.Packages[string(name)]
+	if metadata.Minified != "" {
+		entries = expandMinifiedVersions(entries)
+	}
+
+	versions := make([]Version, 0, len(entries))
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		var v Version
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode version of package %q", name)
+		}
+		versions = append(versions, v)
+	}
+	return versions, nil
+}
+
+// expandMinifiedVersions expands the versions of the minified Composer v2
+// metadata format, in which every version only lists the fields that differ
+// from the previous one, and removed fields are set to "__unset".
+func expandMinifiedVersions(minified []map[string]json.RawMessage) []map[string]json.RawMessage {
+	expanded := make([]map[string]json.RawMessage, 0, len(minified))
+	var previous map[string]json.RawMessage
+	for _, entry := range minified {
+		current := make(map[string]json.RawMessage, len(previous)+len(entry))
+		for field, value := range previous {
+			current[field] = value
+		}
+		for field, value := range entry {
+			if string(value) == `"__unset"` {
+				delete(current, field)
+				continue
+			}
+			current[field] = value
+		}
+		expanded = append(expanded, current)
+		previous = current
+	}
+	return expanded
+}
+
+// GetPackageContents downloads the distribution archive of the given package
+// version.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	versions, err := c.Versions(ctx, dep.PackageSyntax())
+	if err != nil {
+		return nil, err
+	}
+
+	want := strings.TrimPrefix(dep.PackageVersion(), "v")
+	for _, v := range versions {
+		if strings.TrimPrefix(v.Version, "v") != want {
+			continue
+		}
+		if v.Dist == nil || v.Dist.URL == "" {
+			return nil, errors.Newf("package %q has no distribution archive", dep.VersionedPackageSyntax())
+		}
+		if v.Dist.Type != "zip" {
+			return nil, errors.Newf("unsupported distribution archive type %q of package %q", v.Dist.Type, dep.VersionedPackageSyntax())
+		}
+		return c.get(ctx, v.Dist.URL)
+	}
+
+	return nil, &Error{path: string(dep.PackageSyntax()), code: http.StatusNotFound, message: fmt.Sprintf("version %q not found", dep.PackageVersion())}
+}
+
+func (c *Client) get(ctx context.Context, url string) (io.ReadCloser, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-packagist-syncer (sourcegraph.com)")
+
+	return c.do(c.uncachedClient, req)
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,106 @@
+package packagist
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+)
+
+func newTestClient(t *testing.T, repositoryURL string) *Client {
+	t.Helper()
+	client, err := NewClient("packagist_urn", repositoryURL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+	client.limiter = ratelimit.NewInstrumentedLimiter("packagist", rate.NewLimiter(100, 10))
+	return client
+}
+
+// minifiedMetadata is the metadata of a package in the minified Composer v2
+// format, as served by repo.packagist.org.
+const minifiedMetadata = `{
+  "packages": {
+    "monolog/monolog": [
+      {
+        "name": "monolog/monolog",
+        "description": "Sends your logs to files, sockets, inboxes, databases and various web services",
+        "version": "3.4.0",
+        "version_normalized": "3.4.0.0",
+        "dist": {"type": "zip", "url": "{{URL}}/dist/monolog-3.4.0.zip"}
+      },
+      {
+        "version": "3.3.1",
+        "version_normalized": "3.3.1.0",
+        "description": "__unset",
+        "dist": {"type": "zip", "url": "{{URL}}/dist/monolog-3.3.1.zip"}
+      },
+      {
+        "version": "v1.0.0",
+        "version_normalized": "1.0.0.0",
+        "dist": "__unset"
+      }
+    ]
+  },
+  "minified": "composer/2.0"
+}`
+
+func newTestRepository(t *testing.T) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/p2/monolog/monolog.json":
+			_, _ = io.WriteString(w, strings.ReplaceAll(minifiedMetadata, "{{URL}}", srv.URL))
+		case "/dist/monolog-3.3.1.zip":
+			_, _ = io.WriteString(w, "zip")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVersions(t *testing.T) {
+	srv := newTestRepository(t)
+	client := newTestClient(t, srv.URL)
+
+	versions, err := client.Versions(context.Background(), "monolog/monolog")
+	require.NoError(t, err)
+	require.Equal(t, []Version{
+		{Version: "3.4.0", VersionNormalized: "3.4.0.0", Dist: &Dist{Type: "zip", URL: srv.URL + "/dist/monolog-3.4.0.zip"}},
+		{Version: "3.3.1", VersionNormalized: "3.3.1.0", Dist: &Dist{Type: "zip", URL: srv.URL + "/dist/monolog-3.3.1.zip"}},
+		{Version: "v1.0.0", VersionNormalized: "1.0.0.0"},
+	}, versions)
+}
+
+func TestGetPackageContents(t *testing.T) {
+	srv := newTestRepository(t)
+	client := newTestClient(t, srv.URL+"/")
+	ctx := context.Background()
+
+	body, err := client.GetPackageContents(ctx, reposource.NewPhpVersionedPackage("monolog/monolog", "v3.3.1"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "zip", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewPhpVersionedPackage("monolog/monolog", "1.0.0"))
+	require.Error(t, err)
+
+	_, err = client.GetPackageContents(ctx, reposource.NewPhpVersionedPackage("monolog/monolog", "9.9.9"))
+	require.True(t, errcode.IsNotFound(err))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewPhpVersionedPackage("psr/log", "3.0.0"))
+	require.True(t, errcode.IsNotFound(err))
+}
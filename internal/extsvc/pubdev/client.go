@@ -0,0 +1,141 @@
+package pubdev
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DefaultRepositoryURL is the repository of pub.dev.
+const DefaultRepositoryURL = "https://pub.dev/"
+
+type Client struct {
+	repositoryURL string
+
+	uncachedClient httpcli.Doer
+
+	// Self-imposed rate-limiter.
+	limiter *ratelimit.InstrumentedLimiter
+}
+
+func NewClient(urn string, repositoryURL string, httpfactory *httpcli.Factory) (*Client, error) {
+	uncached, err := httpfactory.Doer(httpcli.NewCachedTransportOpt(httpcli.NoopCache{}, false))
+	if err != nil {
+		return nil, err
+	}
+	if repositoryURL == "" {
+		repositoryURL = DefaultRepositoryURL
+	}
+	return &Client{
+		repositoryURL:  repositoryURL,
+		uncachedClient: uncached,
+		limiter:        ratelimit.NewInstrumentedLimiter(urn, ratelimit.NewGlobalRateLimiter(log.Scoped("PubClient"), urn)),
+	}, nil
+}
+
+// Version is a single version of a package, as listed by the hosted pub
+// repository API.
+type Version struct {
+	Version    string `json:"version"`
+	ArchiveURL string `json:"archive_url"`
+}
+
+// Versions returns the versions of the package with the given name.
+func (c *Client) Versions(ctx context.Context, name reposource.PackageName) ([]Version, error) {
+	body, err := c.get(ctx, fmt.Sprintf("%s/api/packages/%s", strings.TrimSuffix(c.repositoryURL, "/"), name))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var listing struct {
+		Versions []Version `json:"versions"`
+	}
+	if err := json.NewDecoder(body).Decode(&listing); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode versions of package %q", name)
+	}
+	return listing.Versions, nil
+}
+
+// GetPackageContents downloads the archive of the given package version.
+func (c *Client) GetPackageContents(ctx context.Context, dep reposource.VersionedPackage) (body io.ReadCloser, err error) {
+	versions, err := c.Versions(ctx, dep.PackageSyntax())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		if v.Version != dep.PackageVersion() {
+			continue
+		}
+		// The archive URL may be relative to the repository URL.
+		base, err := url.Parse(c.repositoryURL)
+		if err != nil {
+			return nil, err
+		}
+		archiveURL, err := base.Parse(v.ArchiveURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid archive URL of package %q", dep.VersionedPackageSyntax())
+		}
+		return c.get(ctx, archiveURL.String())
+	}
+
+	return nil, &Error{path: string(dep.PackageSyntax()), code: http.StatusNotFound, message: fmt.Sprintf("version %q not found", dep.PackageVersion())}
+}
+
+func (c *Client) get(ctx context.Context, url string) (io.ReadCloser, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", "sourcegraph-pub-syncer (sourcegraph.com)")
+	req.Header.Add("Accept", "application/vnd.pub.v2+json")
+
+	return c.do(c.uncachedClient, req)
+}
+
+type Error struct {
+	path    string
+	code    int
+	message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("bad response with status code %d for %s: %s", e.code, e.path, e.message)
+}
+
+func (e *Error) NotFound() bool {
+	return e.code == http.StatusNotFound
+}
+
+func (c *Client) do(doer httpcli.Doer, req *http.Request) (io.ReadCloser, error) {
+	resp, err := doer.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			bs = []byte(errors.Wrap(err, "failed to read body").Error())
+		}
+		return nil, &Error{path: req.URL.Path, code: resp.StatusCode, message: string(bs)}
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,72 @@
+package pubdev
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+)
+
+func newTestClient(t *testing.T, repositoryURL string) *Client {
+	t.Helper()
+	client, err := NewClient("pub_urn", repositoryURL, httpcli.NewFactory(nil))
+	require.NoError(t, err)
+	client.limiter = ratelimit.NewInstrumentedLimiter("pub", rate.NewLimiter(100, 10))
+	return client
+}
+
+func TestGetPackageContents(t *testing.T) {
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/packages/http":
+			_, _ = io.WriteString(w, `{
+  "name": "http",
+  "versions": [
+    {"version": "1.0.0", "archive_url": "`+srv.URL+`/api/archives/http-1.0.0.tar.gz"},
+    {"version": "1.1.0", "archive_url": "/api/archives/http-1.1.0.tar.gz"}
+  ]
+}`)
+		case "/api/archives/http-1.1.0.tar.gz":
+			_, _ = io.WriteString(w, "tgz")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client := newTestClient(t, srv.URL)
+	ctx := context.Background()
+
+	versions, err := client.Versions(ctx, "http")
+	require.NoError(t, err)
+	require.Equal(t, []Version{
+		{Version: "1.0.0", ArchiveURL: srv.URL + "/api/archives/http-1.0.0.tar.gz"},
+		{Version: "1.1.0", ArchiveURL: "/api/archives/http-1.1.0.tar.gz"},
+	}, versions)
+
+	body, err := client.GetPackageContents(ctx, reposource.NewDartVersionedPackage("http", "1.1.0"))
+	require.NoError(t, err)
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	require.NoError(t, err)
+	require.Equal(t, "tgz", string(contents))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewDartVersionedPackage("http", "1.0.0"))
+	require.True(t, errcode.IsNotFound(err))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewDartVersionedPackage("http", "9.9.9"))
+	require.True(t, errcode.IsNotFound(err))
+
+	_, err = client.GetPackageContents(ctx, reposource.NewDartVersionedPackage("path", "1.8.3"))
+	require.True(t, errcode.IsNotFound(err))
+}
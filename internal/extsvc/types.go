@@ -212,6 +212,26 @@ const (
 
 	// VariantLocalGit is the (api.ExternalRepoSpec).ServiceType for local git repositories
 	VariantLocalGit
+
+	// VariantMercurial is the (api.ExternalRepoSpec).ServiceType value for Mercurial repositories. The
+	// ServiceID value is the base URL of the Mercurial server.
+	VariantMercurial
+
+	// VariantSubversion is the (api.ExternalRepoSpec).ServiceType value for Subversion repositories. The
+	// ServiceID value is the base URL of the Subversion server.
+	VariantSubversion
+
+	// VariantDotnetPackages is the (api.ExternalRepoSpec).ServiceType value for .NET packages hosted on NuGet registries.
+	VariantDotnetPackages
+
+	// VariantPhpPackages is the (api.ExternalRepoSpec).ServiceType value for PHP packages hosted on Composer repositories.
+	VariantPhpPackages
+
+	// VariantElixirPackages is the (api.ExternalRepoSpec).ServiceType value for Elixir packages hosted on Hex repositories.
+	VariantElixirPackages
+
+	// VariantDartPackages is the (api.ExternalRepoSpec).ServiceType value for Dart packages hosted on pub repositories.
+	VariantDartPackages
 )
 
 type variantValues struct {
@@ -227,21 +247,27 @@ var variantValuesMap = map[Variant]variantValues{
 	VariantAzureDevOps:     {AsKind: "AZUREDEVOPS", AsType: "azuredevops", ConfigPrototype: func() any { return &schema.AzureDevOpsConnection{} }, SupportsRepoExclusion: true},
 	VariantBitbucketCloud:  {AsKind: "BITBUCKETCLOUD", AsType: "bitbucketCloud", ConfigPrototype: func() any { return &schema.BitbucketCloudConnection{} }, WebhookURLPath: "bitbucket-cloud-webhooks", SupportsRepoExclusion: true},
 	VariantBitbucketServer: {AsKind: "BITBUCKETSERVER", AsType: "bitbucketServer", ConfigPrototype: func() any { return &schema.BitbucketServerConnection{} }, WebhookURLPath: "bitbucket-server-webhooks", SupportsRepoExclusion: true},
+	VariantDartPackages:    {AsKind: "DARTPACKAGES", AsType: "dartPackages", ConfigPrototype: func() any { return &schema.DartPackagesConnection{} }},
+	VariantDotnetPackages:  {AsKind: "DOTNETPACKAGES", AsType: "dotnetPackages", ConfigPrototype: func() any { return &schema.DotnetPackagesConnection{} }},
+	VariantElixirPackages:  {AsKind: "ELIXIRPACKAGES", AsType: "elixirPackages", ConfigPrototype: func() any { return &schema.ElixirPackagesConnection{} }},
 	VariantGerrit:          {AsKind: "GERRIT", AsType: "gerrit", ConfigPrototype: func() any { return &schema.GerritConnection{} }},
 	VariantGitHub:          {AsKind: "GITHUB", AsType: "github", ConfigPrototype: func() any { return &schema.GitHubConnection{} }, WebhookURLPath: "github-webhooks", SupportsRepoExclusion: true},
 	VariantGitLab:          {AsKind: "GITLAB", AsType: "gitlab", ConfigPrototype: func() any { return &schema.GitLabConnection{} }, WebhookURLPath: "gitlab-webhooks", SupportsRepoExclusion: true},
 	VariantGitolite:        {AsKind: "GITOLITE", AsType: "gitolite", ConfigPrototype: func() any { return &schema.GitoliteConnection{} }, SupportsRepoExclusion: true},
 	VariantGoPackages:      {AsKind: "GOMODULES", AsType: "goModules", ConfigPrototype: func() any { return &schema.GoModulesConnection{} }},
 	VariantJVMPackages:     {AsKind: "JVMPACKAGES", AsType: "jvmPackages", ConfigPrototype: func() any { return &schema.JVMPackagesConnection{} }},
+	VariantMercurial:       {AsKind: "MERCURIAL", AsType: "mercurial", ConfigPrototype: func() any { return &schema.MercurialConnection{} }},
 	VariantNpmPackages:     {AsKind: "NPMPACKAGES", AsType: "npmPackages", ConfigPrototype: func() any { return &schema.NpmPackagesConnection{} }},
 	VariantOther:           {AsKind: "OTHER", AsType: "other", ConfigPrototype: func() any { return &schema.OtherExternalServiceConnection{} }},
 	VariantPagure:          {AsKind: "PAGURE", AsType: "pagure", ConfigPrototype: func() any { return &schema.PagureConnection{} }},
 	VariantPerforce:        {AsKind: "PERFORCE", AsType: "perforce", ConfigPrototype: func() any { return &schema.PerforceConnection{} }},
 	VariantPhabricator:     {AsKind: "PHABRICATOR", AsType: "phabricator", ConfigPrototype: func() any { return &schema.PhabricatorConnection{} }},
+	VariantPhpPackages:     {AsKind: "PHPPACKAGES", AsType: "phpPackages", ConfigPrototype: func() any { return &schema.PhpPackagesConnection{} }},
 	VariantPythonPackages:  {AsKind: "PYTHONPACKAGES", AsType: "pythonPackages", ConfigPrototype: func() any { return &schema.PythonPackagesConnection{} }},
 	VariantRubyPackages:    {AsKind: "RUBYPACKAGES", AsType: "rubyPackages", ConfigPrototype: func() any { return &schema.RubyPackagesConnection{} }},
 	VariantRustPackages:    {AsKind: "RUSTPACKAGES", AsType: "rustPackages", ConfigPrototype: func() any { return &schema.RustPackagesConnection{} }},
 	VariantSCIM:            {AsKind: "SCIM", AsType: "scim"},
+	VariantSubversion:      {AsKind: "SUBVERSION", AsType: "subversion", ConfigPrototype: func() any { return &schema.SubversionConnection{} }},
 	VariantLocalGit:        {AsKind: "LOCALGIT", AsType: "localgit", ConfigPrototype: func() any { return &schema.LocalGitExternalService{} }},
 }
 
@@ -291,25 +317,29 @@ var (
 	// The constants below represent the different kinds of external service we support and should be used
 	// in preference to the Type values below.
 
-	KindAWSCodeCommit   = VariantAWSCodeCommit.AsKind()
-	KindBitbucketServer = VariantBitbucketServer.AsKind()
-	KindBitbucketCloud  = VariantBitbucketCloud.AsKind()
-	KindGerrit          = VariantGerrit.AsKind()
-	KindGitHub          = VariantGitHub.AsKind()
-	KindGitLab          = VariantGitLab.AsKind()
-	KindGitolite        = VariantGitolite.AsKind()
-	KindPerforce        = VariantPerforce.AsKind()
-	KindPhabricator     = VariantPhabricator.AsKind()
-	KindGoPackages      = VariantGoPackages.AsKind()
-	KindJVMPackages     = VariantJVMPackages.AsKind()
-	KindPythonPackages  = VariantPythonPackages.AsKind()
-	KindRustPackages    = VariantRustPackages.AsKind()
-	KindRubyPackages    = VariantRubyPackages.AsKind()
-	KindNpmPackages     = VariantNpmPackages.AsKind()
-	KindPagure          = VariantPagure.AsKind()
-	KindAzureDevOps     = VariantAzureDevOps.AsKind()
-	KindSCIM            = VariantSCIM.AsKind()
-	KindOther           = VariantOther.AsKind()
+	KindAWSCodeCommit     = VariantAWSCodeCommit.AsKind()
+	KindBitbucketServer   = VariantBitbucketServer.AsKind()
+	KindBitbucketCloud    = VariantBitbucketCloud.AsKind()
+	KindGerrit            = VariantGerrit.AsKind()
+	KindGitHub            = VariantGitHub.AsKind()
+	KindGitLab            = VariantGitLab.AsKind()
+	KindGitolite          = VariantGitolite.AsKind()
+	KindPerforce          = VariantPerforce.AsKind()
+	KindPhabricator       = VariantPhabricator.AsKind()
+	KindGoPackages        = VariantGoPackages.AsKind()
+	KindJVMPackages       = VariantJVMPackages.AsKind()
+	KindPythonPackages    = VariantPythonPackages.AsKind()
+	KindRustPackages      = VariantRustPackages.AsKind()
+	KindRubyPackages      = VariantRubyPackages.AsKind()
+	KindNpmPackages       = VariantNpmPackages.AsKind()
+	KindNugetPackages     = VariantDotnetPackages.AsKind()
+	KindPackagistPackages = VariantPhpPackages.AsKind()
+	KindHexPackages       = VariantElixirPackages.AsKind()
+	KindPubPackages       = VariantDartPackages.AsKind()
+	KindPagure            = VariantPagure.AsKind()
+	KindAzureDevOps       = VariantAzureDevOps.AsKind()
+	KindSCIM              = VariantSCIM.AsKind()
+	KindOther             = VariantOther.AsKind()
 )
 
 // TODO: Deprecated: use Variant with its `AsType()` function instead
@@ -373,6 +403,18 @@ var (
 	// TypeRubyPackages is the (api.ExternalRepoSpec).ServiceType value for Ruby packages.
 	TypeRubyPackages = VariantRubyPackages.AsType()
 
+	// TypeNugetPackages is the (api.ExternalRepoSpec).ServiceType value for NuGet packages (.NET ecosystem libraries).
+	TypeNugetPackages = VariantDotnetPackages.AsType()
+
+	// TypePackagistPackages is the (api.ExternalRepoSpec).ServiceType value for Packagist packages (PHP ecosystem libraries).
+	TypePackagistPackages = VariantPhpPackages.AsType()
+
+	// TypeHexPackages is the (api.ExternalRepoSpec).ServiceType value for Hex packages (Elixir/Erlang ecosystem libraries).
+	TypeHexPackages = VariantElixirPackages.AsType()
+
+	// TypePubPackages is the (api.ExternalRepoSpec).ServiceType value for pub.dev packages (Dart/Flutter ecosystem libraries).
+	TypePubPackages = VariantDartPackages.AsType()
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = VariantOther.AsType()
 )
@@ -672,6 +714,30 @@ func GetLimitFromConfig(config any, kind string) (limit rate.Limit, isDefault bo
 			isDefault = false
 			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
 		}
+	case *schema.DotnetPackagesConnection:
+		limit = GetDefaultRateLimit(KindNugetPackages)
+		if c != nil && c.RateLimit != nil {
+			isDefault = false
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.PhpPackagesConnection:
+		limit = GetDefaultRateLimit(KindPackagistPackages)
+		if c != nil && c.RateLimit != nil {
+			isDefault = false
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.ElixirPackagesConnection:
+		limit = GetDefaultRateLimit(KindHexPackages)
+		if c != nil && c.RateLimit != nil {
+			isDefault = false
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
+	case *schema.DartPackagesConnection:
+		limit = GetDefaultRateLimit(KindPubPackages)
+		if c != nil && c.RateLimit != nil {
+			isDefault = false
+			limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+		}
 	default:
 		return limit, isDefault, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -716,6 +782,26 @@ func GetDefaultRateLimit(kind string) rate.Limit {
 	case KindRubyPackages:
 		// The rubygems.org API allows 10 rps https://guides.rubygems.org/rubygems-org-rate-limits/
 		return rate.Limit(10)
+	case KindNugetPackages:
+		// The nuget.org rate limits https://learn.microsoft.com/en-us/nuget/api/rate-limits
+		// only cover the search and push endpoints. The package content resource
+		// (v3-flatcontainer) we download from is served from a CDN and isn't limited.
+		return rate.Limit(100)
+	case KindPackagistPackages:
+		// The Packagist API docs https://packagist.org/apidoc don't document a numeric
+		// limit for the p2 metadata on repo.packagist.org but ask clients to keep the
+		// request volume reasonable, so we stay as conservative as for rubygems.org.
+		return rate.Limit(10)
+	case KindHexPackages:
+		// The 100 req/min limit of the Hex API https://github.com/hexpm/specifications
+		// applies to hex.pm/api only. The tarballs we fetch from repo.hex.pm are
+		// served from a CDN without a documented rate limit.
+		return rate.Limit(100)
+	case KindPubPackages:
+		// The hosted pub repository spec https://github.com/dart-lang/pub/blob/master/doc/repository-spec-v2.md
+		// doesn't document a rate limit for the /api/packages endpoint we query
+		// for every version lookup, so we stay as conservative as for rubygems.org.
+		return rate.Limit(10)
 	default:
 		return rate.Inf
 	}
@@ -773,6 +859,22 @@ type OtherRepoMetadata struct {
 	AbsFilePath string
 }
 
+// MercurialRepoMetadata is the metadata of a repository mirrored from a
+// Mercurial server.
+type MercurialRepoMetadata struct {
+	// RelativePath is relative to ServiceID which is the URL of the Mercurial
+	// server. Joining them gives you the clone URL.
+	RelativePath string
+}
+
+// SubversionRepoMetadata is the metadata of a repository mirrored from a
+// Subversion server.
+type SubversionRepoMetadata struct {
+	// RelativePath is relative to ServiceID which is the URL of the Subversion
+	// server. Joining them gives you the repository URL.
+	RelativePath string
+}
+
 type LocalGitMetadata struct {
 	// AbsFilePath is the absolute path to the local repository. The path can also
 	// be extracted from the repo's URN, but storing it separately makes it easier
@@ -850,8 +952,20 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		return VariantRustPackages.AsKind(), nil
 	case *schema.RubyPackagesConnection:
 		return VariantRubyPackages.AsKind(), nil
+	case *schema.DotnetPackagesConnection:
+		return KindNugetPackages, nil
+	case *schema.PhpPackagesConnection:
+		return KindPackagistPackages, nil
+	case *schema.ElixirPackagesConnection:
+		return KindHexPackages, nil
+	case *schema.DartPackagesConnection:
+		return KindPubPackages, nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
+	case *schema.MercurialConnection:
+		rawURL = c.Url
+	case *schema.SubversionConnection:
+		rawURL = c.Url
 	case *schema.LocalGitExternalService:
 		return VariantLocalGit.AsKind(), nil
 	default:
@@ -34,6 +34,15 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantBitbucketServer.ConfigPrototype().(*schema.BitbucketServerConnection); !ok {
 		t.Errorf("wrong type for Bitbucket Server configuration prototype: %T", y)
 	}
+	if y, ok := VariantDartPackages.ConfigPrototype().(*schema.DartPackagesConnection); !ok {
+		t.Errorf("wrong type for Dart Packages configuration prototype: %T", y)
+	}
+	if y, ok := VariantDotnetPackages.ConfigPrototype().(*schema.DotnetPackagesConnection); !ok {
+		t.Errorf("wrong type for .NET Packages configuration prototype: %T", y)
+	}
+	if y, ok := VariantElixirPackages.ConfigPrototype().(*schema.ElixirPackagesConnection); !ok {
+		t.Errorf("wrong type for Elixir Packages configuration prototype: %T", y)
+	}
 	if y, ok := VariantGerrit.ConfigPrototype().(*schema.GerritConnection); !ok {
 		t.Errorf("wrong type for Gerrit configuration prototype: %T", y)
 	}
@@ -52,6 +61,9 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantJVMPackages.ConfigPrototype().(*schema.JVMPackagesConnection); !ok {
 		t.Errorf("wrong type for JVM Packages configuration prototype: %T", y)
 	}
+	if y, ok := VariantMercurial.ConfigPrototype().(*schema.MercurialConnection); !ok {
+		t.Errorf("wrong type for Mercurial configuration prototype: %T", y)
+	}
 	if y, ok := VariantNpmPackages.ConfigPrototype().(*schema.NpmPackagesConnection); !ok {
 		t.Errorf("wrong type for NPM Packages configuration prototype: %T", y)
 	}
@@ -67,6 +79,9 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantPhabricator.ConfigPrototype().(*schema.PhabricatorConnection); !ok {
 		t.Errorf("wrong type for Phabricator configuration prototype: %T", y)
 	}
+	if y, ok := VariantPhpPackages.ConfigPrototype().(*schema.PhpPackagesConnection); !ok {
+		t.Errorf("wrong type for PHP Packages configuration prototype: %T", y)
+	}
 	if y, ok := VariantPythonPackages.ConfigPrototype().(*schema.PythonPackagesConnection); !ok {
 		t.Errorf("wrong type for Python Packages configuration prototype: %T", y)
 	}
@@ -76,6 +91,9 @@ func TestVariantConfigPrototypePointers(t *testing.T) {
 	if y, ok := VariantRustPackages.ConfigPrototype().(*schema.RustPackagesConnection); !ok {
 		t.Errorf("wrong type for Rust Packages configuration prototype: %T", y)
 	}
+	if y, ok := VariantSubversion.ConfigPrototype().(*schema.SubversionConnection); !ok {
+		t.Errorf("wrong type for Subversion configuration prototype: %T", y)
+	}
 }
 
 func TestExtractToken(t *testing.T) {
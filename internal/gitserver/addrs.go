@@ -32,14 +32,15 @@ var (
 	}, []string{"user_agent"})
 )
 
-// NewGitserverAddresses fetches the current set of gitserver addresses
-// and pinned repos for gitserver.
+// NewGitserverAddresses fetches the current set of gitserver addresses,
+// pinned repos and the replication factor for gitserver.
 func NewGitserverAddresses(cfg *conf.Unified) GitserverAddresses {
 	addrs := GitserverAddresses{
 		Addresses: cfg.ServiceConnectionConfig.GitServers,
 	}
 	if cfg.ExperimentalFeatures != nil {
 		addrs.PinnedServers = cfg.ExperimentalFeatures.GitServerPinnedRepos
+		addrs.ReplicationFactor = cfg.ExperimentalFeatures.GitServerReplicationFactor
 	}
 	return addrs
 }
@@ -122,6 +123,17 @@ func (c *testGitserverConns) GetAddressWithClient(addr string) AddressWithClient
 	return nil
 }
 
+// ReplicaClientsForRepo returns the clients for the replicas of the given repo.
+func (c *testGitserverConns) ReplicaClientsForRepo(ctx context.Context, userAgent string, repo api.RepoName) []AddressWithClient {
+	var replicas []AddressWithClient
+	for _, addr := range c.conns.ReplicaAddrsForRepo(ctx, userAgent, repo) {
+		if ac := c.GetAddressWithClient(addr); ac != nil {
+			replicas = append(replicas, ac)
+		}
+	}
+	return replicas
+}
+
 // ClientForRepo returns a client or host for the given repo name.
 func (c *testGitserverConns) ClientForRepo(ctx context.Context, userAgent string, repo api.RepoName) (proto.GitserverServiceClient, error) {
 	conn, err := c.conns.ConnForRepo(ctx, userAgent, repo)
@@ -160,6 +172,10 @@ type GitserverAddresses struct {
 	// ensures that, even if the number of gitservers changes, these repos will
 	// not be moved.
 	PinnedServers map[string]string
+
+	// The number of gitservers that store a copy of each repo, including the
+	// primary. Values smaller than 2 disable replication.
+	ReplicationFactor int
 }
 
 // AddrForRepo returns the gitserver address to use for the given repo name.
@@ -175,6 +191,38 @@ func (g *GitserverAddresses) AddrForRepo(ctx context.Context, userAgent string,
 	return addrForKey(name, g.Addresses)
 }
 
+// ReplicaAddrsForRepo returns the addresses of the gitservers that keep a
+// replica of the given repo, in the order they should be tried. The primary
+// returned by AddrForRepo is never included.
+func (g *GitserverAddresses) ReplicaAddrsForRepo(ctx context.Context, userAgent string, repoName api.RepoName) []string {
+	if g.ReplicationFactor < 2 || len(g.Addresses) < 2 {
+		return nil
+	}
+	return replicaAddrs(g.AddrForRepo(ctx, userAgent, repoName), g.Addresses, g.ReplicationFactor)
+}
+
+// replicaAddrs returns the replicationFactor-1 addresses that follow primary in
+// addrs, wrapping around at the end. This keeps the set of replicas of a repo
+// stable as long as addrs doesn't change.
+func replicaAddrs(primary string, addrs []string, replicationFactor int) []string {
+	start := slices.Index(addrs, primary)
+	if start < 0 {
+		// Pinned to a gitserver that is not in the list, there is no ring
+		// position to start from.
+		return nil
+	}
+
+	n := replicationFactor - 1
+	if n > len(addrs)-1 {
+		n = len(addrs) - 1
+	}
+	replicas := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		replicas = append(replicas, addrs[(start+i)%len(addrs)])
+	}
+	return replicas
+}
+
 // addrForKey returns the gitserver address to use for the given string key,
 // which is hashed for sharding purposes.
 func addrForKey(key string, addrs []string) string {
@@ -236,6 +284,27 @@ func (a *atomicGitServerConns) ClientForRepo(ctx context.Context, userAgent stri
 	return proto.NewGitserverServiceClient(conn), nil
 }
 
+func (a *atomicGitServerConns) ReplicaClientsForRepo(ctx context.Context, userAgent string, repo api.RepoName) []AddressWithClient {
+	conns := a.get()
+	replicaAddrs := conns.ReplicaAddrsForRepo(ctx, userAgent, repo)
+	if len(replicaAddrs) == 0 {
+		return nil
+	}
+	replicas := make([]AddressWithClient, 0, len(replicaAddrs))
+	for _, addr := range replicaAddrs {
+		ce, ok := conns.grpcConns[addr]
+		if !ok {
+			continue
+		}
+		replicas = append(replicas, &connAndErr{
+			address: addr,
+			conn:    ce.conn,
+			err:     ce.err,
+		})
+	}
+	return replicas
+}
+
 func (a *atomicGitServerConns) Addresses() []AddressWithClient {
 	conns := a.get()
 	addrs := make([]AddressWithClient, 0, len(conns.Addresses))
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/api"
 )
 
@@ -54,3 +56,62 @@ func TestAddrForRepo(t *testing.T) {
 		}
 	})
 }
+
+func TestReplicaAddrsForRepo(t *testing.T) {
+	ctx := context.Background()
+	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
+
+	testCases := []struct {
+		name              string
+		replicationFactor int
+		pinned            map[string]string
+		repo              api.RepoName
+		want              []string
+	}{
+		{
+			name: "replication disabled",
+			repo: api.RepoName("repo1"),
+			want: nil,
+		},
+		{
+			name:              "replicas follow the primary",
+			replicationFactor: 2,
+			repo:              api.RepoName("repo1"), // primary is gitserver-3
+			want:              []string{"gitserver-1"},
+		},
+		{
+			name:              "replication factor larger than the number of gitservers",
+			replicationFactor: 5,
+			repo:              api.RepoName("github.com/sourcegraph/sourcegraph.git"), // primary is gitserver-2
+			want:              []string{"gitserver-3", "gitserver-1"},
+		},
+		{
+			name:              "pinned repo",
+			replicationFactor: 2,
+			pinned:            map[string]string{"repo2": "gitserver-1"},
+			repo:              api.RepoName("repo2"),
+			want:              []string{"gitserver-2"},
+		},
+		{
+			name:              "pinned to unknown gitserver",
+			replicationFactor: 2,
+			pinned:            map[string]string{"repo2": "gitserver-4"},
+			repo:              api.RepoName("repo2"),
+			want:              nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ga := GitserverAddresses{
+				Addresses:         addrs,
+				PinnedServers:     tc.pinned,
+				ReplicationFactor: tc.replicationFactor,
+			}
+			got := ga.ReplicaAddrsForRepo(ctx, "gitserver", tc.repo)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("unexpected replicas (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -80,6 +80,10 @@ type ClientSource interface {
 	ClientForRepo(ctx context.Context, userAgent string, repo api.RepoName) (proto.GitserverServiceClient, error)
 	// AddrForRepo returns the address of the gitserver for the given repo.
 	AddrForRepo(ctx context.Context, userAgent string, repo api.RepoName) string
+	// ReplicaClientsForRepo returns the addresses and clients of the gitservers
+	// that keep a replica of the given repo. It returns nil if replication is
+	// disabled.
+	ReplicaClientsForRepo(ctx context.Context, userAgent string, repo api.RepoName) []AddressWithClient
 	// Address the current list of gitserver addresses.
 	Addresses() []AddressWithClient
 	// GetAddressWithClient returns the address and client for a gitserver instance.
@@ -383,6 +387,11 @@ type Client interface {
 	// Stat returns a FileInfo describing the named file at commit.
 	Stat(ctx context.Context, repo api.RepoName, commit api.CommitID, path string) (fs.FileInfo, error)
 
+	// Submodules returns the submodules of the repository at commit, with the
+	// repositories on Sourcegraph that their URLs map to. It requires gRPC to
+	// be enabled.
+	Submodules(ctx context.Context, repo api.RepoName, commit api.CommitID) ([]gitdomain.Submodule, error)
+
 	// DiffPath returns a position-ordered slice of changes (additions or deletions)
 	// of the given path between the given source and target commits.
 	DiffPath(ctx context.Context, repo api.RepoName, sourceCommit, targetCommit, path string) ([]*diff.Hunk, error)
@@ -640,6 +649,10 @@ func (c *clientImplementor) ClientForRepo(ctx context.Context, repo api.RepoName
 	return c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
 }
 
+func (c *clientImplementor) ReplicaClientsForRepo(ctx context.Context, repo api.RepoName) []AddressWithClient {
+	return c.clientSource.ReplicaClientsForRepo(ctx, c.userAgent, repo)
+}
+
 // ArchiveOptions contains options for the Archive func.
 type ArchiveOptions struct {
 	Treeish   string               // the tree or commit to produce an archive for
@@ -777,11 +790,6 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, err e
 	}
 
 	if conf.IsGRPCEnabled(ctx) {
-		client, err := c.execer.ClientForRepo(ctx, repoName)
-		if err != nil {
-			return nil, err
-		}
-
 		req := &proto.ExecRequest{
 			Repo:      string(repoName),
 			Args:      stringsToByteSlices(c.args[1:]),
@@ -792,12 +800,51 @@ func (c *RemoteGitCommand) sendExec(ctx context.Context) (_ io.ReadCloser, err e
 			EnsureRevision: []byte(c.EnsureRevision()),
 		}
 
-		stream, err := client.Exec(ctx, req)
+		// Read-only commands can be served by a replica if the primary
+		// gitserver of the repo is unavailable. We only know whether it is once
+		// the first message arrives, which is then replayed by the reader below.
+		readOnly := gitdomain.IsReadOnlyGitCmd(c.args[1:])
+		var (
+			stream       proto.GitserverService_ExecClient
+			firstMessage *proto.ExecResponse
+			firstError   error
+		)
+		execOnce := func(client proto.GitserverServiceClient) (err error) {
+			stream, err = client.Exec(ctx, req)
+			if err != nil || !readOnly {
+				return err
+			}
+			firstMessage, firstError = stream.Recv()
+			if isReplicaFailoverError(firstError) {
+				return firstError
+			}
+			return nil
+		}
+
+		if readOnly {
+			err = withReplicaFailover(ctx, c.execer, repoName, "Exec", execOnce)
+		} else {
+			var client proto.GitserverServiceClient
+			client, err = c.execer.ClientForRepo(ctx, repoName)
+			if err != nil {
+				return nil, err
+			}
+			err = execOnce(client)
+		}
 		if err != nil {
 			return nil, err
 		}
+
+		firstMessageRead := !readOnly
 		r := streamio.NewReader(func() ([]byte, error) {
-			msg, err := stream.Recv()
+			var msg *proto.ExecResponse
+			var err error
+			if !firstMessageRead {
+				firstMessageRead = true
+				msg, err = firstMessage, firstError
+			} else {
+				msg, err = stream.Recv()
+			}
 			if status.Code(err) == codes.Canceled {
 				return nil, context.Canceled
 			} else if err != nil {
@@ -892,6 +939,9 @@ func convertGRPCErrorToGitDomainError(err error) error {
 				CloneInProgress: payload.CloneInProgress,
 				CloneProgress:   payload.CloneProgress,
 			}
+
+		case *proto.FileNotFoundPayload:
+			return &os.PathError{Op: "open", Path: payload.Path, Err: os.ErrNotExist}
 		}
 	}
 
@@ -939,19 +989,30 @@ func (c *clientImplementor) Search(ctx context.Context, args *protocol.SearchReq
 	repoName := protocol.NormalizeRepo(args.Repo)
 
 	if conf.IsGRPCEnabled(ctx) {
-		client, err := c.ClientForRepo(ctx, repoName)
-		if err != nil {
-			return false, err
-		}
-
-		cs, err := client.Search(ctx, args.ToProto())
+		// The first message is read right away so that we can fail over to a
+		// replica if the gitserver is unavailable, before any matches are sent.
+		var (
+			cs       proto.GitserverService_SearchClient
+			msg      *proto.SearchResponse
+			firstErr error
+		)
+		err := withReplicaFailover(ctx, c, repoName, "Search", func(client proto.GitserverServiceClient) (err error) {
+			cs, err = client.Search(ctx, args.ToProto())
+			if err != nil {
+				return err
+			}
+			msg, firstErr = cs.Recv()
+			if isReplicaFailoverError(firstErr) {
+				return firstErr
+			}
+			return nil
+		})
 		if err != nil {
 			return false, convertGitserverError(err)
 		}
 
 		limitHit := false
-		for {
-			msg, err := cs.Recv()
+		for err := firstErr; ; msg, err = cs.Recv() {
 			if err != nil {
 				return limitHit, convertGitserverError(err)
 			}
@@ -2316,14 +2377,12 @@ func (c *clientImplementor) GetObject(ctx context.Context, repo api.RepoName, ob
 		ObjectName: objectName,
 	}
 	if conf.IsGRPCEnabled(ctx) {
-		client, err := c.ClientForRepo(ctx, req.Repo)
-		if err != nil {
-			return nil, err
-		}
-
-		grpcResp, err := client.GetObject(ctx, req.ToProto())
+		var grpcResp *proto.GetObjectResponse
+		err := withReplicaFailover(ctx, c, req.Repo, "GetObject", func(client proto.GitserverServiceClient) (err error) {
+			grpcResp, err = client.GetObject(ctx, req.ToProto())
+			return err
+		})
 		if err != nil {
-
 			return nil, err
 		}
 
@@ -28,6 +28,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitolite"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
@@ -291,6 +292,28 @@ func TestClient_ListGitolite_ProtoRoundTrip(t *testing.T) {
 	}
 }
 
+func TestClient_BlameHunk_ProtoRoundTrip(t *testing.T) {
+	original := &gitserver.Hunk{
+		StartLine: 3,
+		EndLine:   5,
+		StartByte: 20,
+		EndByte:   42,
+		CommitID:  "e6093374dcf5725d8517db0dccbbf69df65dbde0",
+		Author: gitdomain.Signature{
+			Name:  "a",
+			Email: "a@a.com",
+			Date:  time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		Message:  "foo",
+		Filename: "f",
+	}
+
+	converted := gitserver.HunkFromBlameHunkProto(original.ToProto())
+	if diff := cmp.Diff(original, converted); diff != "" {
+		t.Errorf("Hunk proto roundtrip failed (-want +got):\n%s", diff)
+	}
+}
+
 func TestClient_Remove(t *testing.T) {
 	test := func(t *testing.T, called *bool) {
 		repo := api.RepoName("github.com/sourcegraph/sourcegraph")
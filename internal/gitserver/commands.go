@@ -20,11 +20,11 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-git/go-git/v5/plumbing/format/config"
 	"github.com/golang/groupcache/lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sourcegraph/go-diff/diff"
 
@@ -36,6 +36,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/fileutil"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/internal/grpc/streamio"
 	"github.com/sourcegraph/sourcegraph/internal/honey"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
@@ -84,6 +85,51 @@ func (c *clientImplementor) Diff(ctx context.Context, opts DiffOptions) (_ *Diff
 		// flags or refer to a file.
 		return nil, errors.Errorf("invalid diff range argument: %q", rangeSpec)
 	}
+
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		comparisonType := proto.DiffRequest_COMPARISON_TYPE_THREE_DOT
+		if opts.RangeType == ".." {
+			comparisonType = proto.DiffRequest_COMPARISON_TYPE_TWO_DOT
+		}
+		req := &proto.DiffRequest{
+			Repo:           string(opts.Repo),
+			BaseRevSpec:    opts.Base,
+			HeadRevSpec:    opts.Head,
+			ComparisonType: comparisonType,
+			Paths:          opts.Paths,
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		stream, err := openStreamWithReplicaFailover(ctx, c, opts.Repo, "Diff", func(client proto.GitserverServiceClient) (func() (*proto.DiffResponse, error), error) {
+			s, err := client.Diff(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			return s.Recv, nil
+		})
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(convertGRPCErrorToGitDomainError(err), "executing git diff")
+		}
+
+		rdr := &readCloseWrapper{
+			r: streamio.NewReader(func() ([]byte, error) {
+				msg, err := stream.Recv()
+				if err != nil {
+					return nil, convertGRPCErrorToGitDomainError(err)
+				}
+				return msg.GetData(), nil
+			}),
+			closeFn: cancel,
+		}
+
+		return &DiffFileIterator{
+			rdr:            rdr,
+			mfdr:           diff.NewMultiFileDiffReader(rdr),
+			fileFilterFunc: getFilterFunc(ctx, c.subRepoPermsChecker, opts.Repo),
+		}, nil
+	}
+
 	args := append([]string{
 		"diff",
 		"--find-renames",
@@ -291,6 +337,35 @@ func (c *clientImplementor) CommitGraph(ctx context.Context, repo api.RepoName,
 	})
 	defer endObservation(1, observation.Args{})
 
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		req := &proto.RevListRequest{
+			Repo:      string(repo),
+			AllRefs:   opts.AllRefs,
+			TopoOrder: true,
+		}
+		if opts.Commit != "" {
+			req.Revisions = []string{opts.Commit}
+		} else if !opts.AllRefs {
+			req.Revisions = []string{"HEAD"}
+		}
+		if opts.Since != nil {
+			req.Since = timestamppb.New(*opts.Since)
+		}
+		if opts.Limit > 0 {
+			req.MaxCount = uint32(opts.Limit)
+		}
+
+		var lines []string
+		err := c.revList(ctx, repo, req, func(commit *proto.RevListCommit) bool {
+			lines = append(lines, strings.Join(append([]string{commit.GetId()}, commit.GetParents()...), " "))
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return gitdomain.ParseCommitGraph(lines), nil
+	}
+
 	args := []string{"log", "--pretty=%H %P", "--topo-order"}
 	if opts.AllRefs {
 		args = append(args, "--all")
@@ -655,6 +730,12 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 		return nil, &os.PathError{Op: "git ls-tree", Path: path, Err: os.ErrNotExist}
 	}
 
+	// .gitmodules is only read once a submodule is listed.
+	var (
+		submoduleURLs  map[string]string
+		gitModulesRead bool
+	)
+
 	trimPath := strings.TrimPrefix(path, "./")
 	lines := strings.Split(string(out), "\x00")
 	fis := make([]fs.FileInfo, len(lines)-1)
@@ -716,20 +797,21 @@ func (c *clientImplementor) lsTreeUncached(ctx context.Context, repo api.RepoNam
 			}
 		case "commit":
 			mode = mode | gitdomain.ModeSubmodule
-			cmd := c.gitCommand(repo, "show", fmt.Sprintf("%s:.gitmodules", commit))
-			var submodule gitdomain.Submodule
-			if out, err := cmd.Output(ctx); err == nil {
-
-				var cfg config.Config
-				err := config.NewDecoder(bytes.NewBuffer(out)).Decode(&cfg)
-				if err != nil {
-					return nil, errors.Errorf("error parsing .gitmodules: %s", err)
+			if !gitModulesRead {
+				gitModulesRead = true
+				cmd := c.gitCommand(repo, "show", fmt.Sprintf("%s:.gitmodules", commit))
+				if out, err := cmd.Output(ctx); err == nil {
+					submoduleURLs, err = gitdomain.ParseGitModules(out)
+					if err != nil {
+						return nil, err
+					}
 				}
-
-				submodule.Path = cfg.Section("submodule").Subsection(name).Option("path")
-				submodule.URL = cfg.Section("submodule").Subsection(name).Option("url")
 			}
-			submodule.CommitID = api.CommitID(oid.String())
+			submodule := gitdomain.Submodule{CommitID: api.CommitID(oid.String())}
+			if url, ok := submoduleURLs[name]; ok {
+				submodule.Path = name
+				submodule.URL = url
+			}
 			sys = submodule
 		case "tree":
 			mode = mode | os.ModeDir
@@ -818,6 +900,40 @@ type Hunk struct {
 	Filename string
 }
 
+func (h *Hunk) ToProto() *proto.BlameHunk {
+	return &proto.BlameHunk{
+		StartLine: uint32(h.StartLine),
+		EndLine:   uint32(h.EndLine),
+		StartByte: uint32(h.StartByte),
+		EndByte:   uint32(h.EndByte),
+		Commit:    string(h.CommitID),
+		Author: &proto.BlameAuthor{
+			Name:  h.Author.Name,
+			Email: h.Author.Email,
+			Date:  timestamppb.New(h.Author.Date),
+		},
+		Message:  h.Message,
+		Filename: h.Filename,
+	}
+}
+
+func HunkFromBlameHunkProto(p *proto.BlameHunk) *Hunk {
+	return &Hunk{
+		StartLine: int(p.GetStartLine()),
+		EndLine:   int(p.GetEndLine()),
+		StartByte: int(p.GetStartByte()),
+		EndByte:   int(p.GetEndByte()),
+		CommitID:  api.CommitID(p.GetCommit()),
+		Author: gitdomain.Signature{
+			Name:  p.GetAuthor().GetName(),
+			Email: p.GetAuthor().GetEmail(),
+			Date:  p.GetAuthor().GetDate().AsTime(),
+		},
+		Message:  p.GetMessage(),
+		Filename: p.GetFilename(),
+	}
+}
+
 // StreamBlameFile returns Git blame information about a file.
 func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) (_ HunkReader, err error) {
 	ctx, _, endObservation := c.operations.streamBlameFile.With(ctx, &err, observation.Args{
@@ -829,9 +945,63 @@ func (c *clientImplementor) StreamBlameFile(ctx context.Context, repo api.RepoNa
 	})
 	defer endObservation(1, observation.Args{})
 
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		return c.streamBlameFileGRPC(ctx, repo, path, opt)
+	}
+
 	return streamBlameFileCmd(ctx, c.subRepoPermsChecker, repo, path, opt, c.gitserverGitCommandFunc(repo))
 }
 
+func (c *clientImplementor) streamBlameFileGRPC(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) (HunkReader, error) {
+	hasAccess, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, actor.FromContext(ctx), repo, path)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		return nil, errUnauthorizedStreamBlame{Repo: repo}
+	}
+	if opt == nil {
+		opt = &BlameOptions{}
+	}
+	if err := checkSpecArgSafety(string(opt.NewestCommit)); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.openBlameStream(ctx, repo, path, opt, true)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &grpcBlameHunkReader{stream: stream, cancel: cancel}, nil
+}
+
+// openBlameStream opens a Blame RPC for path in repo.
+func (c *clientImplementor) openBlameStream(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions, incremental bool) (*peekedStream[*proto.BlameResponse], error) {
+	req := &proto.BlameRequest{
+		Repo:             string(repo),
+		Commit:           string(opt.NewestCommit),
+		Path:             filepath.ToSlash(path),
+		IgnoreWhitespace: opt.IgnoreWhitespace,
+		StartLine:        uint32(opt.StartLine),
+		EndLine:          uint32(opt.EndLine),
+		Incremental:      incremental,
+	}
+
+	stream, err := openStreamWithReplicaFailover(ctx, c, repo, "Blame", func(client proto.GitserverServiceClient) (func() (*proto.BlameResponse, error), error) {
+		s, err := client.Blame(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return s.Recv, nil
+	})
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return stream, nil
+}
+
 type errUnauthorizedStreamBlame struct {
 	Repo api.RepoName
 }
@@ -874,7 +1044,7 @@ func streamBlameFileCmd(ctx context.Context, checker authz.SubRepoPermissionChec
 		return nil, errors.WithMessage(err, fmt.Sprintf("git command %v failed", args))
 	}
 
-	return newBlameHunkReader(rc), nil
+	return NewBlameHunkReader(rc), nil
 }
 
 // BlameFile returns Git blame information about a file.
@@ -888,9 +1058,45 @@ func (c *clientImplementor) BlameFile(ctx context.Context, repo api.RepoName, pa
 	})
 	defer endObservation(1, observation.Args{})
 
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		return c.blameFileGRPC(ctx, repo, path, opt)
+	}
+
 	return blameFileCmd(ctx, c.subRepoPermsChecker, c.gitserverGitCommandFunc(repo), path, opt, repo)
 }
 
+func (c *clientImplementor) blameFileGRPC(ctx context.Context, repo api.RepoName, path string, opt *BlameOptions) ([]*Hunk, error) {
+	if hasAccess, err := authz.FilterActorPath(ctx, c.subRepoPermsChecker, actor.FromContext(ctx), repo, path); err != nil || !hasAccess {
+		return nil, err
+	}
+	if opt == nil {
+		opt = &BlameOptions{}
+	}
+	if err := checkSpecArgSafety(string(opt.NewestCommit)); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.openBlameStream(ctx, repo, path, opt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var hunks []*Hunk
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return hunks, nil
+			}
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
+		hunks = append(hunks, HunkFromBlameHunkProto(msg.GetHunk()))
+	}
+}
+
 func blameFileCmd(ctx context.Context, checker authz.SubRepoPermissionChecker, command gitCommandFunc, path string, opt *BlameOptions, repo api.RepoName) ([]*Hunk, error) {
 	a := actor.FromContext(ctx)
 	if hasAccess, err := authz.FilterActorPath(ctx, checker, a, repo, path); err != nil || !hasAccess {
@@ -920,11 +1126,11 @@ func blameFileCmd(ctx context.Context, checker authz.SubRepoPermissionChecker, c
 		return nil, nil
 	}
 
-	return parseGitBlameOutput(string(out))
+	return ParseGitBlameOutput(string(out))
 }
 
-// parseGitBlameOutput parses the output of `git blame -w --porcelain`
-func parseGitBlameOutput(out string) ([]*Hunk, error) {
+// ParseGitBlameOutput parses the output of `git blame -w --porcelain`
+func ParseGitBlameOutput(out string) ([]*Hunk, error) {
 	commits := make(map[string]gitdomain.Commit)
 	filenames := make(map[string]string)
 	hunks := make([]*Hunk, 0)
@@ -1388,6 +1594,23 @@ func (c *clientImplementor) MergeBase(ctx context.Context, repo api.RepoName, a,
 	})
 	defer endObservation(1, observation.Args{})
 
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		req := &proto.MergeBaseRequest{
+			Repo: string(repo),
+			Base: string(a),
+			Head: string(b),
+		}
+		var resp *proto.MergeBaseResponse
+		err := withReplicaFailover(ctx, c, repo, "MergeBase", func(client proto.GitserverServiceClient) (err error) {
+			resp, err = client.MergeBase(ctx, req)
+			return err
+		})
+		if err != nil {
+			return "", convertGRPCErrorToGitDomainError(err)
+		}
+		return api.CommitID(resp.GetMergeBaseCommitSha()), nil
+	}
+
 	cmd := c.gitCommand(repo, "merge-base", "--", string(a), string(b))
 	out, err := cmd.CombinedOutput(ctx)
 	if err != nil {
@@ -1396,6 +1619,44 @@ func (c *clientImplementor) MergeBase(ctx context.Context, repo api.RepoName, a,
 	return api.CommitID(bytes.TrimSpace(out)), nil
 }
 
+// Submodules returns the submodules of the repository at commit, with the
+// repositories on Sourcegraph that their URLs map to.
+func (c *clientImplementor) Submodules(ctx context.Context, repo api.RepoName, commit api.CommitID) (_ []gitdomain.Submodule, err error) {
+	ctx, _, endObservation := c.operations.submodules.With(ctx, &err, observation.Args{
+		MetricLabelValues: []string{c.scope},
+		Attrs: []attribute.KeyValue{
+			repo.Attr(),
+			commit.Attr(),
+		},
+	})
+	defer endObservation(1, observation.Args{})
+
+	// Mapping submodule URLs to repositories needs the database, so this can
+	// only be answered by gitserver itself.
+	if !conf.IsGRPCEnabled(ctx) || ClientMocks.LocalGitserver {
+		return nil, errors.New("resolving submodules requires gRPC to be enabled")
+	}
+
+	req := &proto.SubmodulesRequest{
+		Repo:   string(repo),
+		Commit: string(commit),
+	}
+	var resp *proto.SubmodulesResponse
+	err = withReplicaFailover(ctx, c, repo, "Submodules", func(client proto.GitserverServiceClient) (err error) {
+		resp, err = client.Submodules(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+
+	submodules := make([]gitdomain.Submodule, 0, len(resp.GetSubmodules()))
+	for _, s := range resp.GetSubmodules() {
+		submodules = append(submodules, gitdomain.SubmoduleFromProto(s))
+	}
+	return submodules, nil
+}
+
 // RevList makes a git rev-list call and iterates through the resulting commits, calling the provided onCommit function for each.
 func (c *clientImplementor) RevList(ctx context.Context, repo string, commit string, onCommit func(commit string) (shouldContinue bool, err error)) (err error) {
 	ctx, _, endObservation := c.operations.revList.With(ctx, &err, observation.Args{
@@ -1407,6 +1668,27 @@ func (c *clientImplementor) RevList(ctx context.Context, repo string, commit str
 	})
 	defer endObservation(1, observation.Args{})
 
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		req := &proto.RevListRequest{
+			Repo:        repo,
+			Revisions:   []string{commit},
+			FirstParent: true,
+		}
+		var onCommitErr error
+		err := c.revList(ctx, api.RepoName(repo), req, func(commit *proto.RevListCommit) bool {
+			shouldContinue, err := onCommit(commit.GetId())
+			if err != nil {
+				onCommitErr = err
+				return false
+			}
+			return shouldContinue
+		})
+		if onCommitErr != nil {
+			return onCommitErr
+		}
+		return err
+	}
+
 	command := c.gitCommand(api.RepoName(repo), RevListArgs(commit)...)
 	command.DisableTimeout()
 	stdout, err := command.StdoutReader(ctx)
@@ -1422,6 +1704,39 @@ func RevListArgs(givenCommit string) []string {
 	return []string{"rev-list", "--first-parent", givenCommit}
 }
 
+// revList calls the RevList RPC with req and calls onCommit for each commit it
+// returns, until onCommit returns false.
+func (c *clientImplementor) revList(ctx context.Context, repo api.RepoName, req *proto.RevListRequest, onCommit func(*proto.RevListCommit) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := openStreamWithReplicaFailover(ctx, c, repo, "RevList", func(client proto.GitserverServiceClient) (func() (*proto.RevListResponse, error), error) {
+		s, err := client.RevList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return s.Recv, nil
+	})
+	if err != nil {
+		return convertGRPCErrorToGitDomainError(err)
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return convertGRPCErrorToGitDomainError(err)
+		}
+		for _, commit := range msg.GetCommits() {
+			if !onCommit(commit) {
+				return nil
+			}
+		}
+	}
+}
+
 // GetBehindAhead returns the behind/ahead commit counts information for right vs. left (both Git
 // revspecs).
 func (c *clientImplementor) GetBehindAhead(ctx context.Context, repo api.RepoName, left, right string) (_ *gitdomain.BehindAhead, err error) {
@@ -1508,6 +1823,10 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 	}
 
 	name = rel(name)
+	if conf.IsGRPCEnabled(ctx) && !ClientMocks.LocalGitserver {
+		return c.newFileReaderGRPC(ctx, repo, commit, name)
+	}
+
 	br, err := c.newBlobReader(ctx, repo, commit, name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "getting blobReader for %q", name)
@@ -1515,6 +1834,54 @@ func (c *clientImplementor) NewFileReader(ctx context.Context, repo api.RepoName
 	return br, nil
 }
 
+func (c *clientImplementor) newFileReaderGRPC(ctx context.Context, repo api.RepoName, commit api.CommitID, name string) (io.ReadCloser, error) {
+	if err := gitdomain.EnsureAbsoluteCommit(commit); err != nil {
+		return nil, err
+	}
+
+	req := &proto.ReadFileRequest{
+		Repo:   string(repo),
+		Commit: string(commit),
+		Path:   name,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := openStreamWithReplicaFailover(ctx, c, repo, "ReadFile", func(client proto.GitserverServiceClient) (func() (*proto.ReadFileResponse, error), error) {
+		s, err := client.ReadFile(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return s.Recv, nil
+	})
+	if err != nil {
+		cancel()
+		return nil, errors.Wrapf(convertGRPCErrorToGitDomainError(err), "getting blobReader for %q", name)
+	}
+
+	// Return errors such as the file not existing right away, like
+	// newBlobReader does.
+	first, firstErr := stream.Recv()
+	if firstErr != nil && firstErr != io.EOF {
+		cancel()
+		return nil, convertGRPCErrorToGitDomainError(firstErr)
+	}
+
+	firstRead := false
+	r := streamio.NewReader(func() ([]byte, error) {
+		if !firstRead {
+			firstRead = true
+			return first.GetData(), firstErr
+		}
+		msg, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return msg.GetData(), nil
+	})
+
+	return &readCloseWrapper{r: r, closeFn: cancel}, nil
+}
+
 // blobReader, which should be created using newBlobReader, is a struct that allows
 // us to get a ReadCloser to a specific named file at a specific commit
 type blobReader struct {
@@ -2726,21 +3093,10 @@ func (c *clientImplementor) ArchiveReader(
 	}
 
 	if conf.IsGRPCEnabled(ctx) {
-		client, err := c.clientSource.ClientForRepo(ctx, c.userAgent, repo)
-		if err != nil {
-			return nil, err
-		}
-
 		req := options.ToProto(string(repo)) // HACK: ArchiveOptions doesn't have a repository here, so we have to add it ourselves.
 
 		ctx, cancel := context.WithCancel(ctx)
 
-		stream, err := client.Archive(ctx, req)
-		if err != nil {
-			cancel()
-			return nil, err
-		}
-
 		// first message from the gRPC stream needs to be read to check for errors before continuing
 		// to read the rest of the stream. If the first message is an error, we cancel the stream
 		// and return the error.
@@ -2751,8 +3107,30 @@ func (c *clientImplementor) ArchiveReader(
 		// function returns. gRPC is asynchronous, so we have to start consuming messages from
 		// the stream to see any errors from the server. Reading the first message ensures we
 		// handle any errors synchronously, similar to the HTTP implementation.
+		//
+		// It also tells us whether the gitserver is reachable at all, so that we can fail over
+		// to a replica if it isn't.
+		var (
+			stream       proto.GitserverService_ArchiveClient
+			firstMessage *proto.ArchiveResponse
+			firstError   error
+		)
+		err := withReplicaFailover(ctx, c, repo, "Archive", func(client proto.GitserverServiceClient) (err error) {
+			stream, err = client.Archive(ctx, req)
+			if err != nil {
+				return err
+			}
+			firstMessage, firstError = stream.Recv()
+			if isReplicaFailoverError(firstError) {
+				return firstError
+			}
+			return nil
+		})
+		if err != nil {
+			cancel()
+			return nil, convertGRPCErrorToGitDomainError(err)
+		}
 
-		firstMessage, firstError := stream.Recv()
 		if firstError != nil {
 			// Hack: The ArchiveReader.Read() implementation handles surfacing the
 			// any "revision not found" errors returned from the invoked git binary.
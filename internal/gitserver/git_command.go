@@ -179,6 +179,7 @@ type execer interface {
 	httpPost(ctx context.Context, repo api.RepoName, op string, payload any) (resp *http.Response, err error)
 	AddrForRepo(ctx context.Context, repo api.RepoName) string
 	ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error)
+	ReplicaClientsForRepo(ctx context.Context, repo api.RepoName) []AddressWithClient
 }
 
 // DividedOutput runs the command and returns its standard output and standard error.
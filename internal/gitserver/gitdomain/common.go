@@ -1,12 +1,14 @@
 package gitdomain
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/go-git/go-git/v5/plumbing/format/config"
 	"github.com/gobwas/glob"
 
 	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
@@ -51,6 +53,50 @@ type Submodule struct {
 	// CommitID is the pinned commit ID of the submodule (in the
 	// submodule repository's commit ID space).
 	CommitID api.CommitID
+
+	// Repo is the name of the repository that URL resolves to, or empty if
+	// it doesn't resolve to one. It is only set by gitserver's Submodules
+	// call, not by Stat/ReadDir.
+	Repo api.RepoName
+}
+
+func (s *Submodule) ToProto() *proto.Submodule {
+	return &proto.Submodule{
+		Path:   s.Path,
+		Url:    s.URL,
+		Commit: string(s.CommitID),
+		Repo:   string(s.Repo),
+	}
+}
+
+func SubmoduleFromProto(p *proto.Submodule) Submodule {
+	return Submodule{
+		Path:     p.GetPath(),
+		URL:      p.GetUrl(),
+		CommitID: api.CommitID(p.GetCommit()),
+		Repo:     api.RepoName(p.GetRepo()),
+	}
+}
+
+// ParseGitModules parses the contents of a .gitmodules file, and returns the
+// clone URLs of the submodules it configures by their path. Submodules are
+// looked up by path rather than by name, as the name of a submodule doesn't
+// have to match its path.
+func ParseGitModules(data []byte) (map[string]string, error) {
+	var cfg config.Config
+	if err := config.NewDecoder(bytes.NewReader(data)).Decode(&cfg); err != nil {
+		return nil, errors.Wrap(err, "parsing .gitmodules")
+	}
+
+	urls := map[string]string{}
+	for _, sub := range cfg.Section("submodule").Subsections {
+		path, url := sub.Option("path"), sub.Option("url")
+		if path == "" || url == "" {
+			continue
+		}
+		urls[path] = url
+	}
+	return urls, nil
 }
 
 // ObjectInfo holds information about a Git object and is returned in (fs.FileInfo).Sys for blobs
@@ -109,3 +109,22 @@ func TestIsAbsoluteRevision(t *testing.T) {
 		}
 	}
 }
+
+func TestParseGitModules(t *testing.T) {
+	urls, err := ParseGitModules([]byte(`[submodule "lib"]
+	path = third_party/lib
+	url = https://github.com/org/lib
+[submodule "relative"]
+	path = relative
+	url = ../relative
+[submodule "nopath"]
+	url = https://github.com/org/nopath
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{
+		"third_party/lib": "https://github.com/org/lib",
+		"relative":        "../relative",
+	}, urls)
+}
@@ -24,7 +24,7 @@ var (
 		"branch": {"-r", "-a", "--contains", "--merged", "--format"},
 
 		"rev-parse":    {"--abbrev-ref", "--symbolic-full-name", "--glob", "--exclude"},
-		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--skip", "--left-right"},
+		"rev-list":     {"--first-parent", "--max-parents", "--reverse", "--max-count", "--count", "--after", "--before", "--", "-n", "--date-order", "--skip", "--left-right", "--parents", "--topo-order", "--all", "--since"},
 		"ls-remote":    {"--get-url"},
 		"symbolic-ref": {"--short"},
 		"archive":      {"--worktree-attributes", "--format", "-0", "HEAD", "--"},
@@ -53,6 +53,25 @@ var (
 		"testcat":     {},
 	}
 
+	// gitReadOnlyCmds are the allowed commands that never modify the repository,
+	// regardless of their arguments. They are checked by IsReadOnlyGitCmd.
+	gitReadOnlyCmds = map[string]struct{}{
+		"log":          {},
+		"show":         {},
+		"diff":         {},
+		"blame":        {},
+		"rev-parse":    {},
+		"rev-list":     {},
+		"archive":      {},
+		"ls-tree":      {},
+		"ls-files":     {},
+		"for-each-ref": {},
+		"merge-base":   {},
+		"show-ref":     {},
+		"shortlog":     {},
+		"cat-file":     {},
+	}
+
 	// `git log`, `git show`, `git diff`, etc., share a large common set of allowed args.
 	gitCommonAllowlist = []string{
 		"--name-only", "--name-status", "--full-history", "-M", "--date", "--format", "-i", "-n", "-n1", "-m", "--", "-n200", "-n2", "--follow", "--author", "--grep", "--date-order", "--decorate", "--skip", "--max-count", "--numstat", "--pretty", "--parents", "--topo-order", "--raw", "--follow", "--all", "--before", "--no-merges", "--fixed-strings",
@@ -240,3 +259,13 @@ func IsAllowedGitCmd(logger log.Logger, args []string, dir string) bool {
 	}
 	return true
 }
+
+// IsReadOnlyGitCmd returns true if the git command args never modifies the
+// repository it is run in.
+func IsReadOnlyGitCmd(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	_, ok := gitReadOnlyCmds[args[0]]
+	return ok
+}
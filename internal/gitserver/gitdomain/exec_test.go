@@ -83,3 +83,22 @@ func TestIsAllowedDiffGitCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestIsReadOnlyGitCmd(t *testing.T) {
+	for _, tc := range []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"log", "--format=%H", "HEAD"}, want: true},
+		{args: []string{"archive", "--format=zip", "HEAD"}, want: true},
+		{args: []string{"cat-file", "-p", "HEAD:README.md"}, want: true},
+		{args: []string{"update-ref", "refs/heads/main", "HEAD"}, want: false},
+		{args: []string{"tag", "v1.0.0"}, want: false},
+		{args: []string{"push", "--force"}, want: false},
+		{args: nil, want: false},
+	} {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			assert.Equal(t, tc.want, IsReadOnlyGitCmd(tc.args))
+		})
+	}
+}
@@ -25,6 +25,9 @@ type MockGitserverServiceClient struct {
 	// BatchLogFunc is an instance of a mock function object controlling the
 	// behavior of the method BatchLog.
 	BatchLogFunc *GitserverServiceClientBatchLogFunc
+	// BlameFunc is an instance of a mock function object controlling the
+	// behavior of the method Blame.
+	BlameFunc *GitserverServiceClientBlameFunc
 	// CheckPerforceCredentialsFunc is an instance of a mock function object
 	// controlling the behavior of the method CheckPerforceCredentials.
 	CheckPerforceCredentialsFunc *GitserverServiceClientCheckPerforceCredentialsFunc
@@ -32,6 +35,9 @@ type MockGitserverServiceClient struct {
 	// object controlling the behavior of the method
 	// CreateCommitFromPatchBinary.
 	CreateCommitFromPatchBinaryFunc *GitserverServiceClientCreateCommitFromPatchBinaryFunc
+	// DiffFunc is an instance of a mock function object controlling the
+	// behavior of the method Diff.
+	DiffFunc *GitserverServiceClientDiffFunc
 	// DiskInfoFunc is an instance of a mock function object controlling the
 	// behavior of the method DiskInfo.
 	DiskInfoFunc *GitserverServiceClientDiskInfoFunc
@@ -53,6 +59,9 @@ type MockGitserverServiceClient struct {
 	// ListGitoliteFunc is an instance of a mock function object controlling
 	// the behavior of the method ListGitolite.
 	ListGitoliteFunc *GitserverServiceClientListGitoliteFunc
+	// MergeBaseFunc is an instance of a mock function object controlling
+	// the behavior of the method MergeBase.
+	MergeBaseFunc *GitserverServiceClientMergeBaseFunc
 	// P4ExecFunc is an instance of a mock function object controlling the
 	// behavior of the method P4Exec.
 	P4ExecFunc *GitserverServiceClientP4ExecFunc
@@ -71,6 +80,9 @@ type MockGitserverServiceClient struct {
 	// PerforceUsersFunc is an instance of a mock function object
 	// controlling the behavior of the method PerforceUsers.
 	PerforceUsersFunc *GitserverServiceClientPerforceUsersFunc
+	// ReadFileFunc is an instance of a mock function object controlling the
+	// behavior of the method ReadFile.
+	ReadFileFunc *GitserverServiceClientReadFileFunc
 	// RepoCloneFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoClone.
 	RepoCloneFunc *GitserverServiceClientRepoCloneFunc
@@ -83,9 +95,15 @@ type MockGitserverServiceClient struct {
 	// RepoUpdateFunc is an instance of a mock function object controlling
 	// the behavior of the method RepoUpdate.
 	RepoUpdateFunc *GitserverServiceClientRepoUpdateFunc
+	// RevListFunc is an instance of a mock function object controlling the
+	// behavior of the method RevList.
+	RevListFunc *GitserverServiceClientRevListFunc
 	// SearchFunc is an instance of a mock function object controlling the
 	// behavior of the method Search.
 	SearchFunc *GitserverServiceClientSearchFunc
+	// SubmodulesFunc is an instance of a mock function object controlling
+	// the behavior of the method Submodules.
+	SubmodulesFunc *GitserverServiceClientSubmodulesFunc
 }
 
 // NewMockGitserverServiceClient creates a new mock of the
@@ -103,6 +121,11 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (r0 v1.GitserverService_BlameClient, r1 error) {
+				return
+			},
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (r0 *v1.CheckPerforceCredentialsResponse, r1 error) {
 				return
@@ -113,6 +136,11 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		DiffFunc: &GitserverServiceClientDiffFunc{
+			defaultHook: func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (r0 v1.GitserverService_DiffClient, r1 error) {
+				return
+			},
+		},
 		DiskInfoFunc: &GitserverServiceClientDiskInfoFunc{
 			defaultHook: func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (r0 *v1.DiskInfoResponse, r1 error) {
 				return
@@ -148,6 +176,11 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (r0 *v1.MergeBaseResponse, r1 error) {
+				return
+			},
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (r0 v1.GitserverService_P4ExecClient, r1 error) {
 				return
@@ -178,6 +211,11 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		ReadFileFunc: &GitserverServiceClientReadFileFunc{
+			defaultHook: func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (r0 v1.GitserverService_ReadFileClient, r1 error) {
+				return
+			},
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: func(context.Context, *v1.RepoCloneRequest, ...grpc.CallOption) (r0 *v1.RepoCloneResponse, r1 error) {
 				return
@@ -198,11 +236,21 @@ func NewMockGitserverServiceClient() *MockGitserverServiceClient {
 				return
 			},
 		},
+		RevListFunc: &GitserverServiceClientRevListFunc{
+			defaultHook: func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (r0 v1.GitserverService_RevListClient, r1 error) {
+				return
+			},
+		},
 		SearchFunc: &GitserverServiceClientSearchFunc{
 			defaultHook: func(context.Context, *v1.SearchRequest, ...grpc.CallOption) (r0 v1.GitserverService_SearchClient, r1 error) {
 				return
 			},
 		},
+		SubmodulesFunc: &GitserverServiceClientSubmodulesFunc{
+			defaultHook: func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (r0 *v1.SubmodulesResponse, r1 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -221,6 +269,11 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.BatchLog")
 			},
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.Blame")
+			},
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: func(context.Context, *v1.CheckPerforceCredentialsRequest, ...grpc.CallOption) (*v1.CheckPerforceCredentialsResponse, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.CheckPerforceCredentials")
@@ -231,6 +284,11 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.CreateCommitFromPatchBinary")
 			},
 		},
+		DiffFunc: &GitserverServiceClientDiffFunc{
+			defaultHook: func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.Diff")
+			},
+		},
 		DiskInfoFunc: &GitserverServiceClientDiskInfoFunc{
 			defaultHook: func(context.Context, *v1.DiskInfoRequest, ...grpc.CallOption) (*v1.DiskInfoResponse, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.DiskInfo")
@@ -266,6 +324,11 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.ListGitolite")
 			},
 		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.MergeBase")
+			},
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.P4Exec")
@@ -296,6 +359,11 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.PerforceUsers")
 			},
 		},
+		ReadFileFunc: &GitserverServiceClientReadFileFunc{
+			defaultHook: func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.ReadFile")
+			},
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: func(context.Context, *v1.RepoCloneRequest, ...grpc.CallOption) (*v1.RepoCloneResponse, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.RepoClone")
@@ -316,11 +384,21 @@ func NewStrictMockGitserverServiceClient() *MockGitserverServiceClient {
 				panic("unexpected invocation of MockGitserverServiceClient.RepoUpdate")
 			},
 		},
+		RevListFunc: &GitserverServiceClientRevListFunc{
+			defaultHook: func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.RevList")
+			},
+		},
 		SearchFunc: &GitserverServiceClientSearchFunc{
 			defaultHook: func(context.Context, *v1.SearchRequest, ...grpc.CallOption) (v1.GitserverService_SearchClient, error) {
 				panic("unexpected invocation of MockGitserverServiceClient.Search")
 			},
 		},
+		SubmodulesFunc: &GitserverServiceClientSubmodulesFunc{
+			defaultHook: func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error) {
+				panic("unexpected invocation of MockGitserverServiceClient.Submodules")
+			},
+		},
 	}
 }
 
@@ -335,12 +413,18 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		BatchLogFunc: &GitserverServiceClientBatchLogFunc{
 			defaultHook: i.BatchLog,
 		},
+		BlameFunc: &GitserverServiceClientBlameFunc{
+			defaultHook: i.Blame,
+		},
 		CheckPerforceCredentialsFunc: &GitserverServiceClientCheckPerforceCredentialsFunc{
 			defaultHook: i.CheckPerforceCredentials,
 		},
 		CreateCommitFromPatchBinaryFunc: &GitserverServiceClientCreateCommitFromPatchBinaryFunc{
 			defaultHook: i.CreateCommitFromPatchBinary,
 		},
+		DiffFunc: &GitserverServiceClientDiffFunc{
+			defaultHook: i.Diff,
+		},
 		DiskInfoFunc: &GitserverServiceClientDiskInfoFunc{
 			defaultHook: i.DiskInfo,
 		},
@@ -362,6 +446,9 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		ListGitoliteFunc: &GitserverServiceClientListGitoliteFunc{
 			defaultHook: i.ListGitolite,
 		},
+		MergeBaseFunc: &GitserverServiceClientMergeBaseFunc{
+			defaultHook: i.MergeBase,
+		},
 		P4ExecFunc: &GitserverServiceClientP4ExecFunc{
 			defaultHook: i.P4Exec,
 		},
@@ -380,6 +467,9 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		PerforceUsersFunc: &GitserverServiceClientPerforceUsersFunc{
 			defaultHook: i.PerforceUsers,
 		},
+		ReadFileFunc: &GitserverServiceClientReadFileFunc{
+			defaultHook: i.ReadFile,
+		},
 		RepoCloneFunc: &GitserverServiceClientRepoCloneFunc{
 			defaultHook: i.RepoClone,
 		},
@@ -392,9 +482,15 @@ func NewMockGitserverServiceClientFrom(i v1.GitserverServiceClient) *MockGitserv
 		RepoUpdateFunc: &GitserverServiceClientRepoUpdateFunc{
 			defaultHook: i.RepoUpdate,
 		},
+		RevListFunc: &GitserverServiceClientRevListFunc{
+			defaultHook: i.RevList,
+		},
 		SearchFunc: &GitserverServiceClientSearchFunc{
 			defaultHook: i.Search,
 		},
+		SubmodulesFunc: &GitserverServiceClientSubmodulesFunc{
+			defaultHook: i.Submodules,
+		},
 	}
 }
 
@@ -637,6 +733,124 @@ func (c GitserverServiceClientBatchLogFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientBlameFunc describes the behavior when the Blame
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientBlameFunc struct {
+	defaultHook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)
+	hooks       []func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)
+	history     []GitserverServiceClientBlameFuncCall
+	mutex       sync.Mutex
+}
+
+// Blame delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Blame(v0 context.Context, v1 *v1.BlameRequest, v2 ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+	r0, r1 := m.BlameFunc.nextHook()(v0, v1, v2...)
+	m.BlameFunc.appendCall(GitserverServiceClientBlameFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Blame method of the
+// parent MockGitserverServiceClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverServiceClientBlameFunc) SetDefaultHook(hook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Blame method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientBlameFunc) PushHook(hook func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientBlameFunc) SetDefaultReturn(r0 v1.GitserverService_BlameClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientBlameFunc) PushReturn(r0 v1.GitserverService_BlameClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientBlameFunc) nextHook() func(context.Context, *v1.BlameRequest, ...grpc.CallOption) (v1.GitserverService_BlameClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientBlameFunc) appendCall(r0 GitserverServiceClientBlameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientBlameFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverServiceClientBlameFunc) History() []GitserverServiceClientBlameFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientBlameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientBlameFuncCall is an object that describes an
+// invocation of method Blame on an instance of MockGitserverServiceClient.
+type GitserverServiceClientBlameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.BlameRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_BlameClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientBlameFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientBlameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientCheckPerforceCredentialsFunc describes the behavior
 // when the CheckPerforceCredentials method of the parent
 // MockGitserverServiceClient instance is invoked.
@@ -879,6 +1093,124 @@ func (c GitserverServiceClientCreateCommitFromPatchBinaryFuncCall) Results() []i
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientDiffFunc describes the behavior when the Diff
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientDiffFunc struct {
+	defaultHook func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error)
+	hooks       []func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error)
+	history     []GitserverServiceClientDiffFuncCall
+	mutex       sync.Mutex
+}
+
+// Diff delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Diff(v0 context.Context, v1 *v1.DiffRequest, v2 ...grpc.CallOption) (v1.GitserverService_DiffClient, error) {
+	r0, r1 := m.DiffFunc.nextHook()(v0, v1, v2...)
+	m.DiffFunc.appendCall(GitserverServiceClientDiffFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Diff method of the
+// parent MockGitserverServiceClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverServiceClientDiffFunc) SetDefaultHook(hook func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Diff method of the parent MockGitserverServiceClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *GitserverServiceClientDiffFunc) PushHook(hook func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientDiffFunc) SetDefaultReturn(r0 v1.GitserverService_DiffClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientDiffFunc) PushReturn(r0 v1.GitserverService_DiffClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientDiffFunc) nextHook() func(context.Context, *v1.DiffRequest, ...grpc.CallOption) (v1.GitserverService_DiffClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientDiffFunc) appendCall(r0 GitserverServiceClientDiffFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientDiffFuncCall objects
+// describing the invocations of this function.
+func (f *GitserverServiceClientDiffFunc) History() []GitserverServiceClientDiffFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientDiffFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientDiffFuncCall is an object that describes an
+// invocation of method Diff on an instance of MockGitserverServiceClient.
+type GitserverServiceClientDiffFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.DiffRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_DiffClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientDiffFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientDiffFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientDiskInfoFunc describes the behavior when the
 // DiskInfo method of the parent MockGitserverServiceClient instance is
 // invoked.
@@ -1725,35 +2057,37 @@ func (c GitserverServiceClientListGitoliteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientP4ExecFunc describes the behavior when the P4Exec
-// method of the parent MockGitserverServiceClient instance is invoked.
-type GitserverServiceClientP4ExecFunc struct {
-	defaultHook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)
-	hooks       []func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)
-	history     []GitserverServiceClientP4ExecFuncCall
+// GitserverServiceClientMergeBaseFunc describes the behavior when the
+// MergeBase method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientMergeBaseFunc struct {
+	defaultHook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)
+	hooks       []func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)
+	history     []GitserverServiceClientMergeBaseFuncCall
 	mutex       sync.Mutex
 }
 
-// P4Exec delegates to the next hook function in the queue and stores the
+// MergeBase delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockGitserverServiceClient) P4Exec(v0 context.Context, v1 *v1.P4ExecRequest, v2 ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
-	r0, r1 := m.P4ExecFunc.nextHook()(v0, v1, v2...)
-	m.P4ExecFunc.appendCall(GitserverServiceClientP4ExecFuncCall{v0, v1, v2, r0, r1})
+func (m *MockGitserverServiceClient) MergeBase(v0 context.Context, v1 *v1.MergeBaseRequest, v2 ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
+	r0, r1 := m.MergeBaseFunc.nextHook()(v0, v1, v2...)
+	m.MergeBaseFunc.appendCall(GitserverServiceClientMergeBaseFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the P4Exec method of the
-// parent MockGitserverServiceClient instance is invoked and the hook queue
-// is empty.
-func (f *GitserverServiceClientP4ExecFunc) SetDefaultHook(hook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)) {
+// SetDefaultHook sets function that is called when the MergeBase method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientMergeBaseFunc) SetDefaultHook(hook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// P4Exec method of the parent MockGitserverServiceClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *GitserverServiceClientP4ExecFunc) PushHook(hook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)) {
+// MergeBase method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientMergeBaseFunc) PushHook(hook func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1761,20 +2095,20 @@ func (f *GitserverServiceClientP4ExecFunc) PushHook(hook func(context.Context, *
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *GitserverServiceClientP4ExecFunc) SetDefaultReturn(r0 v1.GitserverService_P4ExecClient, r1 error) {
-	f.SetDefaultHook(func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+func (f *GitserverServiceClientMergeBaseFunc) SetDefaultReturn(r0 *v1.MergeBaseResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *GitserverServiceClientP4ExecFunc) PushReturn(r0 v1.GitserverService_P4ExecClient, r1 error) {
-	f.PushHook(func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+func (f *GitserverServiceClientMergeBaseFunc) PushReturn(r0 *v1.MergeBaseResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 		return r0, r1
 	})
 }
 
-func (f *GitserverServiceClientP4ExecFunc) nextHook() func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+func (f *GitserverServiceClientMergeBaseFunc) nextHook() func(context.Context, *v1.MergeBaseRequest, ...grpc.CallOption) (*v1.MergeBaseResponse, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1787,38 +2121,39 @@ func (f *GitserverServiceClientP4ExecFunc) nextHook() func(context.Context, *v1.
 	return hook
 }
 
-func (f *GitserverServiceClientP4ExecFunc) appendCall(r0 GitserverServiceClientP4ExecFuncCall) {
+func (f *GitserverServiceClientMergeBaseFunc) appendCall(r0 GitserverServiceClientMergeBaseFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of GitserverServiceClientP4ExecFuncCall
+// History returns a sequence of GitserverServiceClientMergeBaseFuncCall
 // objects describing the invocations of this function.
-func (f *GitserverServiceClientP4ExecFunc) History() []GitserverServiceClientP4ExecFuncCall {
+func (f *GitserverServiceClientMergeBaseFunc) History() []GitserverServiceClientMergeBaseFuncCall {
 	f.mutex.Lock()
-	history := make([]GitserverServiceClientP4ExecFuncCall, len(f.history))
+	history := make([]GitserverServiceClientMergeBaseFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// GitserverServiceClientP4ExecFuncCall is an object that describes an
-// invocation of method P4Exec on an instance of MockGitserverServiceClient.
-type GitserverServiceClientP4ExecFuncCall struct {
+// GitserverServiceClientMergeBaseFuncCall is an object that describes an
+// invocation of method MergeBase on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientMergeBaseFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *v1.P4ExecRequest
+	Arg1 *v1.MergeBaseRequest
 	// Arg2 is a slice containing the values of the variadic arguments
 	// passed to this method invocation.
 	Arg2 []grpc.CallOption
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 v1.GitserverService_P4ExecClient
+	Result0 *v1.MergeBaseResponse
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -1828,7 +2163,7 @@ type GitserverServiceClientP4ExecFuncCall struct {
 // invocation. The variadic slice argument is flattened in this array such
 // that one positional argument and three variadic arguments would result in
 // a slice of four, not two.
-func (c GitserverServiceClientP4ExecFuncCall) Args() []interface{} {
+func (c GitserverServiceClientMergeBaseFuncCall) Args() []interface{} {
 	trailing := []interface{}{}
 	for _, val := range c.Arg2 {
 		trailing = append(trailing, val)
@@ -1839,11 +2174,129 @@ func (c GitserverServiceClientP4ExecFuncCall) Args() []interface{} {
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c GitserverServiceClientP4ExecFuncCall) Results() []interface{} {
+func (c GitserverServiceClientMergeBaseFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// GitserverServiceClientPerforceGetChangelistFunc describes the behavior
+// GitserverServiceClientP4ExecFunc describes the behavior when the P4Exec
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientP4ExecFunc struct {
+	defaultHook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)
+	hooks       []func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)
+	history     []GitserverServiceClientP4ExecFuncCall
+	mutex       sync.Mutex
+}
+
+// P4Exec delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) P4Exec(v0 context.Context, v1 *v1.P4ExecRequest, v2 ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+	r0, r1 := m.P4ExecFunc.nextHook()(v0, v1, v2...)
+	m.P4ExecFunc.appendCall(GitserverServiceClientP4ExecFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the P4Exec method of the
+// parent MockGitserverServiceClient instance is invoked and the hook queue
+// is empty.
+func (f *GitserverServiceClientP4ExecFunc) SetDefaultHook(hook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// P4Exec method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientP4ExecFunc) PushHook(hook func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientP4ExecFunc) SetDefaultReturn(r0 v1.GitserverService_P4ExecClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientP4ExecFunc) PushReturn(r0 v1.GitserverService_P4ExecClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientP4ExecFunc) nextHook() func(context.Context, *v1.P4ExecRequest, ...grpc.CallOption) (v1.GitserverService_P4ExecClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientP4ExecFunc) appendCall(r0 GitserverServiceClientP4ExecFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientP4ExecFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientP4ExecFunc) History() []GitserverServiceClientP4ExecFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientP4ExecFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientP4ExecFuncCall is an object that describes an
+// invocation of method P4Exec on an instance of MockGitserverServiceClient.
+type GitserverServiceClientP4ExecFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.P4ExecRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_P4ExecClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientP4ExecFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientP4ExecFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// GitserverServiceClientPerforceGetChangelistFunc describes the behavior
 // when the PerforceGetChangelist method of the parent
 // MockGitserverServiceClient instance is invoked.
 type GitserverServiceClientPerforceGetChangelistFunc struct {
@@ -2452,6 +2905,126 @@ func (c GitserverServiceClientPerforceUsersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientReadFileFunc describes the behavior when the
+// ReadFile method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientReadFileFunc struct {
+	defaultHook func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error)
+	hooks       []func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error)
+	history     []GitserverServiceClientReadFileFuncCall
+	mutex       sync.Mutex
+}
+
+// ReadFile delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) ReadFile(v0 context.Context, v1 *v1.ReadFileRequest, v2 ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error) {
+	r0, r1 := m.ReadFileFunc.nextHook()(v0, v1, v2...)
+	m.ReadFileFunc.appendCall(GitserverServiceClientReadFileFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ReadFile method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientReadFileFunc) SetDefaultHook(hook func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReadFile method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientReadFileFunc) PushHook(hook func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientReadFileFunc) SetDefaultReturn(r0 v1.GitserverService_ReadFileClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientReadFileFunc) PushReturn(r0 v1.GitserverService_ReadFileClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientReadFileFunc) nextHook() func(context.Context, *v1.ReadFileRequest, ...grpc.CallOption) (v1.GitserverService_ReadFileClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientReadFileFunc) appendCall(r0 GitserverServiceClientReadFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientReadFileFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientReadFileFunc) History() []GitserverServiceClientReadFileFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientReadFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientReadFileFuncCall is an object that describes an
+// invocation of method ReadFile on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientReadFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.ReadFileRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_ReadFileClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientReadFileFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientReadFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientRepoCloneFunc describes the behavior when the
 // RepoClone method of the parent MockGitserverServiceClient instance is
 // invoked.
@@ -2937,6 +3510,125 @@ func (c GitserverServiceClientRepoUpdateFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// GitserverServiceClientRevListFunc describes the behavior when the RevList
+// method of the parent MockGitserverServiceClient instance is invoked.
+type GitserverServiceClientRevListFunc struct {
+	defaultHook func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error)
+	hooks       []func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error)
+	history     []GitserverServiceClientRevListFuncCall
+	mutex       sync.Mutex
+}
+
+// RevList delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) RevList(v0 context.Context, v1 *v1.RevListRequest, v2 ...grpc.CallOption) (v1.GitserverService_RevListClient, error) {
+	r0, r1 := m.RevListFunc.nextHook()(v0, v1, v2...)
+	m.RevListFunc.appendCall(GitserverServiceClientRevListFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RevList method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientRevListFunc) SetDefaultHook(hook func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RevList method of the parent MockGitserverServiceClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *GitserverServiceClientRevListFunc) PushHook(hook func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientRevListFunc) SetDefaultReturn(r0 v1.GitserverService_RevListClient, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientRevListFunc) PushReturn(r0 v1.GitserverService_RevListClient, r1 error) {
+	f.PushHook(func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientRevListFunc) nextHook() func(context.Context, *v1.RevListRequest, ...grpc.CallOption) (v1.GitserverService_RevListClient, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientRevListFunc) appendCall(r0 GitserverServiceClientRevListFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientRevListFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientRevListFunc) History() []GitserverServiceClientRevListFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientRevListFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientRevListFuncCall is an object that describes an
+// invocation of method RevList on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientRevListFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.RevListRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 v1.GitserverService_RevListClient
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientRevListFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientRevListFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // GitserverServiceClientSearchFunc describes the behavior when the Search
 // method of the parent MockGitserverServiceClient instance is invoked.
 type GitserverServiceClientSearchFunc struct {
@@ -3054,3 +3746,124 @@ func (c GitserverServiceClientSearchFuncCall) Args() []interface{} {
 func (c GitserverServiceClientSearchFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
+
+// GitserverServiceClientSubmodulesFunc describes the behavior when the
+// Submodules method of the parent MockGitserverServiceClient instance is
+// invoked.
+type GitserverServiceClientSubmodulesFunc struct {
+	defaultHook func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error)
+	hooks       []func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error)
+	history     []GitserverServiceClientSubmodulesFuncCall
+	mutex       sync.Mutex
+}
+
+// Submodules delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockGitserverServiceClient) Submodules(v0 context.Context, v1 *v1.SubmodulesRequest, v2 ...grpc.CallOption) (*v1.SubmodulesResponse, error) {
+	r0, r1 := m.SubmodulesFunc.nextHook()(v0, v1, v2...)
+	m.SubmodulesFunc.appendCall(GitserverServiceClientSubmodulesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Submodules method of
+// the parent MockGitserverServiceClient instance is invoked and the hook
+// queue is empty.
+func (f *GitserverServiceClientSubmodulesFunc) SetDefaultHook(hook func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Submodules method of the parent MockGitserverServiceClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *GitserverServiceClientSubmodulesFunc) PushHook(hook func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *GitserverServiceClientSubmodulesFunc) SetDefaultReturn(r0 *v1.SubmodulesResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *GitserverServiceClientSubmodulesFunc) PushReturn(r0 *v1.SubmodulesResponse, r1 error) {
+	f.PushHook(func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *GitserverServiceClientSubmodulesFunc) nextHook() func(context.Context, *v1.SubmodulesRequest, ...grpc.CallOption) (*v1.SubmodulesResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *GitserverServiceClientSubmodulesFunc) appendCall(r0 GitserverServiceClientSubmodulesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of GitserverServiceClientSubmodulesFuncCall
+// objects describing the invocations of this function.
+func (f *GitserverServiceClientSubmodulesFunc) History() []GitserverServiceClientSubmodulesFuncCall {
+	f.mutex.Lock()
+	history := make([]GitserverServiceClientSubmodulesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// GitserverServiceClientSubmodulesFuncCall is an object that describes an
+// invocation of method Submodules on an instance of
+// MockGitserverServiceClient.
+type GitserverServiceClientSubmodulesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *v1.SubmodulesRequest
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []grpc.CallOption
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *v1.SubmodulesResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c GitserverServiceClientSubmodulesFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c GitserverServiceClientSubmodulesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
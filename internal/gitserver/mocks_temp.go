@@ -195,6 +195,9 @@ type MockClient struct {
 	// StreamBlameFileFunc is an instance of a mock function object
 	// controlling the behavior of the method StreamBlameFile.
 	StreamBlameFileFunc *ClientStreamBlameFileFunc
+	// SubmodulesFunc is an instance of a mock function object controlling
+	// the behavior of the method Submodules.
+	SubmodulesFunc *ClientSubmodulesFunc
 	// SystemInfoFunc is an instance of a mock function object controlling
 	// the behavior of the method SystemInfo.
 	SystemInfoFunc *ClientSystemInfoFunc
@@ -492,6 +495,11 @@ func NewMockClient() *MockClient {
 				return
 			},
 		},
+		SubmodulesFunc: &ClientSubmodulesFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID) (r0 []gitdomain.Submodule, r1 error) {
+				return
+			},
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: func(context.Context, string) (r0 protocol.SystemInfo, r1 error) {
 				return
@@ -794,6 +802,11 @@ func NewStrictMockClient() *MockClient {
 				panic("unexpected invocation of MockClient.StreamBlameFile")
 			},
 		},
+		SubmodulesFunc: &ClientSubmodulesFunc{
+			defaultHook: func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error) {
+				panic("unexpected invocation of MockClient.Submodules")
+			},
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: func(context.Context, string) (protocol.SystemInfo, error) {
 				panic("unexpected invocation of MockClient.SystemInfo")
@@ -982,6 +995,9 @@ func NewMockClientFrom(i Client) *MockClient {
 		StreamBlameFileFunc: &ClientStreamBlameFileFunc{
 			defaultHook: i.StreamBlameFile,
 		},
+		SubmodulesFunc: &ClientSubmodulesFunc{
+			defaultHook: i.Submodules,
+		},
 		SystemInfoFunc: &ClientSystemInfoFunc{
 			defaultHook: i.SystemInfo,
 		},
@@ -7314,6 +7330,116 @@ func (c ClientStreamBlameFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// ClientSubmodulesFunc describes the behavior when the Submodules method of
+// the parent MockClient instance is invoked.
+type ClientSubmodulesFunc struct {
+	defaultHook func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error)
+	hooks       []func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error)
+	history     []ClientSubmodulesFuncCall
+	mutex       sync.Mutex
+}
+
+// Submodules delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockClient) Submodules(v0 context.Context, v1 api.RepoName, v2 api.CommitID) ([]gitdomain.Submodule, error) {
+	r0, r1 := m.SubmodulesFunc.nextHook()(v0, v1, v2)
+	m.SubmodulesFunc.appendCall(ClientSubmodulesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the Submodules method of
+// the parent MockClient instance is invoked and the hook queue is empty.
+func (f *ClientSubmodulesFunc) SetDefaultHook(hook func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Submodules method of the parent MockClient instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *ClientSubmodulesFunc) PushHook(hook func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *ClientSubmodulesFunc) SetDefaultReturn(r0 []gitdomain.Submodule, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *ClientSubmodulesFunc) PushReturn(r0 []gitdomain.Submodule, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error) {
+		return r0, r1
+	})
+}
+
+func (f *ClientSubmodulesFunc) nextHook() func(context.Context, api.RepoName, api.CommitID) ([]gitdomain.Submodule, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *ClientSubmodulesFunc) appendCall(r0 ClientSubmodulesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of ClientSubmodulesFuncCall objects describing
+// the invocations of this function.
+func (f *ClientSubmodulesFunc) History() []ClientSubmodulesFuncCall {
+	f.mutex.Lock()
+	history := make([]ClientSubmodulesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// ClientSubmodulesFuncCall is an object that describes an invocation of
+// method Submodules on an instance of MockClient.
+type ClientSubmodulesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoName
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []gitdomain.Submodule
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c ClientSubmodulesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c ClientSubmodulesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // ClientSystemInfoFunc describes the behavior when the SystemInfo method of
 // the parent MockClient instance is invoked.
 type ClientSystemInfoFunc struct {
@@ -39,6 +39,7 @@ type operations struct {
 	search                   *observation.Operation
 	stat                     *observation.Operation
 	streamBlameFile          *observation.Operation
+	submodules               *observation.Operation
 	systemsInfo              *observation.Operation
 	systemInfo               *observation.Operation
 	requestRepoUpdate        *observation.Operation
@@ -146,6 +147,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 		search:                   op("Search"),
 		stat:                     op("Stat"),
 		streamBlameFile:          op("StreamBlameFile"),
+		submodules:               op("Submodules"),
 		systemsInfo:              op("SystemsInfo"),
 		systemInfo:               op("SystemInfo"),
 		requestRepoUpdate:        op("RequestRepoUpdate"),
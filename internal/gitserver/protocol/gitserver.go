@@ -62,7 +62,8 @@ func SearchRequestFromProto(p *proto.SearchRequest) (*SearchRequest, error) {
 
 type RevisionSpecifier struct {
 	// RevSpec is a revision range specifier suitable for passing to git. See
-	// the manpage gitrevisions(7).
+	// the manpage gitrevisions(7). For example "v1..v2" searches only the
+	// commits reachable from v2 but not from v1.
 	RevSpec string
 
 	// RefGlob is a reference glob to pass to git. See the documentation for
@@ -0,0 +1,114 @@
+package gitserver
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
+)
+
+var replicaFailoverCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_replica_failover_total",
+	Help: "Number of read-only requests that were sent to a replica because the gitserver tried before was unavailable",
+}, []string{"method"})
+
+// replicaClientSource returns the clients of the gitservers that store a repo.
+type replicaClientSource interface {
+	ClientForRepo(ctx context.Context, repo api.RepoName) (proto.GitserverServiceClient, error)
+	ReplicaClientsForRepo(ctx context.Context, repo api.RepoName) []AddressWithClient
+}
+
+// withReplicaFailover calls fn with the client of the primary gitserver of
+// repo. If that gitserver is unavailable, fn is called again with the clients
+// of the replicas of repo in turn, until one of them is reachable.
+//
+// Replicas only ever fetch from the primary, so anything written to a replica
+// is lost. Only use this for read-only requests.
+func withReplicaFailover(ctx context.Context, source replicaClientSource, repo api.RepoName, method string, fn func(proto.GitserverServiceClient) error) error {
+	client, err := source.ClientForRepo(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	err = fn(client)
+	if !isReplicaFailoverError(err) {
+		return err
+	}
+
+	for _, replica := range source.ReplicaClientsForRepo(ctx, repo) {
+		if ctx.Err() != nil {
+			break
+		}
+
+		client, clientErr := replica.GRPCClient()
+		if clientErr != nil {
+			continue
+		}
+
+		replicaFailoverCounter.WithLabelValues(method).Inc()
+		err = fn(client)
+		if !isReplicaFailoverError(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// isReplicaFailoverError returns true if err means the request didn't reach a
+// gitserver, so that it can be retried on a replica.
+func isReplicaFailoverError(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+// peekedStream is a server stream whose first message has already been
+// received.
+type peekedStream[T any] struct {
+	recv func() (T, error)
+
+	first     T
+	firstErr  error
+	firstRead bool
+}
+
+// Recv returns the next message of the stream.
+func (s *peekedStream[T]) Recv() (T, error) {
+	if !s.firstRead {
+		s.firstRead = true
+		return s.first, s.firstErr
+	}
+	return s.recv()
+}
+
+// openStreamWithReplicaFailover opens a server stream with open on the
+// gitserver of repo, and receives its first message. Like withReplicaFailover,
+// the stream is opened on a replica of repo if the gitserver is unavailable.
+//
+// Receiving the first message surfaces errors that the gitserver returns
+// before any data, such as the repo not being found, so that callers can
+// return them right away instead of from their readers. The first message and
+// error are returned again by the first call to Recv.
+func openStreamWithReplicaFailover[T any](ctx context.Context, source replicaClientSource, repo api.RepoName, method string, open func(proto.GitserverServiceClient) (func() (T, error), error)) (*peekedStream[T], error) {
+	var stream *peekedStream[T]
+	err := withReplicaFailover(ctx, source, repo, method, func(client proto.GitserverServiceClient) error {
+		recv, err := open(client)
+		if err != nil {
+			return err
+		}
+		first, firstErr := recv()
+		if isReplicaFailoverError(firstErr) {
+			return firstErr
+		}
+		stream = &peekedStream[T]{recv: recv, first: first, firstErr: firstErr}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
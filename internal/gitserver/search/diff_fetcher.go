@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"os/exec"
 	"sync"
 
@@ -15,6 +16,7 @@ import (
 // started with StartDiffFetcher
 type DiffFetcher struct {
 	dir string
+	env []string
 
 	startOnce sync.Once
 	stdin     io.Writer
@@ -51,6 +53,9 @@ func (d *DiffFetcher) start() (err error) {
 			"--root",           // Treat the root commit as a big creation event (otherwise the diff would be empty)
 		)
 		d.cmd.Dir = d.dir
+		if len(d.env) > 0 {
+			d.cmd.Env = append(os.Environ(), d.env...)
+		}
 
 		var stdoutReader io.ReadCloser
 		stdoutReader, err = d.cmd.StdoutPipe()
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"os/exec"
 	"strings"
 
@@ -89,6 +90,11 @@ type CommitSearcher struct {
 	IncludeDiff          bool
 	IncludeModifiedFiles bool
 	RepoName             api.RepoName
+
+	// Env, if set, is appended to the environment of the git commands run by
+	// the searcher. For example, partial clones need this to fetch missing
+	// blobs from their promisor remote.
+	Env []string
 }
 
 // Search runs a search for commits matching the given predicate across the revisions passed in as revisionArgs.
@@ -146,6 +152,9 @@ func (cs *CommitSearcher) gitArgs() []string {
 func (cs *CommitSearcher) feedBatches(ctx context.Context, jobs chan job, resultChans chan chan *protocol.CommitMatch) (err error) {
 	cmd := exec.CommandContext(ctx, "git", cs.gitArgs()...)
 	cmd.Dir = cs.RepoDir
+	if len(cs.Env) > 0 {
+		cmd.Env = append(os.Environ(), cs.Env...)
+	}
 	stdoutReader, err := cmd.StdoutPipe()
 	if err != nil {
 		return err
@@ -223,6 +232,7 @@ func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 	if err != nil {
 		return err
 	}
+	diffFetcher.env = cs.Env
 	defer diffFetcher.Stop()
 
 	startBuf := make([]byte, 1024)
@@ -131,6 +131,25 @@ func TestSearch(t *testing.T) {
 		require.Len(t, matches, 3)
 	})
 
+	t.Run("revision range", func(t *testing.T) {
+		query := protocol.NewAnd()
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir:   dir,
+			Query:     tree,
+			Revisions: []protocol.RevisionSpecifier{{RevSpec: "HEAD~2..HEAD"}},
+		}
+		var matches []*protocol.CommitMatch
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			matches = append(matches, match)
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 2)
+		require.Equal(t, matches[0].Author.Name, "camden3")
+		require.Equal(t, matches[1].Author.Name, "camden2")
+	})
+
 	t.Run("match diff content", func(t *testing.T) {
 		query := &protocol.DiffMatches{Expr: "ipsum"}
 		tree, err := ToMatchTree(query)
@@ -768,6 +787,12 @@ func Test_revsToGitArgs(t *testing.T) {
 		name:     "implicit HEAD",
 		revSpecs: []protocol.RevisionSpecifier{{}},
 		expected: []string{"HEAD"},
+	}, {
+		name: "range",
+		revSpecs: []protocol.RevisionSpecifier{{
+			RevSpec: "v1.2..v1.3",
+		}},
+		expected: []string{"v1.2..v1.3"},
 	}, {
 		name: "glob",
 		revSpecs: []protocol.RevisionSpecifier{{
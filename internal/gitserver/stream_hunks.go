@@ -2,12 +2,14 @@ package gitserver
 
 import (
 	"bufio"
+	"context"
 	"io"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	proto "github.com/sourcegraph/sourcegraph/internal/gitserver/v1"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -25,7 +27,9 @@ type blameHunkReader struct {
 	commits map[api.CommitID]*Hunk
 }
 
-func newBlameHunkReader(rc io.ReadCloser) HunkReader {
+// NewBlameHunkReader returns a HunkReader that reads the hunks from the output
+// of `git blame --porcelain --incremental`.
+func NewBlameHunkReader(rc io.ReadCloser) HunkReader {
 	return &blameHunkReader{
 		rc:      rc,
 		sc:      bufio.NewScanner(rc),
@@ -173,6 +177,28 @@ func splitLine(line string) (annotation string, content string) {
 	return line, ""
 }
 
+// grpcBlameHunkReader reads hunks from the stream of a Blame RPC.
+type grpcBlameHunkReader struct {
+	stream *peekedStream[*proto.BlameResponse]
+	cancel context.CancelFunc
+}
+
+func (r *grpcBlameHunkReader) Read() (*Hunk, error) {
+	msg, err := r.stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, convertGRPCErrorToGitDomainError(err)
+	}
+	return HunkFromBlameHunkProto(msg.GetHunk()), nil
+}
+
+func (r *grpcBlameHunkReader) Close() error {
+	r.cancel()
+	return nil
+}
+
 type mockHunkReader struct {
 	hunks []*Hunk
 	err   error
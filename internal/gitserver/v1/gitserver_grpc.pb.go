@@ -28,6 +28,12 @@ const (
 	GitserverService_ListGitolite_FullMethodName                = "/gitserver.v1.GitserverService/ListGitolite"
 	GitserverService_Search_FullMethodName                      = "/gitserver.v1.GitserverService/Search"
 	GitserverService_Archive_FullMethodName                     = "/gitserver.v1.GitserverService/Archive"
+	GitserverService_Blame_FullMethodName                       = "/gitserver.v1.GitserverService/Blame"
+	GitserverService_Diff_FullMethodName                        = "/gitserver.v1.GitserverService/Diff"
+	GitserverService_MergeBase_FullMethodName                   = "/gitserver.v1.GitserverService/MergeBase"
+	GitserverService_RevList_FullMethodName                     = "/gitserver.v1.GitserverService/RevList"
+	GitserverService_ReadFile_FullMethodName                    = "/gitserver.v1.GitserverService/ReadFile"
+	GitserverService_Submodules_FullMethodName                  = "/gitserver.v1.GitserverService/Submodules"
 	GitserverService_P4Exec_FullMethodName                      = "/gitserver.v1.GitserverService/P4Exec"
 	GitserverService_RepoClone_FullMethodName                   = "/gitserver.v1.GitserverService/RepoClone"
 	GitserverService_RepoCloneProgress_FullMethodName           = "/gitserver.v1.GitserverService/RepoCloneProgress"
@@ -56,6 +62,12 @@ type GitserverServiceClient interface {
 	ListGitolite(ctx context.Context, in *ListGitoliteRequest, opts ...grpc.CallOption) (*ListGitoliteResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (GitserverService_SearchClient, error)
 	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (GitserverService_ArchiveClient, error)
+	Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (GitserverService_BlameClient, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (GitserverService_DiffClient, error)
+	MergeBase(ctx context.Context, in *MergeBaseRequest, opts ...grpc.CallOption) (*MergeBaseResponse, error)
+	RevList(ctx context.Context, in *RevListRequest, opts ...grpc.CallOption) (GitserverService_RevListClient, error)
+	ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (GitserverService_ReadFileClient, error)
+	Submodules(ctx context.Context, in *SubmodulesRequest, opts ...grpc.CallOption) (*SubmodulesResponse, error)
 	// Deprecated: Do not use.
 	P4Exec(ctx context.Context, in *P4ExecRequest, opts ...grpc.CallOption) (GitserverService_P4ExecClient, error)
 	RepoClone(ctx context.Context, in *RepoCloneRequest, opts ...grpc.CallOption) (*RepoCloneResponse, error)
@@ -255,9 +267,155 @@ func (x *gitserverServiceArchiveClient) Recv() (*ArchiveResponse, error) {
 	return m, nil
 }
 
+func (c *gitserverServiceClient) Blame(ctx context.Context, in *BlameRequest, opts ...grpc.CallOption) (GitserverService_BlameClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[4], GitserverService_Blame_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceBlameClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_BlameClient interface {
+	Recv() (*BlameResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceBlameClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceBlameClient) Recv() (*BlameResponse, error) {
+	m := new(BlameResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (GitserverService_DiffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[5], GitserverService_Diff_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceDiffClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_DiffClient interface {
+	Recv() (*DiffResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceDiffClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceDiffClient) Recv() (*DiffResponse, error) {
+	m := new(DiffResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) MergeBase(ctx context.Context, in *MergeBaseRequest, opts ...grpc.CallOption) (*MergeBaseResponse, error) {
+	out := new(MergeBaseResponse)
+	err := c.cc.Invoke(ctx, GitserverService_MergeBase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gitserverServiceClient) RevList(ctx context.Context, in *RevListRequest, opts ...grpc.CallOption) (GitserverService_RevListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[6], GitserverService_RevList_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceRevListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_RevListClient interface {
+	Recv() (*RevListResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceRevListClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceRevListClient) Recv() (*RevListResponse, error) {
+	m := new(RevListResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) ReadFile(ctx context.Context, in *ReadFileRequest, opts ...grpc.CallOption) (GitserverService_ReadFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[7], GitserverService_ReadFile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gitserverServiceReadFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GitserverService_ReadFileClient interface {
+	Recv() (*ReadFileResponse, error)
+	grpc.ClientStream
+}
+
+type gitserverServiceReadFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *gitserverServiceReadFileClient) Recv() (*ReadFileResponse, error) {
+	m := new(ReadFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gitserverServiceClient) Submodules(ctx context.Context, in *SubmodulesRequest, opts ...grpc.CallOption) (*SubmodulesResponse, error) {
+	out := new(SubmodulesResponse)
+	err := c.cc.Invoke(ctx, GitserverService_Submodules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *gitserverServiceClient) P4Exec(ctx context.Context, in *P4ExecRequest, opts ...grpc.CallOption) (GitserverService_P4ExecClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[4], GitserverService_P4Exec_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &GitserverService_ServiceDesc.Streams[8], GitserverService_P4Exec_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -409,6 +567,12 @@ type GitserverServiceServer interface {
 	ListGitolite(context.Context, *ListGitoliteRequest) (*ListGitoliteResponse, error)
 	Search(*SearchRequest, GitserverService_SearchServer) error
 	Archive(*ArchiveRequest, GitserverService_ArchiveServer) error
+	Blame(*BlameRequest, GitserverService_BlameServer) error
+	Diff(*DiffRequest, GitserverService_DiffServer) error
+	MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error)
+	RevList(*RevListRequest, GitserverService_RevListServer) error
+	ReadFile(*ReadFileRequest, GitserverService_ReadFileServer) error
+	Submodules(context.Context, *SubmodulesRequest) (*SubmodulesResponse, error)
 	// Deprecated: Do not use.
 	P4Exec(*P4ExecRequest, GitserverService_P4ExecServer) error
 	RepoClone(context.Context, *RepoCloneRequest) (*RepoCloneResponse, error)
@@ -457,6 +621,24 @@ func (UnimplementedGitserverServiceServer) Search(*SearchRequest, GitserverServi
 func (UnimplementedGitserverServiceServer) Archive(*ArchiveRequest, GitserverService_ArchiveServer) error {
 	return status.Errorf(codes.Unimplemented, "method Archive not implemented")
 }
+func (UnimplementedGitserverServiceServer) Blame(*BlameRequest, GitserverService_BlameServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blame not implemented")
+}
+func (UnimplementedGitserverServiceServer) Diff(*DiffRequest, GitserverService_DiffServer) error {
+	return status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (UnimplementedGitserverServiceServer) MergeBase(context.Context, *MergeBaseRequest) (*MergeBaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBase not implemented")
+}
+func (UnimplementedGitserverServiceServer) RevList(*RevListRequest, GitserverService_RevListServer) error {
+	return status.Errorf(codes.Unimplemented, "method RevList not implemented")
+}
+func (UnimplementedGitserverServiceServer) ReadFile(*ReadFileRequest, GitserverService_ReadFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFile not implemented")
+}
+func (UnimplementedGitserverServiceServer) Submodules(context.Context, *SubmodulesRequest) (*SubmodulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submodules not implemented")
+}
 func (UnimplementedGitserverServiceServer) P4Exec(*P4ExecRequest, GitserverService_P4ExecServer) error {
 	return status.Errorf(codes.Unimplemented, "method P4Exec not implemented")
 }
@@ -688,6 +870,126 @@ func (x *gitserverServiceArchiveServer) Send(m *ArchiveResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _GitserverService_Blame_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlameRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Blame(m, &gitserverServiceBlameServer{stream})
+}
+
+type GitserverService_BlameServer interface {
+	Send(*BlameResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceBlameServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceBlameServer) Send(m *BlameResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_Diff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiffRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).Diff(m, &gitserverServiceDiffServer{stream})
+}
+
+type GitserverService_DiffServer interface {
+	Send(*DiffResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceDiffServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceDiffServer) Send(m *DiffResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_MergeBase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).MergeBase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_MergeBase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).MergeBase(ctx, req.(*MergeBaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GitserverService_RevList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RevListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).RevList(m, &gitserverServiceRevListServer{stream})
+}
+
+type GitserverService_RevListServer interface {
+	Send(*RevListResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceRevListServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceRevListServer) Send(m *RevListResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_ReadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GitserverServiceServer).ReadFile(m, &gitserverServiceReadFileServer{stream})
+}
+
+type GitserverService_ReadFileServer interface {
+	Send(*ReadFileResponse) error
+	grpc.ServerStream
+}
+
+type gitserverServiceReadFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *gitserverServiceReadFileServer) Send(m *ReadFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GitserverService_Submodules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmodulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GitserverServiceServer).Submodules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GitserverService_Submodules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GitserverServiceServer).Submodules(ctx, req.(*SubmodulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GitserverService_P4Exec_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(P4ExecRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -952,6 +1254,14 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGitolite",
 			Handler:    _GitserverService_ListGitolite_Handler,
 		},
+		{
+			MethodName: "MergeBase",
+			Handler:    _GitserverService_MergeBase_Handler,
+		},
+		{
+			MethodName: "Submodules",
+			Handler:    _GitserverService_Submodules_Handler,
+		},
 		{
 			MethodName: "RepoClone",
 			Handler:    _GitserverService_RepoClone_Handler,
@@ -1022,6 +1332,26 @@ var GitserverService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GitserverService_Archive_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Blame",
+			Handler:       _GitserverService_Blame_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Diff",
+			Handler:       _GitserverService_Diff_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RevList",
+			Handler:       _GitserverService_RevList_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadFile",
+			Handler:       _GitserverService_ReadFile_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "P4Exec",
 			Handler:       _GitserverService_P4Exec_Handler,
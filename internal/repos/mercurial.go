@@ -0,0 +1,147 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A MercurialSource yields repositories from a single Mercurial connection
+// configured in Sourcegraph via the external services configuration. The
+// repositories are converted to Git by gitserver when they are cloned.
+type MercurialSource struct {
+	svc     *types.ExternalService
+	conn    *schema.MercurialConnection
+	baseURL *url.URL
+	exclude excludeFunc
+}
+
+// NewMercurialSource returns a new MercurialSource from the given external
+// service.
+func NewMercurialSource(ctx context.Context, svc *types.ExternalService) (*MercurialSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.MercurialConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	baseURL, err := convertedRepoBaseURL(c.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MercurialSource{
+		svc:     svc,
+		conn:    &c,
+		baseURL: baseURL,
+		exclude: exclude,
+	}, nil
+}
+
+// CheckConnection at this point assumes availability and relies on errors
+// returned when gitserver clones the repositories.
+func (s MercurialSource) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
+// ListRepos returns all the repositories listed in the Mercurial connection.
+func (s MercurialSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	for _, p := range s.conn.Repos {
+		r, err := s.makeRepo(p)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			continue
+		}
+		if s.exclude(string(r.Name)) {
+			continue
+		}
+		results <- SourceResult{Source: s, Repo: r}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s MercurialSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s MercurialSource) makeRepo(repoPath string) (*types.Repo, error) {
+	relativePath, cloneURL, err := convertedRepoURL(s.baseURL, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	name := convertedRepoName(s.conn.RepositoryPathPattern, s.baseURL, relativePath)
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: name,
+		URI:  string(name),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          relativePath,
+			ServiceType: extsvc.VariantMercurial.AsType(),
+			ServiceID:   s.baseURL.String(),
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL,
+			},
+		},
+		Metadata: &extsvc.MercurialRepoMetadata{
+			RelativePath: relativePath,
+		},
+		Private: !s.svc.Unrestricted,
+	}, nil
+}
+
+// convertedRepoBaseURL parses the base URL of a code host whose repositories
+// are converted to Git by gitserver. Credentials are stripped, and the
+// normalized path ends with a slash so that repository paths resolve below it.
+func convertedRepoBaseURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+	u.User = nil
+	return extsvc.NormalizeBaseURL(u), nil
+}
+
+// convertedRepoURL returns the cleaned path of repoPath relative to baseURL,
+// and the URL of the repository.
+func convertedRepoURL(baseURL *url.URL, repoPath string) (relativePath, repoURL string, err error) {
+	relativePath = strings.Trim(path.Clean("/"+repoPath), "/")
+	if relativePath == "" {
+		return "", "", errors.Errorf("invalid repository path %q", repoPath)
+	}
+	u, err := baseURL.Parse(relativePath)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid repository path %q", repoPath)
+	}
+	return relativePath, u.String(), nil
+}
+
+func convertedRepoName(repositoryPathPattern string, baseURL *url.URL, relativePath string) api.RepoName {
+	base := url.URL{Host: baseURL.Host, Path: baseURL.Path}
+	return api.RepoName(reposource.OtherRepoName(repositoryPathPattern, base.String(), relativePath))
+}
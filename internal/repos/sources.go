@@ -78,10 +78,22 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewRustPackagesSource(ctx, svc, cf)
 	case extsvc.KindRubyPackages:
 		return NewRubyPackagesSource(ctx, svc, cf)
+	case extsvc.KindNugetPackages:
+		return NewDotnetPackagesSource(ctx, svc, cf)
+	case extsvc.KindPackagistPackages:
+		return NewPhpPackagesSource(ctx, svc, cf)
+	case extsvc.KindHexPackages:
+		return NewElixirPackagesSource(ctx, svc, cf)
+	case extsvc.KindPubPackages:
+		return NewDartPackagesSource(ctx, svc, cf)
 	case extsvc.KindOther:
 		return NewOtherSource(ctx, svc, cf, logger.Scoped("OtherSource"))
 	case extsvc.VariantLocalGit.AsKind():
 		return NewLocalGitSource(ctx, logger.Scoped("LocalSource"), svc)
+	case extsvc.VariantMercurial.AsKind():
+		return NewMercurialSource(ctx, svc)
+	case extsvc.VariantSubversion.AsKind():
+		return NewSubversionSource(ctx, svc)
 	default:
 		return nil, errors.Newf("cannot create source for kind %q", svc.Kind)
 	}
@@ -0,0 +1,113 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A SubversionSource yields repositories from a single Subversion connection
+// configured in Sourcegraph via the external services configuration. The
+// repositories are converted to Git by gitserver when they are cloned.
+type SubversionSource struct {
+	svc     *types.ExternalService
+	conn    *schema.SubversionConnection
+	baseURL *url.URL
+	exclude excludeFunc
+}
+
+// NewSubversionSource returns a new SubversionSource from the given external
+// service.
+func NewSubversionSource(ctx context.Context, svc *types.ExternalService) (*SubversionSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.SubversionConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d config error", svc.ID)
+	}
+
+	baseURL, err := convertedRepoBaseURL(c.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubversionSource{
+		svc:     svc,
+		conn:    &c,
+		baseURL: baseURL,
+		exclude: exclude,
+	}, nil
+}
+
+// CheckConnection at this point assumes availability and relies on errors
+// returned when gitserver clones the repositories.
+func (s SubversionSource) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
+// ListRepos returns all the repositories listed in the Subversion connection.
+func (s SubversionSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	for _, p := range s.conn.Repos {
+		r, err := s.makeRepo(p)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			continue
+		}
+		if s.exclude(string(r.Name)) {
+			continue
+		}
+		results <- SourceResult{Source: s, Repo: r}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s SubversionSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s SubversionSource) makeRepo(repoPath string) (*types.Repo, error) {
+	relativePath, repoURL, err := convertedRepoURL(s.baseURL, repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	name := convertedRepoName(s.conn.RepositoryPathPattern, s.baseURL, relativePath)
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: name,
+		URI:  string(name),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          relativePath,
+			ServiceType: extsvc.VariantSubversion.AsType(),
+			ServiceID:   s.baseURL.String(),
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: repoURL,
+			},
+		},
+		Metadata: &extsvc.SubversionRepoMetadata{
+			RelativePath: relativePath,
+		},
+		Private: !s.svc.Unrestricted,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestSubversionSource_ListRepos(t *testing.T) {
+	conn := &schema.SubversionConnection{
+		Url:      "svn://svn.example.org/",
+		Username: "admin",
+		Password: "secret",
+		Repos:    []string{"project", "tools/build", "legacy"},
+		Exclude:  []*schema.ExcludedSubversionRepo{{Name: "svn.example.org/legacy"}},
+	}
+	svc := &types.ExternalService{
+		ID:     1,
+		Kind:   extsvc.VariantSubversion.AsKind(),
+		Config: extsvc.NewUnencryptedConfig(MarshalJSON(t, conn)),
+	}
+
+	src, err := NewSubversionSource(context.Background(), svc)
+	require.NoError(t, err)
+
+	repos, err := ListAll(context.Background(), src)
+	require.NoError(t, err)
+
+	want := types.Repos{
+		{
+			Name: "svn.example.org/project",
+			URI:  "svn.example.org/project",
+			ExternalRepo: api.ExternalRepoSpec{
+				ID:          "project",
+				ServiceType: "subversion",
+				ServiceID:   "svn://svn.example.org/",
+			},
+			Sources: map[string]*types.SourceInfo{
+				svc.URN(): {
+					ID:       svc.URN(),
+					CloneURL: "svn://svn.example.org/project",
+				},
+			},
+			Metadata: &extsvc.SubversionRepoMetadata{RelativePath: "project"},
+			Private:  true,
+		},
+		{
+			Name: "svn.example.org/tools/build",
+			URI:  "svn.example.org/tools/build",
+			ExternalRepo: api.ExternalRepoSpec{
+				ID:          "tools/build",
+				ServiceType: "subversion",
+				ServiceID:   "svn://svn.example.org/",
+			},
+			Sources: map[string]*types.SourceInfo{
+				svc.URN(): {
+					ID:       svc.URN(),
+					CloneURL: "svn://svn.example.org/tools/build",
+				},
+			},
+			Metadata: &extsvc.SubversionRepoMetadata{RelativePath: "tools/build"},
+			Private:  true,
+		},
+	}
+	if diff := cmp.Diff(want, types.Repos(repos)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -33,6 +33,11 @@ type SearchJob struct {
 	IncludeModifiedFiles bool
 	Concurrency          int
 
+	// RepoRevs, if set, are the repository revisions to search instead of
+	// resolving RepoOpts. This is used by exhaustive search which resolves
+	// repositories ahead of time and searches one revision at a time.
+	RepoRevs []*search.RepositoryRevisions
+
 	// CodeMonitorSearchWrapper, if set, will wrap the commit search with extra logic specific to code monitors.
 	CodeMonitorSearchWrapper CodeMonitorHook `json:"-"`
 }
@@ -96,11 +101,21 @@ func (j *SearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream
 		return doSearch(args)
 	}
 
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(4).WithFirstError()
+
+	if j.RepoRevs != nil {
+		for _, repoRev := range j.RepoRevs {
+			repoRev := repoRev
+			p.Go(func(ctx context.Context) error {
+				return searchRepoRev(ctx, repoRev)
+			})
+		}
+		return nil, p.Wait()
+	}
+
 	repos := searchrepos.NewResolver(clients.Logger, clients.DB, clients.Gitserver, clients.SearcherURLs, clients.Zoekt)
 	it := repos.Iterator(ctx, j.RepoOpts)
 
-	p := pool.New().WithContext(ctx).WithMaxGoroutines(4).WithFirstError()
-
 	for it.Next() {
 		page := it.Current()
 		page.MaybeSendStats(stream)
@@ -140,6 +155,9 @@ func (j *SearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
 			attribute.Int("limit", j.Limit),
 		)
 		res = append(res, trace.Scoped("repoOpts", j.RepoOpts.Attributes()...)...)
+		if j.RepoRevs != nil {
+			res = append(res, attribute.Int("numRepos", len(j.RepoRevs)))
+		}
 	}
 	return res
 }
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// writeSearchJobDiff writes a CSV of the rows which were added or removed
+// between the results of prevRepoRevs and repoRevs. The first column of every
+// row is "added" or "removed", the remaining columns are the columns of the
+// results.
+//
+// We compare one repository revision at a time so we never need to hold more
+// than the results of a single repository revision in memory. Repository
+// revisions which resolved to the same commit in both jobs are skipped since
+// their results are identical.
+func writeSearchJobDiff(ctx context.Context, uploadStore uploadstore.Store, prevRepoRevs, repoRevs []types.SearchedRepoRevision, w io.Writer) (int64, error) {
+	writeCounter := &writeCounter{w: w}
+	cw := csv.NewWriter(writeCounter)
+
+	var header []string
+	writeRows := func(change string, blobHeader []string, rows [][]string) error {
+		if len(rows) == 0 {
+			return nil
+		}
+		if header == nil {
+			header = blobHeader
+			if err := cw.Write(append([]string{"change"}, header...)); err != nil {
+				return err
+			}
+		} else if !slices.Equal(header, blobHeader) {
+			return errors.Errorf("header mismatch: %v != %v", header, blobHeader)
+		}
+		for _, row := range rows {
+			if err := cw.Write(append([]string{change}, row...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	type repoRevKey struct {
+		repoID   api.RepoID
+		revision string
+	}
+	prevByKey := make(map[repoRevKey]types.SearchedRepoRevision, len(prevRepoRevs))
+	for _, prev := range prevRepoRevs {
+		prevByKey[repoRevKey{prev.RepoID, prev.Revision}] = prev
+	}
+
+	readRows := func(repoRev types.SearchedRepoRevision) ([]string, [][]string, error) {
+		return readBlobstoreCSV(ctx, uploadStore, repoRevPrefix(repoRev))
+	}
+
+	diffRepoRev := func(prev *types.SearchedRepoRevision, cur *types.SearchedRepoRevision) error {
+		var prevHeader, curHeader []string
+		var prevRows, curRows [][]string
+		var err error
+		if prev != nil {
+			if prevHeader, prevRows, err = readRows(*prev); err != nil {
+				return err
+			}
+		}
+		if cur != nil {
+			if curHeader, curRows, err = readRows(*cur); err != nil {
+				return err
+			}
+		}
+
+		added, removed := diffRows(prevHeader, prevRows, curHeader, curRows)
+		if err := writeRows("added", curHeader, added); err != nil {
+			return err
+		}
+		return writeRows("removed", prevHeader, removed)
+	}
+
+	for i := range repoRevs {
+		cur := &repoRevs[i]
+		key := repoRevKey{cur.RepoID, cur.Revision}
+		prev, ok := prevByKey[key]
+		delete(prevByKey, key)
+
+		if ok && prev.CommitID != "" && prev.CommitID == cur.CommitID {
+			continue
+		}
+
+		var prevPtr *types.SearchedRepoRevision
+		if ok {
+			prevPtr = &prev
+		}
+		if err := diffRepoRev(prevPtr, cur); err != nil {
+			return writeCounter.n, errors.Wrapf(err, "diffing repository %d revision %q", cur.RepoID, cur.Revision)
+		}
+	}
+
+	// Whatever is left was only searched by the previous job, so all of its
+	// results were removed. Iterate prevRepoRevs to keep the output stable.
+	for i := range prevRepoRevs {
+		prev := &prevRepoRevs[i]
+		if _, ok := prevByKey[repoRevKey{prev.RepoID, prev.Revision}]; !ok {
+			continue
+		}
+		if err := diffRepoRev(prev, nil); err != nil {
+			return writeCounter.n, errors.Wrapf(err, "diffing repository %d revision %q", prev.RepoID, prev.Revision)
+		}
+	}
+
+	cw.Flush()
+	return writeCounter.n, cw.Error()
+}
+
+// diffRows returns the rows of curRows which are not in prevRows and the rows
+// of prevRows which are not in curRows. Rows are compared with diffRowKey, so
+// columns which only differ because a different commit was searched are
+// ignored.
+func diffRows(prevHeader []string, prevRows [][]string, curHeader []string, curRows [][]string) (added, removed [][]string) {
+	// Count rather than use a set so that duplicate rows are diffed
+	// correctly.
+	prevCount := make(map[string]int, len(prevRows))
+	for _, row := range prevRows {
+		prevCount[diffRowKey(prevHeader, row)]++
+	}
+
+	for _, row := range curRows {
+		k := diffRowKey(curHeader, row)
+		if prevCount[k] > 0 {
+			prevCount[k]--
+			continue
+		}
+		added = append(added, row)
+	}
+
+	for _, row := range prevRows {
+		k := diffRowKey(prevHeader, row)
+		if prevCount[k] > 0 {
+			prevCount[k]--
+			removed = append(removed, row)
+		}
+	}
+
+	return added, removed
+}
+
+// diffRowKey returns a key which identifies the match in row. The revision
+// and URL columns contain the searched commit, so would make every match in a
+// changed repository look new. We leave them out of the key.
+func diffRowKey(header, row []string) string {
+	var b strings.Builder
+	for i, v := range row {
+		if i < len(header) && (header[i] == "revision" || strings.HasSuffix(header[i], "_url")) {
+			continue
+		}
+		fmt.Fprintf(&b, "%d:%s\x00", i, v)
+	}
+	return b.String()
+}
+
+// readBlobstoreCSV reads all the rows written by a BlobstoreCSVWriter with
+// prefix. Every blob starts with the same header, which is returned once.
+func readBlobstoreCSV(ctx context.Context, uploadStore uploadstore.Store, prefix string) (header []string, rows [][]string, err error) {
+	keys, err := blobstoreCSVKeys(ctx, uploadStore, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	readKey := func(key string) error {
+		rc, err := uploadStore.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		records, err := csv.NewReader(rc).ReadAll()
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if header == nil {
+			header = records[0]
+		}
+		rows = append(rows, records[1:]...)
+		return nil
+	}
+
+	for _, key := range keys {
+		if err := readKey(key); err != nil {
+			return nil, nil, errors.Wrapf(err, "reading csv for key %q", key)
+		}
+	}
+	return header, rows, nil
+}
+
+// repoRevPrefix returns the blob prefix the results of repoRev are stored
+// under. See the exhaustive search repo revision worker.
+func repoRevPrefix(repoRev types.SearchedRepoRevision) string {
+	return fmt.Sprintf("%d-%d", repoRev.SearchJobID, repoRev.ID)
+}
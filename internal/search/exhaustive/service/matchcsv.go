@@ -32,7 +32,22 @@ func (w *matchCSVWriter) Write(match result.Match) error {
 
 	switch m := match.(type) {
 	case *result.FileMatch:
+		if len(m.Symbols) > 0 {
+			return w.writeSymbolMatches(m)
+		}
+		if m.IsPathMatch() {
+			return w.writePathMatch(m)
+		}
 		return w.writeFileMatch(m)
+	case *result.CommitMatch:
+		if m.DiffPreview != nil {
+			return w.writeDiffMatch(m)
+		}
+		return w.writeCommitMatch(m)
+	case *result.CommitDiffMatch:
+		return w.writeCommitDiffMatch(m)
+	case *result.RepoMatch:
+		return w.writeRepoMatch(m)
 	default:
 		return errors.Errorf("match type %T not yet supported", match)
 	}
@@ -46,8 +61,9 @@ func (w *matchCSVWriter) writeFileMatch(fm *result.FileMatch) error {
 	// needing to quote them. This makes processing of the output more
 	// pleasant in tools like shell pipelines, sqlite's csv mode, etc.
 	//
-	// Match type :: Excluded since we only have one type for now. When we add
-	// other types we may want to include them in different ways.
+	// Match type :: Excluded. A search job only produces one type of match
+	// and every type has its own set of columns, so the header already
+	// tells you what you are looking at.
 	//
 	// Repository export URL :: We don't like it. It is verbose and is just
 	// repo + rev fields. Unsure why someone would want to click on it.
@@ -109,6 +125,269 @@ func (w *matchCSVWriter) writeFileMatch(fm *result.FileMatch) error {
 	)
 }
 
+func (w *matchCSVWriter) writePathMatch(fm *result.FileMatch) error {
+	// Path matches are produced by queries like "type:path" or
+	// "select:file". There are no chunk matches, so we leave out match_count
+	// and first_match_url in favour of a link to the file.
+
+	if ok, err := w.writeHeader("path"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(
+			"repository",
+			"revision",
+			"file_path",
+			"file_url",
+		); err != nil {
+			return err
+		}
+	}
+
+	return w.w.WriteRow(
+		// repository
+		string(fm.Repo.Name),
+
+		// revision
+		string(fm.CommitID),
+
+		// file_path
+		fm.Path,
+
+		// file_url
+		w.absoluteURL(fm.File.URLAtCommit()),
+	)
+}
+
+func (w *matchCSVWriter) writeSymbolMatches(fm *result.FileMatch) error {
+	// We write one row per symbol rather than per file. Symbol results are
+	// small and consumers want to filter and aggregate on name and kind,
+	// which is awkward if they are packed into a single column.
+
+	if ok, err := w.writeHeader("symbol"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(
+			"repository",
+			"revision",
+			"file_path",
+			"symbol_name",
+			"symbol_kind",
+			"symbol_container",
+			"language",
+			"symbol_url",
+		); err != nil {
+			return err
+		}
+	}
+
+	for _, sm := range fm.Symbols {
+		// Link to the symbol at the commit we searched rather than the input
+		// revision so the link stays valid.
+		symbolURL := sm.URL()
+		symbolURL.Path = fm.File.URLAtCommit().Path
+
+		if err := w.w.WriteRow(
+			// repository
+			string(fm.Repo.Name),
+
+			// revision
+			string(fm.CommitID),
+
+			// file_path
+			fm.Path,
+
+			// symbol_name
+			sm.Symbol.Name,
+
+			// symbol_kind
+			sm.Symbol.Kind,
+
+			// symbol_container
+			sm.Symbol.Parent,
+
+			// language
+			sm.Symbol.Language,
+
+			// symbol_url
+			w.absoluteURL(symbolURL),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *matchCSVWriter) writeCommitMatch(cm *result.CommitMatch) error {
+	// Commit matches are written one row per commit. We include the full
+	// message rather than a preview since audits often want to grep the
+	// exported messages.
+
+	if ok, err := w.writeHeader("commit"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(commitColumns("message", "match_count", "commit_url")...); err != nil {
+			return err
+		}
+	}
+
+	return w.w.WriteRow(commitValues(cm,
+		// message
+		string(cm.Commit.Message),
+
+		// match_count
+		strconv.Itoa(cm.ResultCount()),
+
+		// commit_url
+		w.absoluteURL(cm.URL()),
+	)...)
+}
+
+func (w *matchCSVWriter) writeDiffMatch(cm *result.CommitMatch) error {
+	return w.writeDiffFiles(cm, cm.Diff)
+}
+
+func (w *matchCSVWriter) writeCommitDiffMatch(cdm *result.CommitDiffMatch) error {
+	return w.writeDiffFiles(&result.CommitMatch{
+		Commit: cdm.Commit,
+		Repo:   cdm.Repo,
+	}, []result.DiffFile{*cdm.DiffFile})
+}
+
+// writeDiffFiles writes one row per hunk in diff. We do not include the hunk
+// contents since it is multiline and would make the CSV hard to consume.
+// Instead each row contains the hunk position and a link to the commit, which
+// is enough to build an audit trail and to find the change again.
+func (w *matchCSVWriter) writeDiffFiles(cm *result.CommitMatch, diff []result.DiffFile) error {
+	if ok, err := w.writeHeader("diff"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(commitColumns(
+			"old_path",
+			"new_path",
+			"hunk_header",
+			"added_lines",
+			"removed_lines",
+			"commit_url",
+		)...); err != nil {
+			return err
+		}
+	}
+
+	commitURL := w.absoluteURL(cm.URL())
+
+	for _, df := range diff {
+		for _, h := range df.Hunks {
+			added, removed := countHunkLines(h)
+			if err := w.w.WriteRow(commitValues(cm,
+				// old_path
+				df.OrigName,
+
+				// new_path
+				df.NewName,
+
+				// hunk_header
+				fmt.Sprintf("@@ -%d,%d +%d,%d @@", h.OldStart, h.OldCount, h.NewStart, h.NewCount),
+
+				// added_lines
+				strconv.Itoa(added),
+
+				// removed_lines
+				strconv.Itoa(removed),
+
+				// commit_url
+				commitURL,
+			)...); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func countHunkLines(h result.Hunk) (added, removed int) {
+	for _, line := range h.Lines {
+		if len(line) == 0 {
+			continue
+		}
+		switch line[0] {
+		case '+':
+			added++
+		case '-':
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// commitColumns returns the columns shared by all commit based matches
+// followed by extra.
+func commitColumns(extra ...string) []string {
+	return append([]string{
+		"repository",
+		"commit",
+		"author_name",
+		"author_email",
+		"author_date",
+		"subject",
+	}, extra...)
+}
+
+// commitValues returns the values for the columns in commitColumns followed
+// by extra.
+func commitValues(cm *result.CommitMatch, extra ...string) []string {
+	return append([]string{
+		// repository
+		string(cm.Repo.Name),
+
+		// commit
+		string(cm.Commit.ID),
+
+		// author_name
+		cm.Commit.Author.Name,
+
+		// author_email
+		cm.Commit.Author.Email,
+
+		// author_date
+		formatOrNULL(cm.Commit.Author.Date),
+
+		// subject
+		cm.Commit.Message.Subject(),
+	}, extra...)
+}
+
+func (w *matchCSVWriter) writeRepoMatch(rm *result.RepoMatch) error {
+	if ok, err := w.writeHeader("repo"); err != nil {
+		return err
+	} else if ok {
+		if err := w.w.WriteHeader(
+			"repository",
+			"repository_url",
+		); err != nil {
+			return err
+		}
+	}
+
+	return w.w.WriteRow(
+		// repository
+		string(rm.Name),
+
+		// repository_url
+		w.absoluteURL(rm.URL()),
+	)
+}
+
+// absoluteURL returns u resolved against the external URL of this instance.
+func (w *matchCSVWriter) absoluteURL(u *url.URL) string {
+	abs := *w.host
+	abs.Path = u.Path
+	abs.RawQuery = u.RawQuery
+	abs.Fragment = u.Fragment
+	return abs.String()
+}
+
 // firstMatchRawQuery returns the raw query parameter for the location of the
 // first match. This is what is appended to the sourcegraph URL when clicking
 // on a search result. eg if the match is on line 11 it is "L11". If it is
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestMatchCSVWriter(t *testing.T) {
+	repo := types.MinimalRepo{ID: 1, Name: "github.com/foo/bar"}
+	commit := gitdomain.Commit{
+		ID: "abc123",
+		Author: gitdomain.Signature{
+			Name:  "Alice",
+			Email: "alice@example.com",
+			Date:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		Message: "fix secrets\n\nlonger body",
+	}
+	file := result.File{
+		Repo:     repo,
+		CommitID: "abc123",
+		Path:     "secrets/key.go",
+	}
+
+	cases := []struct {
+		Name    string
+		Matches []result.Match
+		Want    autogold.Value
+	}{{
+		Name: "path",
+		Matches: []result.Match{
+			&result.FileMatch{File: file},
+		},
+		Want: autogold.Expect(`repository,revision,file_path,file_url
+github.com/foo/bar,abc123,secrets/key.go,https://sourcegraph.test/github.com/foo/bar@abc123/-/blob/secrets/key.go
+`),
+	}, {
+		Name: "symbol",
+		Matches: []result.Match{
+			&result.FileMatch{
+				File: file,
+				Symbols: []*result.SymbolMatch{{
+					File: &file,
+					Symbol: result.Symbol{
+						Name:      "Key",
+						Kind:      "function",
+						Parent:    "secrets",
+						Language:  "Go",
+						Line:      3,
+						Character: 5,
+					},
+				}},
+			},
+		},
+		Want: autogold.Expect(`repository,revision,file_path,symbol_name,symbol_kind,symbol_container,language,symbol_url
+github.com/foo/bar,abc123,secrets/key.go,Key,function,secrets,Go,https://sourcegraph.test/github.com/foo/bar@abc123/-/blob/secrets/key.go?L3:6-3:9
+`),
+	}, {
+		Name: "commit",
+		Matches: []result.Match{
+			&result.CommitMatch{
+				Commit:         commit,
+				Repo:           repo,
+				MessagePreview: &result.MatchedString{Content: "fix secrets"},
+			},
+		},
+		Want: autogold.Expect(`repository,commit,author_name,author_email,author_date,subject,message,match_count,commit_url
+github.com/foo/bar,abc123,Alice,alice@example.com,2023-01-02T03:04:05Z,fix secrets,fix secrets
+
+longer body,1,https://sourcegraph.test/github.com/foo/bar/-/commit/abc123
+`),
+	}, {
+		Name: "diff",
+		Matches: []result.Match{
+			&result.CommitMatch{
+				Commit:      commit,
+				Repo:        repo,
+				DiffPreview: &result.MatchedString{Content: "unused"},
+				Diff: []result.DiffFile{{
+					OrigName: "secrets/key.go",
+					NewName:  "secrets/key.go",
+					Hunks: []result.Hunk{{
+						OldStart: 1,
+						OldCount: 2,
+						NewStart: 1,
+						NewCount: 3,
+						Lines:    []string{" package secrets", "-const key = 1", "+const key = 2", "+const other = 3"},
+					}},
+				}},
+			},
+		},
+		Want: autogold.Expect(`repository,commit,author_name,author_email,author_date,subject,old_path,new_path,hunk_header,added_lines,removed_lines,commit_url
+github.com/foo/bar,abc123,Alice,alice@example.com,2023-01-02T03:04:05Z,fix secrets,secrets/key.go,secrets/key.go,@@ -1,2 +1,3 @@,2,1,https://sourcegraph.test/github.com/foo/bar/-/commit/abc123
+`),
+	}, {
+		Name: "repo",
+		Matches: []result.Match{
+			&result.RepoMatch{Name: repo.Name, ID: repo.ID},
+		},
+		Want: autogold.Expect(`repository,repository_url
+github.com/foo/bar,https://sourcegraph.test/github.com/foo/bar
+`),
+	}}
+
+	host, err := url.Parse("https://sourcegraph.test")
+	require.NoError(t, err)
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var buf csvBuffer
+			w := &matchCSVWriter{w: &buf, host: host}
+			for _, m := range tc.Matches {
+				require.NoError(t, w.Write(m))
+			}
+			tc.Want.Equal(t, buf.buf.String())
+		})
+	}
+}
+
+func TestMatchCSVWriter_mixedTypes(t *testing.T) {
+	host, err := url.Parse("https://sourcegraph.test")
+	require.NoError(t, err)
+
+	var buf csvBuffer
+	w := &matchCSVWriter{w: &buf, host: host}
+	require.NoError(t, w.Write(&result.RepoMatch{Name: "foo", ID: 1}))
+	require.Error(t, w.Write(&result.FileMatch{File: result.File{Path: "README.md"}}))
+}
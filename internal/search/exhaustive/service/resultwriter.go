@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/schema"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ResultWriter encodes the rows of a search job in a specific output format.
+// It has the same shape as CSVWriter so that anything which writes rows (eg
+// matchCSVWriter) can write in any format.
+//
+// The caller is expected to call Close() once and only once after the last
+// call to WriteRow.
+type ResultWriter interface {
+	CSVWriter
+
+	// Close flushes any buffered data. It does not close the underlying
+	// io.Writer.
+	Close() error
+}
+
+// NewResultWriter returns a ResultWriter which encodes rows in format to w.
+func NewResultWriter(format types.ResultFormat, w io.Writer) (ResultWriter, error) {
+	switch format {
+	case types.ResultFormatCSV:
+		return &csvResultWriter{w: csv.NewWriter(w)}, nil
+	case types.ResultFormatJSONLines:
+		return &jsonLinesResultWriter{w: w}, nil
+	case types.ResultFormatParquet:
+		return &parquetResultWriter{out: w, rowGroupSize: 10_000}, nil
+	default:
+		return nil, errors.Errorf("unsupported result format %q", format)
+	}
+}
+
+type csvResultWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvResultWriter) WriteHeader(header ...string) error {
+	return c.w.Write(header)
+}
+
+func (c *csvResultWriter) WriteRow(row ...string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvResultWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonLinesResultWriter writes one JSON object per row. The keys are the
+// header columns, in the same order as the header. Every value is a string,
+// which matches what we have in the CSV.
+type jsonLinesResultWriter struct {
+	w      io.Writer
+	header []string
+	buf    bytes.Buffer
+}
+
+func (j *jsonLinesResultWriter) WriteHeader(header ...string) error {
+	if j.header != nil {
+		return errors.New("WriteHeader called more than once")
+	}
+	j.header = header
+	return nil
+}
+
+func (j *jsonLinesResultWriter) WriteRow(row ...string) error {
+	if len(row) != len(j.header) {
+		return errors.Errorf("row has %d columns but header has %d", len(row), len(j.header))
+	}
+
+	// We hand roll the object rather than marshalling a map so that the keys
+	// keep the order of the header.
+	j.buf.Reset()
+	j.buf.WriteByte('{')
+	for i := range row {
+		if i > 0 {
+			j.buf.WriteByte(',')
+		}
+		if err := writeJSONString(&j.buf, j.header[i]); err != nil {
+			return err
+		}
+		j.buf.WriteByte(':')
+		if err := writeJSONString(&j.buf, row[i]); err != nil {
+			return err
+		}
+	}
+	j.buf.WriteString("}\n")
+
+	_, err := j.w.Write(j.buf.Bytes())
+	return err
+}
+
+func (j *jsonLinesResultWriter) Close() error {
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+// parquetResultWriter writes a single parquet file where every column is a
+// required UTF-8 string. Rows are buffered in memory and written out as a row
+// group every rowGroupSize rows.
+type parquetResultWriter struct {
+	out          io.Writer
+	rowGroupSize int
+
+	header []string
+	w      *file.Writer
+
+	// columns is the buffered data for the current row group, one slice per
+	// column.
+	columns [][]parquet.ByteArray
+	rows    int
+}
+
+func (p *parquetResultWriter) WriteHeader(header ...string) error {
+	if p.header != nil {
+		return errors.New("WriteHeader called more than once")
+	}
+
+	fields := make(schema.FieldList, 0, len(header))
+	for _, name := range header {
+		node, err := schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Required, schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1)
+		if err != nil {
+			return errors.Wrapf(err, "creating parquet column %q", name)
+		}
+		fields = append(fields, node)
+	}
+	root, err := schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+	if err != nil {
+		return errors.Wrap(err, "creating parquet schema")
+	}
+
+	p.header = header
+	p.columns = make([][]parquet.ByteArray, len(header))
+	p.w = file.NewParquetWriter(p.out, root)
+	return nil
+}
+
+func (p *parquetResultWriter) WriteRow(row ...string) error {
+	if len(row) != len(p.header) {
+		return errors.Errorf("row has %d columns but header has %d", len(row), len(p.header))
+	}
+
+	for i, v := range row {
+		p.columns[i] = append(p.columns[i], parquet.ByteArray(v))
+	}
+	p.rows++
+
+	if p.rows >= p.rowGroupSize {
+		return p.flushRowGroup()
+	}
+	return nil
+}
+
+func (p *parquetResultWriter) flushRowGroup() error {
+	if p.rows == 0 {
+		return nil
+	}
+
+	rgw := p.w.AppendRowGroup()
+	for i := range p.columns {
+		cw, err := rgw.NextColumn()
+		if err != nil {
+			return err
+		}
+		bw, ok := cw.(*file.ByteArrayColumnChunkWriter)
+		if !ok {
+			return errors.Errorf("unexpected parquet column writer %T", cw)
+		}
+		if _, err := bw.WriteBatch(p.columns[i], nil, nil); err != nil {
+			return err
+		}
+		if err := bw.Close(); err != nil {
+			return err
+		}
+		p.columns[i] = p.columns[i][:0]
+	}
+	p.rows = 0
+
+	return rgw.Close()
+}
+
+func (p *parquetResultWriter) Close() error {
+	// No header means we never saw a row. Like with the other formats we
+	// then write nothing.
+	if p.w == nil {
+		return nil
+	}
+	if err := p.flushRowGroup(); err != nil {
+		return err
+	}
+	return p.w.Close()
+}
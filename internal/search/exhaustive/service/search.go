@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/csv"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -40,7 +42,8 @@ type NewSearcher interface {
 //
 //  1. RepositoryRevSpecs -> just speak to the DB to find the list of repos we need to search.
 //  2. ResolveRepositoryRevSpec -> speak to gitserver to find out which commits to search.
-//  3. Search -> actually do a search.
+//  3. ResolveCommit -> speak to gitserver to find out which commit a revision points at.
+//  4. Search -> actually do a search.
 //
 // This does mean that things like searching a commit in a monorepo are
 // expected to run over a reasonable time frame (eg within a minute?).
@@ -58,7 +61,7 @@ type NewSearcher interface {
 //
 //   - ExhaustiveSearchJob uses RepositoryRevSpecs to create ExhaustiveSearchRepoJob
 //   - ExhaustiveSearchRepoJob uses ResolveRepositoryRevSpec to create ExhaustiveSearchRepoRevisionJob
-//   - ExhaustiveSearchRepoRevisionJob uses Search
+//   - ExhaustiveSearchRepoRevisionJob uses ResolveCommit and Search
 //
 // In each case I imagine NewSearcher.NewSearch(query) to get hold of the
 // SearchQuery. NewSearch is envisioned as being cheap to do. The only IO it
@@ -72,6 +75,12 @@ type SearchQuery interface {
 
 	ResolveRepositoryRevSpec(context.Context, types.RepositoryRevSpecs) ([]types.RepositoryRevision, error)
 
+	// ResolveCommit returns the commit the revision of repoRev points at. We
+	// record it so that a re-run of a search job can skip repository
+	// revisions which haven't changed. An empty commit is returned if the
+	// revision doesn't exist yet, eg HEAD of an empty repository.
+	ResolveCommit(context.Context, types.RepositoryRevision) (api.CommitID, error)
+
 	Search(context.Context, types.RepositoryRevision, CSVWriter) error
 }
 
@@ -229,6 +238,67 @@ func (c *BlobstoreCSVWriter) Close() error {
 	return c.close()
 }
 
+// blobstoreCSVKeys returns the keys of the blobs written by a
+// BlobstoreCSVWriter with prefix, in the order they were written.
+func blobstoreCSVKeys(ctx context.Context, store uploadstore.Store, prefix string) ([]string, error) {
+	iter, err := store.List(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for iter.Next() {
+		key := iter.Current()
+		// List matches on prefix, so "1-2" would also return the blobs of
+		// "1-23". Only keep {prefix} and {prefix}-{shard}.
+		if key == prefix || strings.HasPrefix(key, prefix+"-") {
+			keys = append(keys, key)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	// The first blob has no shard suffix, so sorts first. The shards are
+	// ordered by their number.
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys, nil
+}
+
+// CopyBlobstoreCSV copies the blobs written by a BlobstoreCSVWriter with
+// prefix src to blobs with prefix dst. This is used to reuse the results of a
+// previous search job.
+func CopyBlobstoreCSV(ctx context.Context, store uploadstore.Store, src, dst string) error {
+	keys, err := blobstoreCSVKeys(ctx, store, src)
+	if err != nil {
+		return err
+	}
+
+	copyKey := func(key string) error {
+		rc, err := store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		_, err = store.Upload(ctx, dst+strings.TrimPrefix(key, src), rc)
+		return err
+	}
+
+	for _, key := range keys {
+		if err := copyKey(key); err != nil {
+			return errors.Wrapf(err, "copying blob %q", key)
+		}
+	}
+	return nil
+}
+
 // NewSearcherFake is a convenient working implementation of SearchQuery which
 // always will write results generated from the repoRevs. It expects a query
 // string which looks like
@@ -239,6 +309,7 @@ func (c *BlobstoreCSVWriter) Close() error {
 //
 //	- RepositoryRevSpecs will return one RepositoryRevSpec per unique repository.
 //	- ResolveRepositoryRevSpec returns the repoRevs for that repository.
+//	- ResolveCommit returns the revision as the commit.
 //	- Search will write one result which is just the repo and revision.
 func NewSearcherFake() NewSearcher {
 	return newSearcherFunc(fakeNewSearch)
@@ -312,6 +383,14 @@ func (s searcherFake) ResolveRepositoryRevSpec(ctx context.Context, repoRevSpec
 	return repoRevs, nil
 }
 
+func (s searcherFake) ResolveCommit(ctx context.Context, r types.RepositoryRevision) (api.CommitID, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	return api.CommitID(r.Revision), nil
+}
+
 func (s searcherFake) Search(ctx context.Context, r types.RepositoryRevision, w CSVWriter) error {
 	if err := isSameUser(ctx, s.userID); err != nil {
 		return err
@@ -129,6 +129,33 @@ func TestNoUploadIfNotData(t *testing.T) {
 	}
 }
 
+func TestCopyBlobstoreCSV(t *testing.T) {
+	mockStore := setupMockStore(t)
+	for key, content := range map[string]string{
+		"1-2":    "a\n",
+		"1-2-2":  "b\n",
+		"1-23":   "c\n",
+		"1-23-2": "d\n",
+	} {
+		_, err := mockStore.Upload(context.Background(), key, strings.NewReader(content))
+		require.NoError(t, err)
+	}
+
+	err := CopyBlobstoreCSV(context.Background(), mockStore, "1-2", "5-6")
+	require.NoError(t, err)
+
+	// Only the blobs of 1-2 are copied, not the ones of 1-23.
+	keys, err := blobstoreCSVKeys(context.Background(), mockStore, "5-6")
+	require.NoError(t, err)
+	require.Equal(t, []string{"5-6", "5-6-2"}, keys)
+
+	rc, err := mockStore.Get(context.Background(), "5-6-2")
+	require.NoError(t, err)
+	b, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.Equal(t, "b\n", string(b))
+}
+
 func setupMockStore(t *testing.T) *mocks.MockStore {
 	t.Helper()
 
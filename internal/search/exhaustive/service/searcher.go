@@ -6,6 +6,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/client"
@@ -29,7 +30,10 @@ func FromSearchClient(client client.SearchClient) NewSearcher {
 		// TODO this hack is an ugly workaround to get the plan and jobs to
 		// get into a shape we like. it will break in bad ways but works for
 		// EAP.
-		q = "type:file index:no " + q
+		if !hasTypeFilter(q) {
+			q = "type:file " + q
+		}
+		q = "index:no " + q
 
 		inputs, err := client.Plan(
 			ctx,
@@ -153,6 +157,31 @@ func (s searchQuery) toRepoRevSpecs(ctx context.Context, repoRevSpec types.Repos
 	}, nil
 }
 
+func (s searchQuery) ResolveCommit(ctx context.Context, repoRev types.RepositoryRevision) (api.CommitID, error) {
+	if err := isSameUser(ctx, s.userID); err != nil {
+		return "", err
+	}
+
+	// A revision range (eg rev:v1..v2 type:diff) doesn't point at a single
+	// commit, so there is nothing to record.
+	if (query.RevisionSpecifier{RevSpec: repoRev.Revision}).IsRange() {
+		return "", nil
+	}
+
+	repo, err := s.minimalRepo(ctx, repoRev.Repository)
+	if err != nil {
+		return "", err
+	}
+
+	commitID, err := s.clients.Gitserver.ResolveRevision(ctx, repo.Name, repoRev.Revision, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+	// Like in Search, HEAD of an empty repository is not an error. There is
+	// no commit to record.
+	if repoRev.Revision == "HEAD" && errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+		return "", nil
+	}
+	return commitID, err
+}
+
 func (s searchQuery) Search(ctx context.Context, repoRev types.RepositoryRevision, w CSVWriter) error {
 	if err := isSameUser(ctx, s.userID); err != nil {
 		return err
@@ -224,6 +253,16 @@ func (s searchQuery) minimalRepo(ctx context.Context, repoID api.RepoID) (sgtype
 	return minimalRepos[0], nil
 }
 
+// hasTypeFilter returns true if q explicitly sets the result type. If q fails
+// to parse we return false and leave it up to planning to report the error.
+func hasTypeFilter(q string) bool {
+	parsed, err := query.ParseStandard(q)
+	if err != nil {
+		return false
+	}
+	return parsed.Exists(query.FieldType)
+}
+
 func isReposMissingError(err error) bool {
 	var m repos.MissingRepoRevsError
 	return errors.Is(err, repos.ErrNoResolvedRepos) || errors.HasType(err, &m)
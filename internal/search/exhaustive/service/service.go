@@ -62,9 +62,12 @@ type operations struct {
 	listSearchJobs           *observation.Operation
 	cancelSearchJob          *observation.Operation
 	getAggregateRepoRevState *observation.Operation
+	rerunSearchJob           *observation.Operation
 
-	getSearchJobCSVWriterTo  operationWithWriterTo
-	getSearchJobLogsWriterTo operationWithWriterTo
+	getSearchJobCSVWriterTo     operationWithWriterTo
+	getSearchJobResultsWriterTo operationWithWriterTo
+	getSearchJobLogsWriterTo    operationWithWriterTo
+	getSearchJobDiffWriterTo    operationWithWriterTo
 }
 
 // operationWithWriterTo encodes our pattern around our CSV WriterTo were we
@@ -108,23 +111,35 @@ func newOperations(observationCtx *observation.Context) *operations {
 			listSearchJobs:           op("ListSearchJobs"),
 			cancelSearchJob:          op("CancelSearchJob"),
 			getAggregateRepoRevState: op("GetAggregateRepoRevState"),
+			rerunSearchJob:           op("RerunSearchJob"),
 
 			getSearchJobCSVWriterTo: operationWithWriterTo{
 				get:      op("GetSearchJobCSVWriterTo"),
 				writerTo: op("GetSearchJobCSVWriterTo.WriteTo"),
 			},
+			getSearchJobResultsWriterTo: operationWithWriterTo{
+				get:      op("GetSearchJobResultsWriterTo"),
+				writerTo: op("GetSearchJobResultsWriterTo.WriteTo"),
+			},
 			getSearchJobLogsWriterTo: operationWithWriterTo{
 				get:      op("GetSearchJobLogsWriterTo"),
 				writerTo: op("GetSearchJobLogsWriterTo.WriteTo"),
 			},
+			getSearchJobDiffWriterTo: operationWithWriterTo{
+				get:      op("GetSearchJobDiffWriterTo"),
+				writerTo: op("GetSearchJobDiffWriterTo.WriteTo"),
+			},
 		}
 	})
 	return singletonOperations
 }
 
-func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.ExhaustiveSearchJob, err error) {
+// CreateSearchJob creates a search job for query. The results of the job
+// will be served in format by default.
+func (s *Service) CreateSearchJob(ctx context.Context, query string, format types.ResultFormat) (_ *types.ExhaustiveSearchJob, err error) {
 	ctx, _, endObservation := s.operations.createSearchJob.With(ctx, &err, opAttrs(
 		attribute.String("query", query),
+		attribute.String("format", string(format)),
 	))
 	defer endObservation(1, observation.Args{})
 
@@ -153,8 +168,57 @@ func (s *Service) CreateSearchJob(ctx context.Context, query string) (_ *types.E
 	// ExhaustiveSearchJob type has lots of fields, but reading the store
 	// implementation only two fields are read.
 	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
-		InitiatorID: actor.UID,
-		Query:       query,
+		InitiatorID:  actor.UID,
+		Query:        query,
+		ResultFormat: format,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.GetExhaustiveSearchJob(ctx, jobID)
+}
+
+// RerunSearchJob creates a new search job with the same query and result
+// format as the job id. Repository revisions which still point at the same
+// commit as in job id reuse its results instead of being searched again.
+func (s *Service) RerunSearchJob(ctx context.Context, id int64) (_ *types.ExhaustiveSearchJob, err error) {
+	ctx, _, endObservation := s.operations.rerunSearchJob.With(ctx, &err, opAttrs(
+		attribute.Int64("id", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	if !isEnabled() {
+		return nil, errors.New("search jobs is an experimental feature, enable it by setting \"experimentalFeatures.searchJobs: true\" in site configuration")
+	}
+
+	actor := actor.FromContext(ctx)
+	if !actor.IsAuthenticated() {
+		return nil, errors.New("search jobs can only be created by an authenticated user")
+	}
+
+	// 🚨 SECURITY: GetExhaustiveSearchJob checks that the user has access to
+	// the job.
+	prev, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch prev.AggState {
+	case types.JobStateQueued, types.JobStateProcessing:
+		return nil, errors.Errorf("search job %d is still running", id)
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	jobID, err := tx.CreateExhaustiveSearchJob(ctx, types.ExhaustiveSearchJob{
+		InitiatorID:   actor.UID,
+		Query:         prev.Query,
+		ResultFormat:  prev.ResultFormat,
+		PreviousJobID: prev.ID,
 	})
 	if err != nil {
 		return nil, err
@@ -344,6 +408,90 @@ func (s *Service) GetSearchJobCSVWriterTo(parentCtx context.Context, id int64) (
 	}), nil
 }
 
+// GetSearchJobResultsWriterTo returns a WriterTo which can be called once to
+// write all results of a search job encoded in format. If format is empty the
+// format the job was created with is used. Note: ctx is used by WriterTo.
+//
+// Results are stored as CSV blobs. For CSV we copy the blobs as is, for other
+// formats we decode the blobs and re-encode every row.
+func (s *Service) GetSearchJobResultsWriterTo(parentCtx context.Context, id int64, format types.ResultFormat) (_ io.WriterTo, _ types.ResultFormat, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.get.With(parentCtx, &err, opAttrs(
+		attribute.Int64("id", id),
+		attribute.String("format", string(format))))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: GetExhaustiveSearchJob checks that the user has access to
+	// the job.
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if format == "" {
+		format = job.ResultFormat
+	}
+
+	if format == types.ResultFormatCSV {
+		writerTo, err := s.GetSearchJobCSVWriterTo(parentCtx, id)
+		return writerTo, format, err
+	}
+
+	iter, err := s.uploadStore.List(ctx, getPrefix(id))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return writerToFunc(func(w io.Writer) (n int64, err error) {
+		ctx, _, endObservation := s.operations.getSearchJobResultsWriterTo.writerTo.With(parentCtx, &err, opAttrs(
+			attribute.Int64("id", id),
+			attribute.String("format", string(format))))
+		defer func() {
+			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
+		}()
+
+		return writeSearchJobResults(ctx, iter, s.uploadStore, format, w)
+	}), format, nil
+}
+
+// GetSearchJobDiffWriterTo returns a WriterTo which can be called once to
+// write a CSV of the matches which were added or removed in job id compared
+// to the job it is a re-run of. Note: ctx is used by WriterTo.
+func (s *Service) GetSearchJobDiffWriterTo(parentCtx context.Context, id int64) (_ io.WriterTo, err error) {
+	ctx, _, endObservation := s.operations.getSearchJobDiffWriterTo.get.With(parentCtx, &err, opAttrs(
+		attribute.Int64("id", id)))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: GetExhaustiveSearchJob checks that the user has access to
+	// the job.
+	job, err := s.store.GetExhaustiveSearchJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.PreviousJobID == 0 {
+		return nil, errors.Errorf("search job %d is not a re-run of another search job", id)
+	}
+
+	// 🚨 SECURITY: ListSearchedRepoRevisions checks that the user has access
+	// to both jobs.
+	prevRepoRevs, err := s.store.ListSearchedRepoRevisions(ctx, job.PreviousJobID)
+	if err != nil {
+		return nil, err
+	}
+	repoRevs, err := s.store.ListSearchedRepoRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return writerToFunc(func(w io.Writer) (n int64, err error) {
+		ctx, _, endObservation := s.operations.getSearchJobDiffWriterTo.writerTo.With(parentCtx, &err, opAttrs(
+			attribute.Int64("id", id)))
+		defer func() {
+			endObservation(1, opAttrs(attribute.Int64("bytesWritten", n)))
+		}()
+
+		return writeSearchJobDiff(ctx, s.uploadStore, prevRepoRevs, repoRevs, w)
+	}), nil
+}
+
 // GetAggregateRepoRevState returns the map of state -> count for all repo
 // revision jobs for the given job.
 func (s *Service) GetAggregateRepoRevState(ctx context.Context, id int64) (_ *types.RepoRevJobStats, err error) {
@@ -435,6 +583,69 @@ func writeSearchJobCSV(ctx context.Context, iter *iterator.Iterator[string], upl
 	return n, iter.Err()
 }
 
+// writeSearchJobResults decodes the CSV blobs in iter and writes the rows to w
+// encoded in format.
+func writeSearchJobResults(ctx context.Context, iter *iterator.Iterator[string], uploadStore uploadstore.Store, format types.ResultFormat, w io.Writer) (int64, error) {
+	writeCounter := &writeCounter{w: w}
+	rw, err := NewResultWriter(format, writeCounter)
+	if err != nil {
+		return 0, err
+	}
+
+	var header []string
+	writeKey := func(key string) error {
+		rc, err := uploadStore.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		defer rc.Close()
+
+		cr := csv.NewReader(rc)
+		cr.ReuseRecord = true
+
+		// Every blob starts with the same header. We only write it once.
+		blobHeader, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if header == nil {
+			header = append([]string(nil), blobHeader...)
+			if err := rw.WriteHeader(header...); err != nil {
+				return err
+			}
+		} else if len(header) != len(blobHeader) {
+			return errors.Errorf("header mismatch: %v != %v", header, blobHeader)
+		}
+
+		for {
+			row, err := cr.Read()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if err := rw.WriteRow(row...); err != nil {
+				return err
+			}
+		}
+	}
+
+	for iter.Next() {
+		key := iter.Current()
+		if err := writeKey(key); err != nil {
+			return writeCounter.n, errors.Wrapf(err, "writing %s for key %q", format, key)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return writeCounter.n, err
+	}
+
+	err = rw.Close()
+	return writeCounter.n, err
+}
+
 func writeSearchJobLogs(iter *iterator.Iterator[types.SearchJobLog], w io.Writer) (int64, error) {
 	// For csv.NewWriter we have no way to track bytes written, so we wrap
 	// w to find out. The implementation of csv writer uses a
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/sourcegraph/sourcegraph/internal/search/exhaustive/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
 	"github.com/sourcegraph/sourcegraph/lib/iterator"
 )
@@ -35,3 +37,88 @@ func Test_copyBlobs(t *testing.T) {
 	want := "h/h/h\na/a/a\nb/b/b\nc/c/c\n"
 	require.Equal(t, want, w.String())
 }
+
+func Test_writeSearchJobResults(t *testing.T) {
+	blobs := map[string]string{
+		"a": "repository,file_path\nfoo,a.go\n",
+		"b": "repository,file_path\nbar,\"multi\nline\"\n",
+		"c": "",
+	}
+
+	blobstore := mocks.NewMockStore()
+	blobstore.GetFunc.SetDefaultHook(func(ctx context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(blobs[key])), nil
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		n, err := writeSearchJobResults(context.Background(), iterator.From([]string{"a", "b", "c"}), blobstore, types.ResultFormatJSONLines, w)
+		require.NoError(t, err)
+		require.Equal(t, int64(w.Len()), n)
+
+		want := `{"repository":"foo","file_path":"a.go"}
+{"repository":"bar","file_path":"multi\nline"}
+`
+		require.Equal(t, want, w.String())
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		n, err := writeSearchJobResults(context.Background(), iterator.From([]string{"a", "b", "c"}), blobstore, types.ResultFormatParquet, w)
+		require.NoError(t, err)
+		require.Equal(t, int64(w.Len()), n)
+
+		// A parquet file starts and ends with the magic bytes "PAR1".
+		require.True(t, bytes.HasPrefix(w.Bytes(), []byte("PAR1")))
+		require.True(t, bytes.HasSuffix(w.Bytes(), []byte("PAR1")))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		w := &bytes.Buffer{}
+		n, err := writeSearchJobResults(context.Background(), iterator.From([]string{"c"}), blobstore, types.ResultFormatParquet, w)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+}
+
+func Test_writeSearchJobDiff(t *testing.T) {
+	blobstore := setupMockStore(t)
+	upload := func(key, content string) {
+		_, err := blobstore.Upload(context.Background(), key, strings.NewReader(content))
+		require.NoError(t, err)
+	}
+
+	// Job 1 searched repos 1, 2 and 3. Job 2 is a re-run where repo 1 is
+	// unchanged, repo 2 moved to a new commit, repo 3 is gone and repo 4 is
+	// new.
+	upload("1-10", "repository,revision,file_path\nrepo1,aaa,a.go\n")
+	upload("1-11", "repository,revision,file_path\nrepo2,bbb,b.go\nrepo2,bbb,c.go\n")
+	upload("1-12", "repository,revision,file_path\nrepo3,ccc,d.go\n")
+	upload("2-20", "repository,revision,file_path\nrepo1,aaa,a.go\n")
+	upload("2-21", "repository,revision,file_path\nrepo2,eee,b.go\nrepo2,eee,e.go\n")
+	upload("2-22", "repository,revision,file_path\nrepo4,fff,f.go\n")
+
+	prev := []types.SearchedRepoRevision{
+		{ID: 10, SearchJobID: 1, RepoID: 1, Revision: "HEAD", CommitID: "aaa"},
+		{ID: 11, SearchJobID: 1, RepoID: 2, Revision: "HEAD", CommitID: "bbb"},
+		{ID: 12, SearchJobID: 1, RepoID: 3, Revision: "HEAD", CommitID: "ccc"},
+	}
+	cur := []types.SearchedRepoRevision{
+		{ID: 20, SearchJobID: 2, RepoID: 1, Revision: "HEAD", CommitID: "aaa"},
+		{ID: 21, SearchJobID: 2, RepoID: 2, Revision: "HEAD", CommitID: "eee"},
+		{ID: 22, SearchJobID: 2, RepoID: 4, Revision: "HEAD", CommitID: "fff"},
+	}
+
+	w := &bytes.Buffer{}
+	n, err := writeSearchJobDiff(context.Background(), blobstore, prev, cur, w)
+	require.NoError(t, err)
+	require.Equal(t, int64(w.Len()), n)
+
+	want := `change,repository,revision,file_path
+added,repo2,eee,e.go
+removed,repo2,bbb,c.go
+added,repo4,fff,f.go
+removed,repo3,ccc,d.go
+`
+	require.Equal(t, want, w.String())
+}
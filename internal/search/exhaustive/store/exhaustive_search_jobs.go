@@ -60,6 +60,8 @@ var exhaustiveSearchJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cancel"),
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
+	sqlf.Sprintf("result_format"),
+	sqlf.Sprintf("previous_job_id"),
 }
 
 func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.ExhaustiveSearchJob) (_ int64, err error) {
@@ -75,6 +77,12 @@ func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.Exhaust
 	if job.InitiatorID <= 0 {
 		return 0, MissingInitiatorIDErr
 	}
+	if job.ResultFormat == "" {
+		job.ResultFormat = types.ResultFormatCSV
+	}
+	if !job.ResultFormat.Valid() {
+		return 0, errors.Errorf("unsupported result format %q", job.ResultFormat)
+	}
 
 	// 🚨 SECURITY: InitiatorID has to match the actor or can be overridden by SiteAdmin.
 	if err := auth.CheckSiteAdminOrSameUser(ctx, s.db, job.InitiatorID); err != nil {
@@ -83,7 +91,7 @@ func (s *Store) CreateExhaustiveSearchJob(ctx context.Context, job types.Exhaust
 
 	return basestore.ScanAny[int64](s.Store.QueryRow(
 		ctx,
-		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID),
+		sqlf.Sprintf(createExhaustiveSearchJobQueryFmtr, job.Query, job.InitiatorID, string(job.ResultFormat), dbutil.NullInt64Column(job.PreviousJobID)),
 	))
 }
 
@@ -94,8 +102,8 @@ var MissingQueryErr = errors.New("missing query")
 var MissingInitiatorIDErr = errors.New("missing initiator ID")
 
 const createExhaustiveSearchJobQueryFmtr = `
-INSERT INTO exhaustive_search_jobs (query, initiator_id)
-VALUES (%s, %s)
+INSERT INTO exhaustive_search_jobs (query, initiator_id, result_format, previous_job_id)
+VALUES (%s, %s, %s, %s)
 RETURNING id
 `
 
@@ -546,6 +554,8 @@ func defaultScanTargets(job *types.ExhaustiveSearchJob) []any {
 		&job.Cancel,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&job.ResultFormat,
+		&dbutil.NullInt64{N: &job.PreviousJobID},
 	}
 }
 
@@ -85,6 +85,23 @@ func TestStore_CreateExhaustiveSearchJob(t *testing.T) {
 				Query:       "repo:^github\\.com/hashicorp/errwrap$ CreateExhaustiveSearchJob_exists",
 			},
 		},
+		{
+			name: "JSON lines result format",
+			job: types.ExhaustiveSearchJob{
+				InitiatorID:  userID,
+				Query:        "repo:^github\\.com/hashicorp/errwrap$ CreateExhaustiveSearchJob_jsonl",
+				ResultFormat: types.ResultFormatJSONLines,
+			},
+		},
+		{
+			name: "Unsupported result format",
+			job: types.ExhaustiveSearchJob{
+				InitiatorID:  userID,
+				Query:        "repo:^github\\.com/hashicorp/errwrap$ CreateExhaustiveSearchJob_xml",
+				ResultFormat: "xml",
+			},
+			expectedErr: errors.New("unsupported result format \"xml\""),
+		},
 
 		// Security tests
 		{
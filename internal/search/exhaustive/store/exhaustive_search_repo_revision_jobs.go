@@ -2,10 +2,13 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
+	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -51,6 +54,7 @@ var revSearchJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cancel"),
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
+	sqlf.Sprintf("commit_id"),
 }
 
 func (s *Store) CreateExhaustiveSearchRepoRevisionJob(ctx context.Context, job types.ExhaustiveSearchRepoRevisionJob) (int64, error) {
@@ -112,6 +116,106 @@ func (s *Store) GetQueryRepoRev(ctx context.Context, job *types.ExhaustiveSearch
 	return id, query, repoRev, initiatorID, nil
 }
 
+// SetRepoRevisionJobCommit records the commit the revision of the repo
+// revision job id resolved to when it was searched.
+func (s *Store) SetRepoRevisionJobCommit(ctx context.Context, id int64, commitID api.CommitID) (err error) {
+	ctx, _, endObservation := s.operations.setRepoRevisionJobCommit.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+		attribute.String("commitID", string(commitID)),
+	))
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(setRepoRevisionJobCommitFmtStr, dbutil.NullStringColumn(string(commitID)), id))
+}
+
+const setRepoRevisionJobCommitFmtStr = `
+UPDATE exhaustive_search_repo_revision_jobs
+SET commit_id = %s
+WHERE id = %s
+`
+
+// GetPreviousRepoRevisionJob returns the completed repo revision job which
+// searched the same repository and revision as job in the job's previous
+// search job. It returns nil if the search job isn't a re-run or the previous
+// search job didn't search the repository revision.
+func (s *Store) GetPreviousRepoRevisionJob(ctx context.Context, job *types.ExhaustiveSearchRepoRevisionJob) (_ *types.SearchedRepoRevision, err error) {
+	ctx, _, endObservation := s.operations.getPreviousRepoRevisionJob.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", job.ID),
+	))
+	defer endObservation(1, observation.Args{})
+
+	prev, err := scanSearchedRepoRevision(s.QueryRow(ctx, sqlf.Sprintf(getPreviousRepoRevisionJobFmtStr, job.ID)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return prev, nil
+}
+
+const getPreviousRepoRevisionJobFmtStr = `
+SELECT prrj.id, prj.search_job_id, prj.repo_id, prrj.revision, prrj.commit_id, prrj.state
+FROM exhaustive_search_repo_revision_jobs rrj
+JOIN exhaustive_search_repo_jobs rj ON rrj.search_repo_job_id = rj.id
+JOIN exhaustive_search_jobs sj ON rj.search_job_id = sj.id
+JOIN exhaustive_search_repo_jobs prj ON prj.search_job_id = sj.previous_job_id AND prj.repo_id = rj.repo_id
+JOIN exhaustive_search_repo_revision_jobs prrj ON prrj.search_repo_job_id = prj.id AND prrj.revision = rrj.revision
+WHERE rrj.id = %s AND prrj.state = 'completed'
+ORDER BY prrj.id DESC
+LIMIT 1
+`
+
+// ListSearchedRepoRevisions returns all repository revisions searched by the
+// search job id, ordered by the ID of the repo revision job.
+func (s *Store) ListSearchedRepoRevisions(ctx context.Context, id int64) (_ []types.SearchedRepoRevision, err error) {
+	ctx, _, endObservation := s.operations.listSearchedRepoRevisions.With(ctx, &err, opAttrs(
+		attribute.Int64("ID", id),
+	))
+	defer endObservation(1, observation.Args{})
+
+	// 🚨 SECURITY: only someone with access to the job may list what it searched
+	if err := s.UserHasAccess(ctx, id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(listSearchedRepoRevisionsFmtStr, id))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var repoRevs []types.SearchedRepoRevision
+	for rows.Next() {
+		repoRev, err := scanSearchedRepoRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		repoRevs = append(repoRevs, *repoRev)
+	}
+	return repoRevs, nil
+}
+
+const listSearchedRepoRevisionsFmtStr = `
+SELECT rrj.id, rj.search_job_id, rj.repo_id, rrj.revision, rrj.commit_id, rrj.state
+FROM exhaustive_search_repo_revision_jobs rrj
+JOIN exhaustive_search_repo_jobs rj ON rrj.search_repo_job_id = rj.id
+WHERE rj.search_job_id = %s
+ORDER BY rrj.id ASC
+`
+
+func scanSearchedRepoRevision(sc dbutil.Scanner) (*types.SearchedRepoRevision, error) {
+	var repoRev types.SearchedRepoRevision
+	return &repoRev, sc.Scan(
+		&repoRev.ID,
+		&repoRev.SearchJobID,
+		&repoRev.RepoID,
+		&repoRev.Revision,
+		&dbutil.NullString{S: (*string)(&repoRev.CommitID)},
+		&repoRev.State,
+	)
+}
+
 func scanRevSearchJob(sc dbutil.Scanner) (*types.ExhaustiveSearchRepoRevisionJob, error) {
 	var job types.ExhaustiveSearchRepoRevisionJob
 	// required field for the sync worker, but
@@ -134,5 +238,6 @@ func scanRevSearchJob(sc dbutil.Scanner) (*types.ExhaustiveSearchRepoRevisionJob
 		&job.Cancel,
 		&job.CreatedAt,
 		&job.UpdatedAt,
+		&dbutil.NullString{S: (*string)(&job.CommitID)},
 	)
 }
@@ -69,6 +69,9 @@ type operations struct {
 	createExhaustiveSearchRepoJob         *observation.Operation
 	createExhaustiveSearchRepoRevisionJob *observation.Operation
 	getAggregateRepoRevState              *observation.Operation
+	setRepoRevisionJobCommit              *observation.Operation
+	getPreviousRepoRevisionJob            *observation.Operation
+	listSearchedRepoRevisions             *observation.Operation
 }
 
 var m = new(metrics.SingletonREDMetrics)
@@ -102,5 +105,8 @@ func newOperations(observationCtx *observation.Context) *operations {
 		createExhaustiveSearchRepoJob:         op("CreateExhaustiveSearchRepoJob"),
 		createExhaustiveSearchRepoRevisionJob: op("CreateExhaustiveSearchRepoRevisionJob"),
 		getAggregateRepoRevState:              op("GetAggregateRepoRevState"),
+		setRepoRevisionJobCommit:              op("SetRepoRevisionJobCommit"),
+		getPreviousRepoRevisionJob:            op("GetPreviousRepoRevisionJob"),
+		listSearchedRepoRevisions:             op("ListSearchedRepoRevisions"),
 	}
 }
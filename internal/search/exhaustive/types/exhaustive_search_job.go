@@ -2,7 +2,10 @@ package types
 
 import (
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 // ExhaustiveSearchJob is a job that runs the exhaustive search.
@@ -18,6 +21,15 @@ type ExhaustiveSearchJob struct {
 
 	Query string
 
+	// ResultFormat is the format results are served in when downloading the
+	// job's results.
+	ResultFormat ResultFormat
+
+	// PreviousJobID is the ID of the job this job is a re-run of, or 0 if it
+	// isn't a re-run. Repository revisions whose commit hasn't changed since
+	// the previous job reuse its results.
+	PreviousJobID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
@@ -34,3 +46,54 @@ func (j *ExhaustiveSearchJob) RecordID() int {
 func (j *ExhaustiveSearchJob) RecordUID() string {
 	return strconv.FormatInt(j.ID, 10)
 }
+
+// ResultFormat is the format in which the results of a search job are
+// downloaded. Results are always stored as CSV blobs, the format only affects
+// how they are served.
+type ResultFormat string
+
+const (
+	ResultFormatCSV       ResultFormat = "csv"
+	ResultFormatJSONLines ResultFormat = "jsonl"
+	ResultFormatParquet   ResultFormat = "parquet"
+)
+
+// Valid returns true if f is a known ResultFormat.
+func (f ResultFormat) Valid() bool {
+	switch f {
+	case ResultFormatCSV, ResultFormatJSONLines, ResultFormatParquet:
+		return true
+	}
+	return false
+}
+
+// ParseResultFormat parses s case insensitively. The empty string is treated
+// as ResultFormatCSV.
+func ParseResultFormat(s string) (ResultFormat, error) {
+	if s == "" {
+		return ResultFormatCSV, nil
+	}
+	f := ResultFormat(strings.ToLower(s))
+	if !f.Valid() {
+		return "", errors.Errorf("unsupported result format %q", s)
+	}
+	return f, nil
+}
+
+// FileExtension returns the file extension (without the leading dot) used
+// for downloads in format f.
+func (f ResultFormat) FileExtension() string {
+	return string(f)
+}
+
+// ContentType returns the MIME type used when serving results in format f.
+func (f ResultFormat) ContentType() string {
+	switch f {
+	case ResultFormatJSONLines:
+		return "application/jsonl"
+	case ResultFormatParquet:
+		return "application/vnd.apache.parquet"
+	default:
+		return "text/csv"
+	}
+}
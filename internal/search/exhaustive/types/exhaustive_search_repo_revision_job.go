@@ -17,6 +17,10 @@ type ExhaustiveSearchRepoRevisionJob struct {
 	SearchRepoJobID int64
 	Revision        string
 
+	// CommitID is the commit Revision resolved to when it was searched. It is
+	// empty until the job has been processed.
+	CommitID api.CommitID
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -39,3 +43,19 @@ type SearchJobLog struct {
 	StartedAt      time.Time
 	FinishedAt     time.Time
 }
+
+// SearchedRepoRevision is a repository revision searched by a search job,
+// along with the commit the revision resolved to.
+type SearchedRepoRevision struct {
+	// ID is the ID of the ExhaustiveSearchRepoRevisionJob.
+	ID int64
+
+	// SearchJobID is the ID of the ExhaustiveSearchJob which searched the
+	// repository revision.
+	SearchJobID int64
+
+	RepoID   api.RepoID
+	Revision string
+	CommitID api.CommitID
+	State    JobState
+}
@@ -0,0 +1,45 @@
+package jobutil
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+)
+
+// NewDedupeContentJob creates a job that groups file matches of files with
+// identical content, for queries with `dedupe:content`. The first match of a
+// group is streamed as is, the other locations are streamed as duplicates of it.
+func NewDedupeContentJob(child job.Job) job.Job {
+	return &dedupeContentJob{child: child}
+}
+
+type dedupeContentJob struct {
+	child job.Job
+}
+
+func (j *dedupeContentJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	_, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	return j.child.Run(ctx, clients, streaming.NewContentDedupingStream(stream))
+}
+
+func (j *dedupeContentJob) Name() string {
+	return "DedupeContentJob"
+}
+
+func (j *dedupeContentJob) Attributes(job.Verbosity) []attribute.KeyValue { return nil }
+
+func (j *dedupeContentJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *dedupeContentJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	cp.child = job.Map(j.child, fn)
+	return &cp
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/search/repos"
@@ -17,6 +19,25 @@ import (
 // differentiate ourself from the infrastructure.
 type Exhaustive struct {
 	repoPagerJob *repoPagerJob
+
+	// commitJob is set instead of repoPagerJob for "type:commit" and
+	// "type:diff" queries. Commit search does not go through the repo pager,
+	// so we fill in the repository revisions ourselves in Job.
+	commitJob *commit.SearchJob
+
+	// selector is applied to the results of every job returned by Job. It
+	// is empty if the query does not contain "select:".
+	selector filter.SelectPath
+}
+
+// exhaustiveResultTypes are the values of "type:" we support in exhaustive
+// search.
+var exhaustiveResultTypes = map[string]bool{
+	"file":   true,
+	"path":   true,
+	"symbol": true,
+	"commit": true,
+	"diff":   true,
 }
 
 // NewExhaustive constructs Exhaustive from the search inputs.
@@ -29,11 +50,11 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 		return Exhaustive{}, errors.New("only works for exhaustive search inputs")
 	}
 
-	// This doesn't lead to an error, but we will drop result types other than
-	// "file" which might be surprising to users.
+	// We only support a single result type per job since each type is
+	// exported with its own set of columns.
 	types, _ := inputs.Query.StringValues(query.FieldType)
-	if len(types) != 1 || types[0] != "file" {
-		return Exhaustive{}, errors.Errorf("expected \"type:file\" only. Got %v", types)
+	if len(types) != 1 || !exhaustiveResultTypes[types[0]] {
+		return Exhaustive{}, errors.Errorf("expected exactly one of \"type:file\", \"type:path\", \"type:symbol\", \"type:commit\" or \"type:diff\". Got %v", types)
 	}
 
 	if len(inputs.Plan) != 1 {
@@ -57,11 +78,37 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 		return Exhaustive{}, errors.Errorf("field: predicates are not supported. Got %v", pred)
 	}
 
+	// Each repository revision is searched separately, so we can't group
+	// identical files across repositories.
+	if inputs.Query.Exists(query.FieldDedupe) {
+		return Exhaustive{}, errors.Errorf("dedupe is not supported")
+	}
+
 	// This is a very weak protection but should be enough to catch simple misuse.
 	if inputs.PatternType == query.SearchTypeRegex && term.Value == ".*" {
 		return Exhaustive{}, errors.Errorf("regex search with .* is not supported")
 	}
 
+	var selector filter.SelectPath
+	if v, _ := b.ToParseTree().StringValue(query.FieldSelect); v != "" {
+		selector, _ = filter.SelectPathFromString(v) // Invariant: select already validated
+	}
+
+	if types[0] == "commit" || types[0] == "diff" {
+		diff := types[0] == "diff"
+		repoOptions := toRepoOptions(b, inputs.UserSettings)
+		repoOptions.OnlyCloned = true
+		return Exhaustive{
+			commitJob: &commit.SearchJob{
+				Query:    commit.QueryToGitQuery(b, diff),
+				RepoOpts: repoOptions,
+				Diff:     diff,
+				Limit:    b.MaxResults(inputs.DefaultLimit()),
+			},
+			selector: selector,
+		}, nil
+	}
+
 	planJob, err := NewFlatJob(inputs, query.Flat{Parameters: b.Parameters, Pattern: &term})
 	if err != nil {
 		return Exhaustive{}, err
@@ -74,6 +121,7 @@ func NewExhaustive(inputs *search.Inputs) (Exhaustive, error) {
 
 	return Exhaustive{
 		repoPagerJob: repoPagerJob,
+		selector:     selector,
 	}, nil
 }
 
@@ -91,19 +139,38 @@ func hasPredicates(field string, q query.Q) (pred string, ok bool) {
 func (e Exhaustive) Job(repoRevs *search.RepositoryRevisions) job.Job {
 	// TODO should we add in a timeout and limit here?
 	// TODO should we support indexed search and run through zoekt.PartitionRepos?
-	return e.repoPagerJob.child.Resolve(resolvedRepos{
-		unindexed: []*search.RepositoryRevisions{repoRevs},
-	})
+	var j job.Job
+	if e.commitJob != nil {
+		commitJob := *e.commitJob
+		commitJob.RepoRevs = []*search.RepositoryRevisions{repoRevs}
+		j = &commitJob
+	} else {
+		j = e.repoPagerJob.child.Resolve(resolvedRepos{
+			unindexed: []*search.RepositoryRevisions{repoRevs},
+		})
+	}
+
+	if len(e.selector) > 0 {
+		j = NewSelectJob(e.selector, j)
+	}
+	return j
 }
 
 // RepositoryRevSpecs is a wrapper around repos.Resolver.IterateRepoRevs.
 func (e Exhaustive) RepositoryRevSpecs(ctx context.Context, clients job.RuntimeClients) *iterator.Iterator[repos.RepoRevSpecs] {
-	return reposNewResolver(clients).IterateRepoRevs(ctx, e.repoPagerJob.repoOpts)
+	return reposNewResolver(clients).IterateRepoRevs(ctx, e.repoOpts())
 }
 
 // ResolveRepositoryRevSpec is a wrapper around repos.Resolver.ResolveRevSpecs.
 func (e Exhaustive) ResolveRepositoryRevSpec(ctx context.Context, clients job.RuntimeClients, repoRevSpecs []repos.RepoRevSpecs) (repos.Resolved, error) {
-	return reposNewResolver(clients).ResolveRevSpecs(ctx, e.repoPagerJob.repoOpts, repoRevSpecs)
+	return reposNewResolver(clients).ResolveRevSpecs(ctx, e.repoOpts(), repoRevSpecs)
+}
+
+func (e Exhaustive) repoOpts() search.RepoOptions {
+	if e.commitJob != nil {
+		return e.commitJob.RepoOpts
+	}
+	return e.repoPagerJob.repoOpts
 }
 
 func reposNewResolver(clients job.RuntimeClients) *repos.Resolver {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/commit"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/printer"
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
@@ -135,6 +136,52 @@ func TestNewExhaustive(t *testing.T) {
 	}
 }
 
+func TestNewExhaustive_commit(t *testing.T) {
+	repoRevs := &search.RepositoryRevisions{
+		Repo: types.MinimalRepo{
+			ID:   1,
+			Name: "repo",
+		},
+		Revs: []string{"dev1"},
+	}
+
+	for _, tc := range []struct {
+		query    string
+		wantDiff bool
+	}{
+		{query: `type:commit index:no repo:foo content`},
+		{query: `type:diff index:no repo:foo content`, wantDiff: true},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			searchType := query.SearchTypeLiteral
+			plan, err := query.Pipeline(query.Init(tc.query, searchType))
+			require.NoError(t, err)
+
+			inputs := &search.Inputs{
+				Plan:         plan,
+				Query:        plan.ToQ(),
+				UserSettings: &schema.Settings{},
+				PatternType:  searchType,
+				Protocol:     search.Exhaustive,
+				Features:     &search.Features{},
+			}
+
+			exhaustive, err := NewExhaustive(inputs)
+			require.NoError(t, err)
+			require.Nil(t, exhaustive.repoPagerJob)
+			require.True(t, exhaustive.commitJob.RepoOpts.OnlyCloned)
+
+			j, ok := exhaustive.Job(repoRevs).(*commit.SearchJob)
+			require.True(t, ok)
+			require.Equal(t, tc.wantDiff, j.Diff)
+			require.Equal(t, []*search.RepositoryRevisions{repoRevs}, j.RepoRevs)
+
+			// Job must not mutate the shared commit job.
+			require.Nil(t, exhaustive.commitJob.RepoRevs)
+		})
+	}
+}
+
 func sPrintSexpMax(j job.Describer) string {
 	return "\n" + printer.SexpVerbose(j, job.VerbosityMax, true) + "\n"
 }
@@ -161,6 +208,8 @@ func TestNewExhaustive_negative(t *testing.T) {
 		{query: `type:file index:no repohasfile:foo.bar content`},
 		{query: `type:file index:no file:has.content("content")`},
 		{query: `type:file index:no repo:has.path("src") content`},
+		// dedupe across repositories
+		{query: `type:file index:no dedupe:content content`},
 	}
 
 	for _, c := range tc {
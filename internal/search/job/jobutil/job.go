@@ -146,6 +146,16 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 				if err != nil {
 					return nil, err
 				}
+				// Precise symbols are looked up per repository, so they are
+				// only used for the indexed repositories of a subset of the
+				// universe. Global searches stay with Zoekt.
+				if searcher.DefaultPreciseSymbolSource != nil && isSinglePattern(b) {
+					searchJob = NewPreciseSymbolSearchJob(
+						searchJob.(*zoekt.SymbolSearchJob),
+						toTextPatternInfo(b, resultTypes, inputs.DefaultLimit()),
+						b.MaxResults(inputs.DefaultLimit()),
+					)
+				}
 				addJob(&repoPagerJob{
 					child:            &reposPartialJob{searchJob},
 					repoOpts:         repoOptions,
@@ -229,6 +239,12 @@ func NewBasicJob(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 		}
 	}
 
+	{ // Group file matches with identical content
+		if b.DedupeContent() {
+			basicJob = NewDedupeContentJob(basicJob)
+		}
+	}
+
 	{ // Apply search result sanitization post-filter if enabled
 		if len(inputs.SanitizeSearchPatterns) > 0 {
 			basicJob = NewSanitizeJob(inputs.SanitizeSearchPatterns, basicJob)
@@ -414,6 +430,8 @@ func NewFlatJob(searchInputs *search.Inputs, f query.Flat) (job.Job, error) {
 					query.FieldRepoHasCommitAfter: {},
 					query.FieldPatternType:        {},
 					query.FieldSelect:             {},
+					query.FieldDedupe:             {},
+					query.FieldSubmodules:         {},
 				}
 
 				// Don't run a repo search if the search contains fields that aren't on the allowlist.
@@ -669,8 +687,11 @@ func toTextPatternInfo(b query.Basic, resultTypes result.Types, defaultLimit int
 		Languages:                    langInclude,
 		PathPatternsAreCaseSensitive: b.IsCaseSensitive(),
 		CombyRule:                    b.FindValue(query.FieldCombyRule),
+		Rewrite:                      b.FindValue(query.FieldRewrite),
 		Index:                        b.Index(),
 		Select:                       selector,
+		SearchArchives:               b.SearchArchives(),
+		Package:                      b.Package(),
 	}
 }
 
@@ -759,6 +780,7 @@ func toRepoOptions(b query.Basic, userSettings *schema.Settings) search.RepoOpti
 		UseIndex:            b.Index(),
 		HasKVPs:             b.RepoHasKVPs(),
 		HasTopics:           b.RepoHasTopics(),
+		IncludeSubmodules:   b.IncludeSubmodules(),
 	}
 }
 
@@ -1004,6 +1026,16 @@ func toFlatJobs(inputs *search.Inputs, b query.Basic) (job.Job, error) {
 	}
 }
 
+// isSinglePattern returns whether b has at most one pattern, which isn't
+// negated. Precise symbol search doesn't support other patterns.
+func isSinglePattern(b query.Basic) bool {
+	if b.Pattern == nil {
+		return true
+	}
+	p, ok := b.Pattern.(query.Pattern)
+	return ok && !p.Negated
+}
+
 // isGlobal returns whether a given set of repo options can be fulfilled
 // with a global search with Zoekt.
 func isGlobal(op search.RepoOptions) bool {
@@ -1060,6 +1092,12 @@ func isGlobal(op search.RepoOptions) bool {
 		return false
 	}
 
+	// Submodules are expanded during the repo resolution step, Zoekt only
+	// knows about the default branches of the superprojects.
+	if op.IncludeSubmodules {
+		return false
+	}
+
 	// There should be no cursors when calling this, but if there are that
 	// means we're already paginating. Cursors should probably not live on this
 	// struct since they are an implementation detail of pagination.
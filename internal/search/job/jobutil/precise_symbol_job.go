@@ -0,0 +1,125 @@
+package jobutil
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/job"
+	"github.com/sourcegraph/sourcegraph/internal/search/searcher"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/zoekt"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// NewPreciseSymbolSearchJob creates a job that searches the symbols of the
+// indexed repositories of child in their precise indexes. Only repositories
+// without a precise index at the searched revision are searched by child,
+// whose symbols come from ctags.
+func NewPreciseSymbolSearchJob(child *zoekt.SymbolSearchJob, patternInfo *search.TextPatternInfo, limit int) job.Job {
+	return &preciseSymbolSearchJob{
+		child:       child,
+		patternInfo: patternInfo,
+		limit:       limit,
+	}
+}
+
+type preciseSymbolSearchJob struct {
+	child       *zoekt.SymbolSearchJob
+	patternInfo *search.TextPatternInfo
+	limit       int
+}
+
+func (j *preciseSymbolSearchJob) Run(ctx context.Context, clients job.RuntimeClients, stream streaming.Sender) (alert *search.Alert, err error) {
+	tr, ctx, stream, finish := job.StartSpan(ctx, stream, j)
+	defer func() { finish(alert, err) }()
+
+	if j.child.Repos == nil || len(j.child.Repos.RepoRevs) == 0 {
+		return nil, nil
+	}
+
+	var (
+		mu      sync.Mutex
+		precise = make(map[api.RepoID]struct{})
+	)
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(conf.SearchSymbolsParallelism())
+	for _, repoRevs := range j.child.Repos.RepoRevs {
+		repoRevs := repoRevs
+		// Like the searcher symbol search we only search a single revision
+		// per repository, Zoekt searches repositories with several of them.
+		if len(repoRevs.Revs) != 1 {
+			continue
+		}
+
+		p.Go(func(ctx context.Context) error {
+			matches, ok, err := searcher.SearchPreciseSymbolsInRepo(ctx, clients.Gitserver, repoRevs, j.patternInfo, j.limit)
+			if err != nil {
+				// Zoekt can still search the repository.
+				tr.SetAttributes(repoRevs.Repo.Name.Attr(), trace.Error(err))
+				return nil
+			}
+			if !ok {
+				return nil
+			}
+
+			mu.Lock()
+			precise[repoRevs.Repo.ID] = struct{}{}
+			mu.Unlock()
+
+			status, limitHit, _ := search.HandleRepoSearchResult(repoRevs.Repo.ID, repoRevs.Revs, len(matches) > j.limit, false, nil)
+			stream.Send(streaming.SearchEvent{
+				Results: matches,
+				Stats: streaming.Stats{
+					Status:     status,
+					IsLimitHit: limitHit,
+				},
+			})
+			return nil
+		})
+	}
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+	tr.SetAttributes(attribute.Int("numPreciseRepos", len(precise)))
+
+	if len(precise) == len(j.child.Repos.RepoRevs) {
+		return nil, nil
+	}
+
+	child := *j.child
+	child.Repos = j.child.Repos.Without(precise)
+	return child.Run(ctx, clients, stream)
+}
+
+func (j *preciseSymbolSearchJob) Name() string {
+	return "PreciseSymbolSearchJob"
+}
+
+func (j *preciseSymbolSearchJob) Attributes(v job.Verbosity) (res []attribute.KeyValue) {
+	switch v {
+	case job.VerbosityMax:
+		fallthrough
+	case job.VerbosityBasic:
+		res = append(res, attribute.Int("limit", j.limit))
+	}
+	return res
+}
+
+func (j *preciseSymbolSearchJob) Children() []job.Describer {
+	return []job.Describer{j.child}
+}
+
+func (j *preciseSymbolSearchJob) MapChildren(fn job.MapFunc) job.Job {
+	cp := *j
+	// The child is only replaced by a copy with its repositories set.
+	if child, ok := job.Map(j.child, fn).(*zoekt.SymbolSearchJob); ok {
+		cp.child = child
+	}
+	return &cp
+}
@@ -32,3 +32,15 @@ func Test_setRepos(t *testing.T) {
 	require.Len(t, j.(*ParallelJob).children[0].(*zoekt.RepoSubsetTextSearchJob).Repos.RepoRevs, 1)
 	require.Len(t, j.(*ParallelJob).children[1].(*searcher.TextSearchJob).Repos, 2)
 }
+
+func Test_setRepos_preciseSymbols(t *testing.T) {
+	indexed := &zoekt.IndexedRepoRevs{
+		RepoRevs: map[api.RepoID]*search.RepositoryRevisions{
+			1: {Repo: types.MinimalRepo{Name: "indexed"}},
+		},
+	}
+
+	j := NewPreciseSymbolSearchJob(&zoekt.SymbolSearchJob{}, &search.TextPatternInfo{}, 10)
+	j = setRepos(j, indexed, nil)
+	require.Len(t, j.(*preciseSymbolSearchJob).child.Repos.RepoRevs, 1)
+}
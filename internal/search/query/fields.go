@@ -28,11 +28,16 @@ const (
 	FieldMessage   = "message"
 
 	// Temporary experimental fields:
-	FieldIndex     = "index"
-	FieldCount     = "count" // Searches that specify `count:` will fetch at least that number of results, or the full result set
-	FieldTimeout   = "timeout"
-	FieldCombyRule = "rule"
-	FieldSelect    = "select"
+	FieldIndex      = "index"
+	FieldCount      = "count" // Searches that specify `count:` will fetch at least that number of results, or the full result set
+	FieldTimeout    = "timeout"
+	FieldCombyRule  = "rule"
+	FieldRewrite    = "rewrite"
+	FieldSelect     = "select"
+	FieldDedupe     = "dedupe"
+	FieldSubmodules = "submodules"
+	FieldArchives   = "archives"
+	FieldPackage    = "package"
 )
 
 var allFields = map[string]struct{}{
@@ -68,9 +73,14 @@ var allFields = map[string]struct{}{
 	FieldCount:              empty,
 	FieldTimeout:            empty,
 	FieldCombyRule:          empty,
+	FieldRewrite:            empty,
 	FieldRev:                empty,
 	"revision":              empty,
 	FieldSelect:             empty,
+	FieldDedupe:             empty,
+	FieldSubmodules:         empty,
+	FieldArchives:           empty,
+	FieldPackage:            empty,
 }
 
 var aliases = map[string]string{
@@ -51,6 +51,28 @@ func (r1 RevisionSpecifier) HasRefGlob() bool {
 	return r1.RefGlob != "" || r1.ExcludeRefGlob != ""
 }
 
+// RevisionRange splits a revision range of the form "base..head" into its
+// endpoints. Either endpoint may be empty, in which case git uses HEAD. ok is
+// false if r1 is not a revision range.
+//
+// Symmetric differences ("base...head") are not treated as ranges.
+func (r1 RevisionSpecifier) RevisionRange() (base, head string, ok bool) {
+	if r1.RevSpec == "" {
+		return "", "", false
+	}
+	base, head, ok = strings.Cut(r1.RevSpec, "..")
+	if !ok || strings.HasPrefix(head, ".") {
+		return "", "", false
+	}
+	return base, head, true
+}
+
+// IsRange returns true if r1 is a revision range like "v1.2..v1.3".
+func (r1 RevisionSpecifier) IsRange() bool {
+	_, _, ok := r1.RevisionRange()
+	return ok
+}
+
 type ParsedRepoFilter struct {
 	Repo      string
 	RepoRegex *regexp.Regexp // A case-insensitive regex matching the Repo pattern
@@ -90,6 +112,9 @@ func (p ParsedRepoFilter) String() string {
 //   - 'foo@*bar' refers to the 'foo' repo and all refs matching the glob 'bar/*',
 //     because git interprets the ref glob 'bar' as being 'bar/*' (see `man git-log`
 //     section on the --glob flag)
+//   - 'foo@v1..v2' refers to the 'foo' repo and the commits reachable from 'v2'
+//     but not from 'v1'. Revision ranges are only supported by commit and diff
+//     search.
 func ParseRepositoryRevisions(repoAndOptionalRev string) (ParsedRepoFilter, error) {
 	var repo string
 	var revs []RevisionSpecifier
@@ -35,6 +35,7 @@ func TestParseRepositoryRevisions(t *testing.T) {
 				{RefGlob: "glob3"},
 			},
 		},
+		"repo@v1..v2":      {repo: "repo", revs: []RevisionSpecifier{{RevSpec: "v1..v2"}}},
 		"@rev1":            {repo: "", revs: []RevisionSpecifier{{RevSpec: "rev1"}}},
 		"repo?*@rev1:rev2": {err: &syntax.Error{Code: "invalid nested repetition operator", Expr: "?*"}},
 	}
@@ -61,3 +62,31 @@ func TestParseRepositoryRevisions(t *testing.T) {
 		})
 	}
 }
+
+func TestRevisionSpecifier_RevisionRange(t *testing.T) {
+	type want struct {
+		Base, Head string
+		OK         bool
+	}
+	tests := map[string]want{
+		"v1..v2":  {Base: "v1", Head: "v2", OK: true},
+		"..v2":    {Head: "v2", OK: true},
+		"v1..":    {Base: "v1", OK: true},
+		"v1...v2": {},
+		"v1":      {},
+		"^v1":     {},
+		"":        {},
+	}
+	for input, w := range tests {
+		t.Run(input, func(t *testing.T) {
+			base, head, ok := RevisionSpecifier{RevSpec: input}.RevisionRange()
+			if diff := cmp.Diff(w, want{Base: base, Head: head, OK: ok}); diff != "" {
+				t.Fatalf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if (RevisionSpecifier{RefGlob: "a..b"}).IsRange() {
+		t.Fatal("ref globs are never ranges")
+	}
+}
@@ -559,6 +559,19 @@ func (p Parameters) GetTimeout() *time.Duration {
 	return timeout
 }
 
+// DedupeContent is the value of `dedupe:` which groups file matches with
+// identical content, e.g. the same file in a fork, a mirror and upstream.
+const DedupeContent = "content"
+
+// DedupeContent returns true if the query contains `dedupe:content`.
+func (p Parameters) DedupeContent() bool {
+	result := false
+	VisitField(toNodes(p), FieldDedupe, func(value string, _ bool, _ Annotation) {
+		result = value == DedupeContent
+	})
+	return result
+}
+
 func (p Parameters) VisitParameter(field string, f func(value string, negated bool, annotation Annotation)) {
 	for _, parameter := range p {
 		if parameter.Field == field {
@@ -579,6 +592,24 @@ func (p Parameters) IsCaseSensitive() bool {
 	return p.boolValue(FieldCase)
 }
 
+// IncludeSubmodules returns true if the query contains `submodules:yes`, which
+// expands the searched repositories into the commits their submodules pin.
+func (p Parameters) IncludeSubmodules() bool {
+	return p.boolValue(FieldSubmodules)
+}
+
+// SearchArchives returns true if the query contains `archives:yes`, which
+// searches the files inside of archives like zip and tar files.
+func (p Parameters) SearchArchives() bool {
+	return p.boolValue(FieldArchives)
+}
+
+// Package returns the value of the `package:` filter, a regular expression
+// matching the package that defines a symbol.
+func (p Parameters) Package() string {
+	return p.FindValue(FieldPackage)
+}
+
 func (p Parameters) yesNoOnlyValue(field string) *YesNoOnly {
 	var res *YesNoOnly
 	VisitField(toNodes(p), field, func(value string, _ bool, _ Annotation) {
@@ -592,6 +623,17 @@ func (p Parameters) yesNoOnlyValue(field string) *YesNoOnly {
 }
 
 func (p Parameters) Index() YesNoOnly {
+	// Zoekt doesn't index the contents of archives, so only searcher can
+	// search them.
+	if p.SearchArchives() {
+		return No
+	}
+	// Zoekt's symbols come from ctags and don't record the package that
+	// defines them, so package: filters need the symbols service. Validation
+	// rejects package: combined with index:only.
+	if p.Package() != "" {
+		return No
+	}
 	v := p.yesNoOnlyValue(FieldIndex)
 	if v == nil {
 		return Yes
@@ -222,6 +222,13 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidDedupe := func() error {
+		if value != DedupeContent {
+			return errors.Errorf("invalid value %q for field %q. Valid values are: %s", value, field, DedupeContent)
+		}
+		return nil
+	}
+
 	isValidGitDate := func() error {
 		_, err := ParseGitDate(value, time.Now)
 		return err
@@ -241,7 +248,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldDefault:
 		// Search patterns are not validated here, as it depends on the search type.
 	case
-		FieldCase:
+		FieldCase,
+		FieldSubmodules,
+		FieldArchives:
 		return satisfies(isSingular, isBoolean, isNotNegated)
 	case
 		FieldRepo:
@@ -287,7 +296,11 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		FieldCount:
 		return satisfies(isSingular, isNumber, isNotNegated)
 	case
-		FieldCombyRule:
+		FieldPackage:
+		return satisfies(isSingular, isNotNegated, isValidRegexp)
+	case
+		FieldCombyRule,
+		FieldRewrite:
 		return satisfies(isSingular, isNotNegated)
 	case
 		FieldTimeout:
@@ -298,6 +311,9 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldSelect:
 		return satisfies(isSingular, isNotNegated, isValidSelect)
+	case
+		FieldDedupe:
+		return satisfies(isSingular, isNotNegated, isValidDedupe)
 	default:
 		return isUnrecognizedField()
 	}
@@ -382,6 +398,61 @@ func validateTypeStructural(nodes []Node) error {
 	return nil
 }
 
+// validateRewrite checks that rewrite: is only used with structural search
+// patterns, whose holes it refers to.
+func validateRewrite(nodes []Node) error {
+	seenRewrite := false
+	seenStructural := false
+	VisitParameter(nodes, func(field, _ string, _ bool, _ Annotation) {
+		if field == FieldRewrite {
+			seenRewrite = true
+		}
+	})
+	VisitPattern(nodes, func(_ string, _ bool, annotation Annotation) {
+		if annotation.Labels.IsSet(Structural) {
+			seenStructural = true
+		}
+	})
+	if seenRewrite && !seenStructural {
+		return errors.New("the query contains `rewrite:`, which requires a structural search pattern. Use `patterntype:structural`")
+	}
+	return nil
+}
+
+// validatePackage checks that package: is only used for symbol search, since
+// only precise symbols know which package defines them. For the same reason,
+// package: can't be combined with index:only, since Zoekt's symbols come from
+// ctags.
+func validatePackage(nodes []Node) error {
+	seenPackage := false
+	typeSymbolExists := false
+	indexOnly := false
+	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
+		switch field {
+		case FieldPackage:
+			seenPackage = true
+		case FieldType:
+			if value == "symbol" {
+				typeSymbolExists = true
+			}
+		case FieldIndex:
+			if parseYesNoOnly(value) == Only {
+				indexOnly = true
+			}
+		}
+	})
+	if !seenPackage {
+		return nil
+	}
+	if !typeSymbolExists {
+		return errors.New("the query contains `package:`, which requires type:symbol in the query")
+	}
+	if indexOnly {
+		return errors.New("the query contains `package:`, which can't be combined with index:only, since indexed symbols don't record their package")
+	}
+	return nil
+}
+
 func validateRefGlobs(nodes []Node) error {
 	if !ContainsRefGlobs(nodes) {
 		return nil
@@ -396,6 +467,44 @@ func validateRefGlobs(nodes []Node) error {
 	return nil
 }
 
+// validateRevisionRanges validates that revision ranges like rev:v1..v2 are
+// only used for commit and diff search. Other searches need a single commit
+// to search.
+func validateRevisionRanges(nodes []Node) error {
+	var rangeRev string
+	visitRevs := func(revs []RevisionSpecifier) {
+		for _, rev := range revs {
+			if rangeRev == "" && rev.IsRange() {
+				rangeRev = rev.RevSpec
+			}
+		}
+	}
+
+	repoFilters, _ := Q(nodes).Repositories()
+	for _, r := range repoFilters {
+		visitRevs(r.Revs)
+	}
+	VisitField(nodes, FieldRev, func(value string, _ bool, _ Annotation) {
+		for _, part := range strings.Split(value, ":") {
+			visitRevs([]RevisionSpecifier{ParseRevisionSpecifier(part)})
+		}
+	})
+	if rangeRev == "" {
+		return nil
+	}
+
+	var typeCommitExists bool
+	VisitField(nodes, FieldType, func(value string, _ bool, _ Annotation) {
+		if value == "commit" || value == "diff" {
+			typeCommitExists = true
+		}
+	})
+	if !typeCommitExists {
+		return errors.Errorf("the revision range %q requires type:commit or type:diff in the query", rangeRev)
+	}
+	return nil
+}
+
 // validatePredicates validates predicate parameters with respect to their validation logic.
 func validatePredicate(field, value string, negated bool) error {
 	name, params := ParseAsPredicate(value)                // guaranteed to succeed
@@ -499,7 +608,10 @@ func validate(nodes []Node) error {
 		validateRepoHasFile,
 		validateCommitParameters,
 		validateTypeStructural,
+		validateRewrite,
+		validatePackage,
 		validateRefGlobs,
+		validateRevisionRanges,
 	)
 }
 
@@ -95,6 +95,14 @@ func TestValidation(t *testing.T) {
 			input: "repo:foo author:rob@saucegraph.com",
 			want:  `your query contains the field 'author', which requires type:commit or type:diff in the query`,
 		},
+		{
+			input: "repo:foo rev:v1..v2 bar",
+			want:  `the revision range "v1..v2" requires type:commit or type:diff in the query`,
+		},
+		{
+			input: "repo:foo@v1..v2 type:symbol bar",
+			want:  `the revision range "v1..v2" requires type:commit or type:diff in the query`,
+		},
 		{
 			input: "repohasfile:README type:symbol yolo",
 			want:  "repohasfile is not compatible for type:symbol. Subscribe to https://github.com/sourcegraph/sourcegraph/issues/4610 for updates",
@@ -111,6 +119,30 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "foo dedupe:repo",
+			want:  `invalid value "repo" for field "dedupe". Valid values are: content`,
+		},
+		{
+			input: "foo -dedupe:content",
+			want:  `field "dedupe" does not support negation`,
+		},
+		{
+			input: "foo submodules:maybe",
+			want:  `invalid boolean "maybe"`,
+		},
+		{
+			input: "foo -submodules:yes",
+			want:  `field "submodules" does not support negation`,
+		},
+		{
+			input: "foo archives:maybe",
+			want:  `invalid boolean "maybe"`,
+		},
+		{
+			input: "foo -archives:yes",
+			want:  `field "archives" does not support negation`,
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -121,6 +153,31 @@ func TestValidation(t *testing.T) {
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents and is not currently supported for diff searches",
 			searchType: SearchTypeStructural,
 		},
+		{
+			input: "foo(:[x]) rewrite:bar(:[x])",
+			want:  "the query contains `rewrite:`, which requires a structural search pattern. Use `patterntype:structural`",
+		},
+		{
+			input:      "foo(:[x]) -rewrite:bar(:[x])",
+			want:       `field "rewrite" does not support negation`,
+			searchType: SearchTypeStructural,
+		},
+		{
+			input: "foo package:github.com/foo/bar",
+			want:  "the query contains `package:`, which requires type:symbol in the query",
+		},
+		{
+			input: "type:symbol foo -package:bar",
+			want:  `field "package" does not support negation`,
+		},
+		{
+			input: "type:symbol foo package:bar package:baz",
+			want:  `field "package" may not be used more than once`,
+		},
+		{
+			input: "type:symbol foo package:bar index:only",
+			want:  "the query contains `package:`, which can't be combined with index:only, since indexed symbols don't record their package",
+		},
 	}
 	for _, c := range cases {
 		t.Run("validate and/or query", func(t *testing.T) {
@@ -399,6 +399,13 @@ func (r *Resolver) filterGitserver(ctx context.Context, tr trace.Trace, op searc
 	}
 	tr.AddEvent("completed rev filtering")
 
+	tr.AddEvent("starting submodule expansion")
+	filteredRepoRevs, err = r.expandSubmodules(ctx, filteredRepoRevs, op)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "expand submodules")
+	}
+	tr.AddEvent("finished submodule expansion")
+
 	return filteredRepoRevs, normalizedMissingRepoRevs, nil
 }
 
@@ -531,6 +538,29 @@ func (r *Resolver) normalizeRepoRefs(
 			// instead of just []string because we have the exact commit hashes,
 			// so we could avoid resolving later.
 			revs = append(revs, rev.RevSpec)
+		case rev.IsRange():
+			// A range is not a commit, so we check that both endpoints
+			// exist. Empty endpoints mean HEAD.
+			base, head, _ := rev.RevisionRange()
+			missing := false
+			for _, endpoint := range []string{base, head} {
+				if endpoint == "" {
+					continue
+				}
+				_, err := r.gitserver.ResolveRevision(ctx, repo.Name, endpoint, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+				if err != nil {
+					if errors.Is(err, context.DeadlineExceeded) || errors.HasType(err, &gitdomain.BadCommitError{}) {
+						return nil, err
+					}
+					missing = true
+					break
+				}
+			}
+			if missing {
+				reportMissing(RepoRevSpecs{Repo: repo, Revs: []query.RevisionSpecifier{rev}})
+				continue
+			}
+			revs = append(revs, rev.RevSpec)
 		case rev.RevSpec != "":
 			trimmedRev := strings.TrimPrefix(rev.RevSpec, "^")
 			_, err := r.gitserver.ResolveRevision(ctx, repo.Name, trimmedRev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
@@ -635,6 +665,106 @@ func (r *Resolver) filterHasCommitAfter(
 	return filteredRepoRevs, nil
 }
 
+// expandSubmodules adds the commits pinned by the submodules of each of a set
+// of RepositoryRevisions to the revisions to search, if `submodules:yes` is
+// set. Submodules are only expanded one level deep, and only if they resolve
+// to repositories the user has access to.
+func (r *Resolver) expandSubmodules(
+	ctx context.Context,
+	repoRevs []*search.RepositoryRevisions,
+	op search.RepoOptions,
+) (
+	[]*search.RepositoryRevisions,
+	error,
+) {
+	if !op.IncludeSubmodules {
+		return repoRevs, nil
+	}
+
+	var (
+		mu     sync.Mutex
+		pinned = map[api.RepoName][]string{}
+	)
+
+	p := pool.New().WithContext(ctx).WithMaxGoroutines(128)
+	for _, repoRev := range repoRevs {
+		repoRev := repoRev
+		for _, rev := range repoRev.Revs {
+			rev := rev
+			// Ranges don't pin submodules to a single commit, and the
+			// commits of excluded revisions are not searched.
+			if strings.Contains(rev, "..") || strings.HasPrefix(rev, "^") {
+				continue
+			}
+			p.Go(func(ctx context.Context) error {
+				commit, err := r.gitserver.ResolveRevision(ctx, repoRev.Repo.Name, rev, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+				if err != nil {
+					if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+						return nil
+					}
+					return err
+				}
+				submodules, err := r.gitserver.Submodules(ctx, repoRev.Repo.Name, commit)
+				if err != nil {
+					if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+						return nil
+					}
+					return err
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				for _, s := range submodules {
+					if s.Repo == "" || s.Repo == repoRev.Repo.Name {
+						continue
+					}
+					pinned[s.Repo] = append(pinned[s.Repo], string(s.CommitID))
+				}
+				return nil
+			})
+		}
+	}
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+	if len(pinned) == 0 {
+		return repoRevs, nil
+	}
+
+	names := make([]string, 0, len(pinned))
+	for name := range pinned {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	// Listing the repositories from the database drops the ones the user
+	// can't access.
+	repos, err := r.db.Repos().ListMinimalRepos(ctx, database.ReposListOptions{Names: names})
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[api.RepoID]*search.RepositoryRevisions, len(repoRevs))
+	for _, repoRev := range repoRevs {
+		byID[repoRev.Repo.ID] = repoRev
+	}
+	for _, repo := range repos {
+		repoRev, ok := byID[repo.ID]
+		if !ok {
+			repoRev = &search.RepositoryRevisions{Repo: repo}
+			byID[repo.ID] = repoRev
+			repoRevs = append(repoRevs, repoRev)
+		}
+		for _, commit := range pinned[repo.Name] {
+			if !slices.Contains(repoRev.Revs, commit) {
+				repoRev.Revs = append(repoRev.Revs, commit)
+			}
+		}
+	}
+
+	return repoRevs, nil
+}
+
 // filterRepoHasFileContent filters a page of repos to only those that match the
 // given contains predicates in RepoOptions.HasFileContent.
 // Brief overview of the method:
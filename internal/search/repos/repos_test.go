@@ -114,6 +114,28 @@ func TestRevisionValidation(t *testing.T) {
 				}},
 			},
 		},
+		{
+			repoFilters: []string{"repoFoo@revBar..revBas:..revBar"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"revBar..revBas", "..revBar"},
+			}},
+		},
+		{
+			repoFilters: []string{"repoFoo@revBar:revBar..revQux"},
+			wantRepoRevs: []*search.RepositoryRevisions{{
+				Repo: types.MinimalRepo{Name: "repoFoo"},
+				Revs: []string{"revBar"},
+			}},
+			wantErr: &MissingRepoRevsError{
+				Missing: []RepoRevSpecs{{
+					Repo: types.MinimalRepo{Name: "repoFoo"},
+					Revs: []query.RevisionSpecifier{{
+						RevSpec: "revBar..revQux",
+					}},
+				}},
+			},
+		},
 		{
 			repoFilters:  []string{"repoFoo@revBar:bad_commit"},
 			wantRepoRevs: nil,
@@ -874,3 +896,86 @@ func TestRepoHasCommitAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandSubmodules(t *testing.T) {
+	app := types.MinimalRepo{ID: 1, Name: "example.com/app"}
+	lib := types.MinimalRepo{ID: 2, Name: "example.com/lib"}
+	private := types.MinimalRepo{ID: 3, Name: "example.com/private"}
+
+	mockGitserver := gitserver.NewMockClient()
+	mockGitserver.ResolveRevisionFunc.SetDefaultHook(func(_ context.Context, _ api.RepoName, spec string, _ gitserver.ResolveRevisionOptions) (api.CommitID, error) {
+		if spec == "old" {
+			return "0ld", nil
+		}
+		return "deadbeef", nil
+	})
+	mockGitserver.SubmodulesFunc.SetDefaultHook(func(_ context.Context, repo api.RepoName, commit api.CommitID) ([]gitdomain.Submodule, error) {
+		if repo != app.Name {
+			return nil, nil
+		}
+		if commit == "0ld" {
+			return []gitdomain.Submodule{
+				{Path: "lib", CommitID: "4444444444444444444444444444444444444444", Repo: lib.Name},
+			}, nil
+		}
+		return []gitdomain.Submodule{
+			{Path: "lib", CommitID: "1111111111111111111111111111111111111111", Repo: lib.Name},
+			{Path: "private", CommitID: "2222222222222222222222222222222222222222", Repo: private.Name},
+			{Path: "unknown", CommitID: "3333333333333333333333333333333333333333"},
+		}, nil
+	})
+
+	repos := dbmocks.NewMockRepoStore()
+	repos.ListMinimalReposFunc.SetDefaultHook(func(_ context.Context, opts database.ReposListOptions) ([]types.MinimalRepo, error) {
+		if len(opts.Names) > 0 {
+			// The user doesn't have access to example.com/private.
+			require.Equal(t, []string{string(lib.Name), string(private.Name)}, opts.Names)
+			return []types.MinimalRepo{lib}, nil
+		}
+		return []types.MinimalRepo{app}, nil
+	})
+
+	db := dbmocks.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+
+	for _, tc := range []struct {
+		name              string
+		repoFilter        string
+		includeSubmodules bool
+		expected          []*search.RepositoryRevisions
+	}{{
+		name: "default",
+		expected: []*search.RepositoryRevisions{
+			{Repo: app, Revs: []string{""}},
+		},
+	}, {
+		name:              "submodules:yes",
+		includeSubmodules: true,
+		expected: []*search.RepositoryRevisions{
+			{Repo: app, Revs: []string{""}},
+			{Repo: lib, Revs: []string{"1111111111111111111111111111111111111111"}},
+		},
+	}, {
+		name:              "excluded revisions are not expanded",
+		repoFilter:        "example.com/app@main:^old",
+		includeSubmodules: true,
+		expected: []*search.RepositoryRevisions{
+			{Repo: app, Revs: []string{"main", "^old"}},
+			{Repo: lib, Revs: []string{"1111111111111111111111111111111111111111"}},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			repoFilter := "example.com/app"
+			if tc.repoFilter != "" {
+				repoFilter = tc.repoFilter
+			}
+			res := NewResolver(logtest.Scoped(t), db, mockGitserver, endpoint.Static("test"), nil)
+			resolved, _, err := res.resolve(context.Background(), search.RepoOptions{
+				RepoFilters:       toParsedRepoFilters(repoFilter),
+				IncludeSubmodules: tc.includeSubmodules,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, resolved.RepoRevs)
+		})
+	}
+}
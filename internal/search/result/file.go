@@ -60,6 +60,20 @@ type FileMatch struct {
 
 	LimitHit bool
 
+	// Checksum is a hash of the content of the file. It is only set by
+	// backends which know it (Zoekt) and is used to detect identical files
+	// across repositories.
+	Checksum []byte `json:"-"`
+
+	// DuplicateOf is the location of an earlier match of a file with the
+	// same content. It is only set when searching with `dedupe:content`, in
+	// which case the match carries no content and doesn't count as a result.
+	DuplicateOf *File `json:"-"`
+
+	// Diff is a unified diff of the file with the matches of a structural
+	// search rewritten. It is only set when searching with `rewrite:`.
+	Diff string `json:"-"`
+
 	// Debug is optionally set with a debug message explaining the result.
 	//
 	// Note: this is a pointer since usually this is unset. Pointer is 8 bytes
@@ -74,6 +88,9 @@ func (fm *FileMatch) RepoName() types.MinimalRepo {
 func (fm *FileMatch) searchResultMarker() {}
 
 func (fm *FileMatch) ResultCount() int {
+	if fm.DuplicateOf != nil {
+		return 0
+	}
 	rc := len(fm.Symbols) + fm.ChunkMatches.MatchCount()
 	if rc == 0 {
 		return 1 // 1 to count "empty" results like type:path results
@@ -144,6 +161,11 @@ func (fm *FileMatch) AppendMatches(src *FileMatch) {
 //	if limit >= ResultCount then nothing is done and we return limit - ResultCount.
 //	if limit < ResultCount then ResultCount becomes limit and we return 0.
 func (fm *FileMatch) Limit(limit int) int {
+	// Duplicates are reported alongside the match they duplicate
+	if fm.DuplicateOf != nil {
+		return limit
+	}
+
 	matchCount := fm.ChunkMatches.MatchCount()
 	symbolCount := len(fm.Symbols)
 
@@ -58,6 +58,7 @@ func Search(
 			IncludePatterns:              p.IncludePatterns,
 			Languages:                    p.Languages,
 			CombyRule:                    p.CombyRule,
+			Rewrite:                      p.Rewrite,
 			Select:                       p.Select.Root(),
 			Limit:                        int(p.FileMatchLimit),
 			IsRegExp:                     p.IsRegExp,
@@ -68,6 +69,7 @@ func Search(
 			IsNegated:                    p.IsNegated,
 			PatternMatchesContent:        p.PatternMatchesContent,
 			PatternMatchesPath:           p.PatternMatchesPath,
+			SearchArchives:               p.SearchArchives,
 		},
 		Indexed:      indexed,
 		FetchTimeout: fetchTimeout,
@@ -47,6 +47,7 @@ func SearchGRPC(
 			IncludePatterns:              p.IncludePatterns,
 			Languages:                    p.Languages,
 			CombyRule:                    p.CombyRule,
+			Rewrite:                      p.Rewrite,
 			Select:                       p.Select.Root(),
 			Limit:                        int(p.FileMatchLimit),
 			IsRegExp:                     p.IsRegExp,
@@ -57,6 +58,7 @@ func SearchGRPC(
 			IsNegated:                    p.IsNegated,
 			PatternMatchesContent:        p.PatternMatchesContent,
 			PatternMatchesPath:           p.PatternMatchesPath,
+			SearchArchives:               p.SearchArchives,
 		},
 		Indexed:      indexed,
 		FetchTimeout: fetchTimeout,
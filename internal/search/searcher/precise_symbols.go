@@ -0,0 +1,214 @@
+package searcher
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/grafana/regexp"
+	"github.com/grafana/regexp/syntax"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PreciseSymbol is a symbol defined according to a precise code intelligence
+// index. Unlike ctags symbols, Line is 0-based and Parent is the fully
+// qualified name of the symbol's container.
+type PreciseSymbol struct {
+	result.Symbol
+
+	// Package is the name of the package that defines the symbol, as
+	// recorded by the indexer.
+	Package string
+
+	// Namespace is the path of the namespaces enclosing the symbol, like the
+	// import path of a Go package.
+	Namespace string
+}
+
+// PreciseSymbolQuery selects the precise symbols returned by a
+// PreciseSymbolSource.
+type PreciseSymbolQuery struct {
+	// Name and Paths are substrings which occur, ignoring case, in the name
+	// and the path of every symbol matching the query. They let sources skip
+	// most symbols which can't match without calling Match.
+	Name  string
+	Paths []string
+
+	// Match reports whether a symbol matches the query.
+	Match func(PreciseSymbol) bool
+
+	// Limit is the maximum number of symbols to return, or 0 for no limit.
+	Limit int
+}
+
+// PreciseSymbolSource looks up symbols in precise code intelligence indexes.
+type PreciseSymbolSource interface {
+	// SymbolDefinitions returns the symbols defined at the given commit of a
+	// repository which match q. The returned flag is false if the commit has
+	// no precise index.
+	SymbolDefinitions(ctx context.Context, repoID api.RepoID, commit api.CommitID, q PreciseSymbolQuery) ([]PreciseSymbol, bool, error)
+}
+
+// DefaultPreciseSymbolSource is consulted by symbol searches before falling
+// back to the symbols service. It is nil unless code intelligence is enabled.
+var DefaultPreciseSymbolSource PreciseSymbolSource
+
+// searchPreciseSymbols returns the precise symbols at the given commit which
+// match args and packagePattern, ordered by location. The returned flag is
+// false if the commit has no precise index, in which case callers should fall
+// back to ctags.
+func searchPreciseSymbols(ctx context.Context, source PreciseSymbolSource, repoID api.RepoID, args search.SymbolsParameters, packagePattern string) ([]result.Symbol, bool, error) {
+	match, err := newPreciseSymbolMatcher(args, packagePattern)
+	if err != nil {
+		return nil, false, err
+	}
+
+	q := PreciseSymbolQuery{
+		Name:  requiredSubstring(args.Query, args.IsRegExp),
+		Match: match,
+		Limit: args.First,
+	}
+	for _, pattern := range args.IncludePatterns {
+		if path := requiredSubstring(pattern, true); path != "" {
+			q.Paths = append(q.Paths, path)
+		}
+	}
+
+	definitions, ok, err := source.SymbolDefinitions(ctx, repoID, args.CommitID, q)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	symbols := make([]result.Symbol, 0, len(definitions))
+	for _, definition := range definitions {
+		symbols = append(symbols, definition.Symbol)
+	}
+
+	sort.Slice(symbols, func(i, j int) bool {
+		if symbols[i].Path != symbols[j].Path {
+			return symbols[i].Path < symbols[j].Path
+		}
+		if symbols[i].Line != symbols[j].Line {
+			return symbols[i].Line < symbols[j].Line
+		}
+		return symbols[i].Character < symbols[j].Character
+	})
+	if args.First > 0 && len(symbols) > args.First {
+		symbols = symbols[:args.First]
+	}
+
+	return symbols, true, nil
+}
+
+// newPreciseSymbolMatcher returns a predicate matching precise symbols the
+// same way the symbols service matches ctags symbols: the query against the
+// name, and the include and exclude patterns against the path.
+func newPreciseSymbolMatcher(args search.SymbolsParameters, packagePattern string) (func(PreciseSymbol) bool, error) {
+	compile := func(expr string) (*regexp.Regexp, error) {
+		if expr == "" {
+			return nil, nil
+		}
+		if !args.IsCaseSensitive {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid regexp %q", expr)
+		}
+		return re, nil
+	}
+
+	query := args.Query
+	if !args.IsRegExp {
+		query = regexp.QuoteMeta(query)
+	}
+	queryRe, err := compile(query)
+	if err != nil {
+		return nil, err
+	}
+	excludeRe, err := compile(args.ExcludePattern)
+	if err != nil {
+		return nil, err
+	}
+	includeRes := make([]*regexp.Regexp, 0, len(args.IncludePatterns))
+	for _, pattern := range args.IncludePatterns {
+		re, err := compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		if re != nil {
+			includeRes = append(includeRes, re)
+		}
+	}
+	packageRe, err := compile(packagePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(symbol PreciseSymbol) bool {
+		if queryRe != nil && !queryRe.MatchString(symbol.Name) {
+			return false
+		}
+		if excludeRe != nil && excludeRe.MatchString(symbol.Path) {
+			return false
+		}
+		for _, re := range includeRes {
+			if !re.MatchString(symbol.Path) {
+				return false
+			}
+		}
+		if packageRe != nil && !packageRe.MatchString(symbol.Package) && !packageRe.MatchString(symbol.Namespace) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// requiredSubstring returns a string which occurs, ignoring case, in every
+// string matched by pattern, or "" if we can't tell. It is lower case and
+// ASCII-only, so that it means the same to the database.
+func requiredSubstring(pattern string, isRegExp bool) string {
+	if !isRegExp {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	literal := strings.ToLower(longestLiteral(re.Simplify()))
+	for i := 0; i < len(literal); i++ {
+		if literal[i] >= utf8.RuneSelf {
+			return ""
+		}
+	}
+	return literal
+}
+
+// longestLiteral returns the longest literal string which every match of re
+// contains.
+func longestLiteral(re *syntax.Regexp) string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return string(re.Rune)
+	case syntax.OpCapture, syntax.OpPlus:
+		return longestLiteral(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return longestLiteral(re.Sub[0])
+		}
+	case syntax.OpConcat:
+		var longest string
+		for _, sub := range re.Sub {
+			if literal := longestLiteral(sub); len(literal) > len(longest) {
+				longest = literal
+			}
+		}
+		return longest
+	}
+	return ""
+}
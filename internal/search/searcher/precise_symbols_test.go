@@ -0,0 +1,190 @@
+package searcher
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+type fakePreciseSymbolSource map[api.CommitID][]PreciseSymbol
+
+func (s fakePreciseSymbolSource) SymbolDefinitions(_ context.Context, _ api.RepoID, commit api.CommitID, q PreciseSymbolQuery) ([]PreciseSymbol, bool, error) {
+	definitions, ok := s[commit]
+	if !ok {
+		return nil, false, nil
+	}
+
+	var symbols []PreciseSymbol
+	for _, symbol := range definitions {
+		if !strings.Contains(strings.ToLower(symbol.Name), q.Name) {
+			continue
+		}
+		if !q.Match(symbol) {
+			continue
+		}
+		symbols = append(symbols, symbol)
+		if len(symbols) == q.Limit {
+			break
+		}
+	}
+	return symbols, true, nil
+}
+
+func TestSearchPreciseSymbols(t *testing.T) {
+	source := fakePreciseSymbolSource{
+		"indexed": {
+			{
+				Symbol:    result.Symbol{Name: "Client", Path: "client/client.go", Line: 9, Kind: "type", Parent: "example.com/mod/client", ParentKind: "namespace"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+			{
+				Symbol:    result.Symbol{Name: "NewClient", Path: "client/client.go", Line: 3, Kind: "function", Parent: "example.com/mod/client", ParentKind: "namespace"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+			{
+				Symbol:    result.Symbol{Name: "Do", Path: "client/client.go", Line: 12, Kind: "method", Parent: "example.com/mod/client/Client", ParentKind: "type"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/client",
+			},
+			{
+				Symbol:    result.Symbol{Name: "Client", Path: "server/server_test.go", Line: 2, Kind: "type", Parent: "example.com/mod/server", ParentKind: "namespace"},
+				Package:   "example.com/mod",
+				Namespace: "example.com/mod/server",
+			},
+		},
+	}
+
+	names := func(symbols []result.Symbol) (names []string) {
+		for _, symbol := range symbols {
+			names = append(names, symbol.Parent+"."+symbol.Name)
+		}
+		return names
+	}
+
+	cases := []struct {
+		name           string
+		args           search.SymbolsParameters
+		packagePattern string
+		want           []string
+	}{{
+		name: "all",
+		args: search.SymbolsParameters{},
+		want: []string{
+			"example.com/mod/client.NewClient",
+			"example.com/mod/client.Client",
+			"example.com/mod/client/Client.Do",
+			"example.com/mod/server.Client",
+		},
+	}, {
+		name: "literal",
+		args: search.SymbolsParameters{Query: "client"},
+		want: []string{
+			"example.com/mod/client.NewClient",
+			"example.com/mod/client.Client",
+			"example.com/mod/server.Client",
+		},
+	}, {
+		name: "regexp case sensitive",
+		args: search.SymbolsParameters{Query: "^Client$", IsRegExp: true, IsCaseSensitive: true},
+		want: []string{
+			"example.com/mod/client.Client",
+			"example.com/mod/server.Client",
+		},
+	}, {
+		name: "literal is not a regexp",
+		args: search.SymbolsParameters{Query: "^Client$"},
+	}, {
+		name: "paths",
+		args: search.SymbolsParameters{Query: "client", IncludePatterns: []string{`\.go$`}, ExcludePattern: `_test\.go$`},
+		want: []string{
+			"example.com/mod/client.NewClient",
+			"example.com/mod/client.Client",
+		},
+	}, {
+		name:           "namespace",
+		args:           search.SymbolsParameters{Query: "client"},
+		packagePattern: "mod/server$",
+		want: []string{
+			"example.com/mod/server.Client",
+		},
+	}, {
+		name:           "package",
+		args:           search.SymbolsParameters{Query: "Do"},
+		packagePattern: "^example.com/mod$",
+		want: []string{
+			"example.com/mod/client/Client.Do",
+		},
+	}, {
+		name: "first",
+		args: search.SymbolsParameters{First: 2},
+		want: []string{
+			"example.com/mod/client.NewClient",
+			"example.com/mod/client.Client",
+		},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.args.CommitID = "indexed"
+			symbols, ok, err := searchPreciseSymbols(context.Background(), source, 1, tc.args, tc.packagePattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ok {
+				t.Fatal("expected precise symbols")
+			}
+			if diff := cmp.Diff(tc.want, names(symbols)); diff != "" {
+				t.Errorf("unexpected symbols (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("no index", func(t *testing.T) {
+		_, ok, err := searchPreciseSymbols(context.Background(), source, 1, search.SymbolsParameters{CommitID: "unindexed"}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Fatal("expected no precise symbols")
+		}
+	})
+
+	t.Run("invalid package", func(t *testing.T) {
+		if _, _, err := searchPreciseSymbols(context.Background(), source, 1, search.SymbolsParameters{CommitID: "indexed"}, "("); err == nil {
+			t.Fatal("expected error")
+		}
+	})
+}
+
+func TestRequiredSubstring(t *testing.T) {
+	cases := []struct {
+		pattern  string
+		isRegExp bool
+		want     string
+	}{
+		{pattern: "NewClient", want: "newclient"},
+		{pattern: "^Client$", want: "^client$"},
+		{pattern: "^Client$", isRegExp: true, want: "client"},
+		{pattern: `(?i)new(Client|Server)`, isRegExp: true, want: "new"},
+		{pattern: `\.go$`, isRegExp: true, want: ".go"},
+		{pattern: `^cmd/(frontend)+/internal/`, isRegExp: true, want: "/internal/"},
+		{pattern: `(Client)?Options`, isRegExp: true, want: "options"},
+		{pattern: `Client|Server`, isRegExp: true, want: ""},
+		{pattern: `a*`, isRegExp: true, want: ""},
+		{pattern: "Größe", want: ""},
+		{pattern: "(", isRegExp: true, want: ""},
+	}
+	for _, tc := range cases {
+		if got := requiredSubstring(tc.pattern, tc.isRegExp); got != tc.want {
+			t.Errorf("requiredSubstring(%q, %t) = %q, want %q", tc.pattern, tc.isRegExp, got, tc.want)
+		}
+	}
+}
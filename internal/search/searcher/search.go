@@ -288,6 +288,7 @@ func convertProtoMatch(repo types.MinimalRepo, commit api.CommitID, rev *string,
 		ChunkMatches: chunkMatches,
 		PathMatches:  pathMatches,
 		LimitHit:     fm.GetLimitHit(),
+		Diff:         string(fm.GetDiff()),
 	}
 }
 
@@ -354,6 +355,7 @@ func convertMatches(repo types.MinimalRepo, commit api.CommitID, rev *string, se
 			ChunkMatches: chunkMatches,
 			PathMatches:  pathMatches,
 			LimitHit:     fm.LimitHit,
+			Diff:         fm.Diff,
 		})
 	}
 	return matches
@@ -14,7 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
-	"github.com/sourcegraph/sourcegraph/internal/symbols"
+	symbolsclient "github.com/sourcegraph/sourcegraph/internal/symbols"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
@@ -103,19 +103,31 @@ func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRev
 	}
 	tr.SetAttributes(commitID.Attr())
 
-	symbols, err := symbols.DefaultClient.Search(ctx, search.SymbolsParameters{
-		Repo:            repoRevs.Repo.Name,
-		CommitID:        commitID,
-		Query:           patternInfo.Pattern,
-		IsCaseSensitive: patternInfo.IsCaseSensitive,
-		IsRegExp:        patternInfo.IsRegExp,
-		IncludePatterns: patternInfo.IncludePatterns,
-		ExcludePattern:  patternInfo.ExcludePattern,
-		// Ask for limit + 1 so we can detect whether there are more results than the limit.
-		First: limit + 1,
-	})
-	if err != nil {
-		return nil, err
+	args := newSymbolsParameters(repoRevs.Repo.Name, commitID, patternInfo, limit)
+
+	// Prefer the symbols of precise indexes, which have fully qualified names,
+	// accurate kinds and know their package, and only fall back to ctags for
+	// commits without one.
+	var symbols []result.Symbol
+	precise := false
+	if DefaultPreciseSymbolSource != nil {
+		symbols, precise, err = searchPreciseSymbols(ctx, DefaultPreciseSymbolSource, repoRevs.Repo.ID, args, patternInfo.Package)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tr.SetAttributes(attribute.Bool("precise", precise))
+
+	if !precise {
+		// ctags symbols don't know which package defines them.
+		if patternInfo.Package != "" {
+			return nil, nil
+		}
+
+		symbols, err = symbolsclient.DefaultClient.Search(ctx, args)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	for i := range symbols {
@@ -127,6 +139,57 @@ func searchInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRev
 	return symbolsToMatches(symbols, repoRevs.Repo, commitID, inputRev), err
 }
 
+// SearchPreciseSymbolsInRepo searches the symbols of the precise indexes at
+// the first revision of repoRevs, like the searcher symbol search does. The
+// returned flag is false if the revision has no precise index, in which case
+// callers should search ctags symbols instead.
+func SearchPreciseSymbolsInRepo(ctx context.Context, gitserverClient gitserver.Client, repoRevs *search.RepositoryRevisions, patternInfo *search.TextPatternInfo, limit int) (_ result.Matches, precise bool, err error) {
+	if DefaultPreciseSymbolSource == nil {
+		return nil, false, nil
+	}
+
+	inputRev := repoRevs.Revs[0]
+	tr, ctx := trace.New(ctx, "symbols.SearchPreciseSymbolsInRepo",
+		repoRevs.Repo.Name.Attr(),
+		attribute.String("rev", inputRev))
+	defer func() {
+		tr.SetAttributes(attribute.Bool("precise", precise))
+		tr.EndWithErr(&err)
+	}()
+
+	commitID, err := gitserverClient.ResolveRevision(ctx, repoRevs.GitserverRepo(), inputRev, gitserver.ResolveRevisionOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	tr.SetAttributes(commitID.Attr())
+
+	args := newSymbolsParameters(repoRevs.Repo.Name, commitID, patternInfo, limit)
+	symbols, precise, err := searchPreciseSymbols(ctx, DefaultPreciseSymbolSource, repoRevs.Repo.ID, args, patternInfo.Package)
+	if err != nil || !precise {
+		return nil, false, err
+	}
+
+	for i := range symbols {
+		symbols[i].Line += 1 // callers expect 1-indexed lines
+	}
+
+	return symbolsToMatches(symbols, repoRevs.Repo, commitID, inputRev), true, nil
+}
+
+func newSymbolsParameters(repo api.RepoName, commitID api.CommitID, patternInfo *search.TextPatternInfo, limit int) search.SymbolsParameters {
+	return search.SymbolsParameters{
+		Repo:            repo,
+		CommitID:        commitID,
+		Query:           patternInfo.Pattern,
+		IsCaseSensitive: patternInfo.IsCaseSensitive,
+		IsRegExp:        patternInfo.IsRegExp,
+		IncludePatterns: patternInfo.IncludePatterns,
+		ExcludePattern:  patternInfo.ExcludePattern,
+		// Ask for limit + 1 so we can detect whether there are more results than the limit.
+		First: limit + 1,
+	}
+}
+
 func symbolsToMatches(symbols []result.Symbol, repo types.MinimalRepo, commitID api.CommitID, inputRev string) result.Matches {
 	symbolsByPath := make(map[string][]result.Symbol)
 	for _, symbol := range symbols {
@@ -0,0 +1,91 @@
+package streaming
+
+import (
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// NewContentDedupingStream returns a stream which groups file matches with
+// identical content, for example the same file in a fork, a mirror and the
+// upstream repository.
+//
+// The first match of each group is sent to parent as soon as it arrives. Later
+// matches of the group are sent without their content and with DuplicateOf set
+// to the location of the first match, so they don't count towards limits.
+// Other results and stats are forwarded unchanged.
+func NewContentDedupingStream(parent Sender) *contentDedupingStream {
+	return &contentDedupingStream{
+		parent:    parent,
+		canonical: map[string]result.File{},
+		seen:      map[result.Key]struct{}{},
+	}
+}
+
+type contentDedupingStream struct {
+	parent Sender
+
+	mu sync.Mutex
+	// canonical maps a content key to the location of the first match with
+	// that content.
+	canonical map[string]result.File
+	// seen is the set of duplicates we've reported, so that each location is
+	// only reported once.
+	seen map[result.Key]struct{}
+}
+
+func (s *contentDedupingStream) Send(event SearchEvent) {
+	s.mu.Lock()
+	results := event.Results[:0]
+	for _, match := range event.Results {
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			results = append(results, match)
+			continue
+		}
+		key, ok := contentKey(fm)
+		if !ok {
+			results = append(results, match)
+			continue
+		}
+		canonical, ok := s.canonical[key]
+		if !ok {
+			s.canonical[key] = fm.File
+			results = append(results, match)
+			continue
+		}
+		// The same file may be sent more than once, it is not a duplicate
+		// location of itself.
+		if fm.Key() == (&result.FileMatch{File: canonical}).Key() {
+			results = append(results, match)
+			continue
+		}
+		if _, ok := s.seen[fm.Key()]; ok {
+			continue
+		}
+		s.seen[fm.Key()] = struct{}{}
+		results = append(results, &result.FileMatch{
+			File:        fm.File,
+			DuplicateOf: &canonical,
+		})
+	}
+	event.Results = results
+	s.mu.Unlock()
+
+	if len(event.Results) > 0 || !event.Stats.Zero() {
+		s.parent.Send(event)
+	}
+}
+
+// contentKey returns a key which is equal for file matches of files with
+// identical content. It returns false if we can't tell, in which case the
+// match is not deduplicated.
+//
+// Only Zoekt tells us the checksum of a file. Matches from unindexed searches
+// are never grouped, since two different files may share the matched lines.
+func contentKey(fm *result.FileMatch) (string, bool) {
+	if len(fm.Checksum) == 0 {
+		return "", false
+	}
+	return string(fm.Checksum), true
+}
@@ -30,11 +30,22 @@ type EventContentMatch struct {
 	Hunks           []DecoratedHunk  `json:"hunks"`
 	LineMatches     []EventLineMatch `json:"lineMatches,omitempty"`
 	ChunkMatches    []ChunkMatch     `json:"chunkMatches,omitempty"`
+	Diff            string           `json:"diff,omitempty"`
 	Debug           string           `json:"debug,omitempty"`
 }
 
 func (e *EventContentMatch) eventMatch() {}
 
+// FileLocation is the location of an earlier match with identical content to
+// a path match. Only set for queries with dedupe:content.
+type FileLocation struct {
+	Path         string   `json:"path"`
+	RepositoryID int32    `json:"repositoryID"`
+	Repository   string   `json:"repository"`
+	Branches     []string `json:"branches,omitempty"`
+	Commit       string   `json:"commit,omitempty"`
+}
+
 // EventPathMatch is a subset of zoekt.FileMatch for our Event API.
 // It is used for result.FileMatch results with no line matches and
 // no symbol matches, indicating it represents a match of the file itself
@@ -43,15 +54,16 @@ type EventPathMatch struct {
 	// Type is always PathMatchType. Included here for marshalling.
 	Type MatchType `json:"type"`
 
-	Path            string     `json:"path"`
-	PathMatches     []Range    `json:"pathMatches,omitempty"`
-	RepositoryID    int32      `json:"repositoryID"`
-	Repository      string     `json:"repository"`
-	RepoStars       int        `json:"repoStars,omitempty"`
-	RepoLastFetched *time.Time `json:"repoLastFetched,omitempty"`
-	Branches        []string   `json:"branches,omitempty"`
-	Commit          string     `json:"commit,omitempty"`
-	Debug           string     `json:"debug,omitempty"`
+	Path            string        `json:"path"`
+	PathMatches     []Range       `json:"pathMatches,omitempty"`
+	RepositoryID    int32         `json:"repositoryID"`
+	Repository      string        `json:"repository"`
+	RepoStars       int           `json:"repoStars,omitempty"`
+	RepoLastFetched *time.Time    `json:"repoLastFetched,omitempty"`
+	Branches        []string      `json:"branches,omitempty"`
+	Commit          string        `json:"commit,omitempty"`
+	DuplicateOf     *FileLocation `json:"duplicateOf,omitempty"`
+	Debug           string        `json:"debug,omitempty"`
 }
 
 func (e *EventPathMatch) eventMatch() {}
@@ -119,6 +119,10 @@ func (s *SearchFilters) Update(event SearchEvent) {
 	for _, match := range event.Results {
 		switch v := match.(type) {
 		case *result.FileMatch:
+			// Duplicates are shown under the match they duplicate
+			if v.DuplicateOf != nil {
+				continue
+			}
 			rev := ""
 			if v.InputRev != nil {
 				rev = *v.InputRev
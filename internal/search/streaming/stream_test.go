@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -9,7 +10,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
 func BenchmarkBatchingStream(b *testing.B) {
@@ -148,3 +151,56 @@ func TestDedupingStream(t *testing.T) {
 
 	require.Equal(t, 1, len(sent))
 }
+
+func TestContentDedupingStream(t *testing.T) {
+	var sent []result.Match
+	s := NewContentDedupingStream(StreamFunc(func(e SearchEvent) {
+		sent = append(sent, e.Results...)
+	}))
+
+	fileMatch := func(repoID api.RepoID, path string, checksum string) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo: types.MinimalRepo{ID: repoID, Name: api.RepoName(fmt.Sprintf("repo%d", repoID))},
+				Path: path,
+			},
+			ChunkMatches: result.ChunkMatches{{
+				Content: "foo bar",
+				Ranges:  result.Ranges{{Start: result.Location{Offset: 0}, End: result.Location{Offset: 3}}},
+			}},
+			Checksum: []byte(checksum),
+		}
+	}
+	duplicate := func(fm, of *result.FileMatch) *result.FileMatch {
+		return &result.FileMatch{File: fm.File, DuplicateOf: &of.File}
+	}
+
+	upstream := fileMatch(1, "a.go", "abc")
+	other := fileMatch(2, "a.go", "def")
+	repoMatch := &result.RepoMatch{Name: "repo9", ID: 9}
+
+	// The first match of each group is sent straight away.
+	s.Send(SearchEvent{Results: []result.Match{upstream, other, repoMatch}})
+	require.Equal(t, []result.Match{upstream, other, repoMatch}, sent)
+
+	fork := fileMatch(3, "a.go", "abc")
+	mirror := fileMatch(4, "b.go", "abc")
+	again := fileMatch(1, "a.go", "abc")
+	forkAgain := fileMatch(3, "a.go", "abc")
+	s.Send(SearchEvent{Results: []result.Match{fork, mirror, again, forkAgain}})
+	require.Equal(t, []result.Match{
+		upstream, other, repoMatch,
+		duplicate(fork, upstream), duplicate(mirror, upstream), again,
+	}, sent)
+
+	// Duplicates don't count as results.
+	require.Equal(t, 0, sent[3].ResultCount())
+
+	// Matches without a checksum are never grouped, even if the matched
+	// content is the same.
+	sent = nil
+	unindexedA := fileMatch(5, "c.go", "")
+	unindexedB := fileMatch(6, "c.go", "")
+	s.Send(SearchEvent{Results: []result.Match{unindexedA, unindexedB}})
+	require.Equal(t, []result.Match{unindexedA, unindexedB}, sent)
+}
@@ -304,6 +304,10 @@ type TextPatternInfo struct {
 	Index           query.YesNoOnly
 	Select          filter.SelectPath
 
+	// Rewrite is a template which structural search matches are rewritten
+	// to. If set, file matches include a diff of the rewritten file.
+	Rewrite string
+
 	// We do not support IsMultiline
 	// IsMultiline     bool
 	IncludePatterns []string
@@ -315,6 +319,14 @@ type TextPatternInfo struct {
 	PatternMatchesPath    bool
 
 	Languages []string
+
+	// SearchArchives is true if the files inside of archives, like zip and
+	// tar files, are searched too.
+	SearchArchives bool
+
+	// Package is an optional regex that the package defining a symbol needs
+	// to match. Only precise symbols know their package.
+	Package string
 }
 
 func (p *TextPatternInfo) Fields() []attribute.KeyValue {
@@ -337,6 +349,9 @@ func (p *TextPatternInfo) Fields() []attribute.KeyValue {
 	if p.CombyRule != "" {
 		add(attribute.String("combyRule", p.CombyRule))
 	}
+	if p.Rewrite != "" {
+		add(attribute.String("rewrite", p.Rewrite))
+	}
 	if p.IsWordMatch {
 		add(attribute.Bool("isWordMatch", p.IsWordMatch))
 	}
@@ -366,6 +381,12 @@ func (p *TextPatternInfo) Fields() []attribute.KeyValue {
 	if len(p.Languages) > 0 {
 		add(attribute.StringSlice("languages", p.Languages))
 	}
+	if p.SearchArchives {
+		add(attribute.Bool("searchArchives", p.SearchArchives))
+	}
+	if p.Package != "" {
+		add(attribute.String("package", p.Package))
+	}
 	return res
 }
 
@@ -380,6 +401,9 @@ func (p *TextPatternInfo) String() string {
 		} else {
 			args = append(args, "comby")
 		}
+		if p.Rewrite != "" {
+			args = append(args, fmt.Sprintf("rewrite:%s", p.Rewrite))
+		}
 	}
 	if p.IsWordMatch {
 		args = append(args, "word")
@@ -399,6 +423,12 @@ func (p *TextPatternInfo) String() string {
 	for _, lang := range p.Languages {
 		args = append(args, fmt.Sprintf("lang:%s", lang))
 	}
+	if p.SearchArchives {
+		args = append(args, "archives")
+	}
+	if p.Package != "" {
+		args = append(args, fmt.Sprintf("package:%q", p.Package))
+	}
 
 	path := "f"
 	if p.PathPatternsAreCaseSensitive {
@@ -492,6 +522,10 @@ type RepoOptions struct {
 	ArchivedSet  bool
 	NoArchived   bool
 	OnlyArchived bool
+
+	// IncludeSubmodules adds the commits that the submodules of the resolved
+	// repositories pin to the repositories to search.
+	IncludeSubmodules bool
 }
 
 func (op *RepoOptions) Attributes() []attribute.KeyValue {
@@ -594,6 +628,9 @@ func (op *RepoOptions) Attributes() []attribute.KeyValue {
 	if op.OnlyArchived {
 		add(attribute.Bool("onlyArchived", op.OnlyArchived))
 	}
+	if op.IncludeSubmodules {
+		add(attribute.Bool("includeSubmodules", op.IncludeSubmodules))
+	}
 	return res
 }
 
@@ -684,6 +721,9 @@ func (op *RepoOptions) String() string {
 	if op.OnlyArchived {
 		fmt.Fprintf(&b, "OnlyArchived: %t\n", op.OnlyArchived)
 	}
+	if op.IncludeSubmodules {
+		fmt.Fprintf(&b, "IncludeSubmodules: %t\n", op.IncludeSubmodules)
+	}
 
 	return b.String()
 }
@@ -140,6 +140,31 @@ func (rb *IndexedRepoRevs) add(reporev *search.RepositoryRevisions, repo zoekt.M
 	return unindexed
 }
 
+// Without returns a copy of rb which doesn't search the given repositories.
+func (rb *IndexedRepoRevs) Without(repoIDs map[api.RepoID]struct{}) *IndexedRepoRevs {
+	remove := roaring.New()
+	for id := range repoIDs {
+		remove.Add(uint32(id))
+	}
+
+	cp := &IndexedRepoRevs{
+		RepoRevs:    make(map[api.RepoID]*search.RepositoryRevisions, len(rb.RepoRevs)),
+		branchRepos: make(map[string]*zoektquery.BranchRepos, len(rb.branchRepos)),
+	}
+	for id, repoRevs := range rb.RepoRevs {
+		if _, ok := repoIDs[id]; !ok {
+			cp.RepoRevs[id] = repoRevs
+		}
+	}
+	for branch, br := range rb.branchRepos {
+		repos := roaring.AndNot(br.Repos, remove)
+		if !repos.IsEmpty() {
+			cp.branchRepos[branch] = &zoektquery.BranchRepos{Branch: branch, Repos: repos}
+		}
+	}
+	return cp
+}
+
 func (rb *IndexedRepoRevs) BranchRepos() []zoektquery.BranchRepos {
 	brs := make([]zoektquery.BranchRepos, 0, len(rb.branchRepos))
 	for _, br := range rb.branchRepos {
@@ -415,6 +440,7 @@ func sendMatches(event *zoekt.SearchResult, pathRegexps []*regexp.Regexp, getRep
 				ChunkMatches: hms,
 				Symbols:      symbols,
 				PathMatches:  pathMatches,
+				Checksum:     file.Checksum,
 				File: result.File{
 					InputRev: &inputRev,
 					CommitID: api.CommitID(file.Version),
@@ -1001,3 +1001,38 @@ func TestGetRepoRevsFromBranchRepos_SingleRepo(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexedRepoRevsWithout(t *testing.T) {
+	repoRevs := makeRepositoryRevisionsMap("foo/one", "foo/two@dev", "foo/three")
+	indexed := &IndexedRepoRevs{
+		RepoRevs:    map[api.RepoID]*search.RepositoryRevisions{},
+		branchRepos: map[string]*zoektquery.BranchRepos{},
+	}
+	var removed api.RepoID
+	for _, rr := range repoRevs {
+		if rr.Revs[0] == "dev" {
+			removed = rr.Repo.ID
+		}
+		indexed.add(rr, zoekt.MinimalRepoListEntry{Branches: []zoekt.RepositoryBranch{{Name: rr.Revs[0]}}})
+	}
+
+	got := indexed.Without(map[api.RepoID]struct{}{removed: {}})
+
+	if _, ok := got.RepoRevs[removed]; ok {
+		t.Errorf("expected repo %d to be removed", removed)
+	}
+	if len(got.RepoRevs) != 2 {
+		t.Errorf("expected 2 repos, have %d", len(got.RepoRevs))
+	}
+	if _, ok := got.branchRepos["dev"]; ok {
+		t.Errorf("expected branch dev to be removed")
+	}
+	if n := got.branchRepos["HEAD"].Repos.GetCardinality(); n != 2 {
+		t.Errorf("expected 2 repos on HEAD, have %d", n)
+	}
+
+	// The original is unchanged
+	if len(indexed.RepoRevs) != 3 || indexed.branchRepos["dev"].Repos.GetCardinality() != 1 {
+		t.Errorf("expected Without to not modify the original")
+	}
+}
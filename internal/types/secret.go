@@ -93,10 +93,22 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		// Nothing to redact
 	case *schema.RubyPackagesConnection:
 		es.redactString(c.Repository, "repository")
+	case *schema.DotnetPackagesConnection:
+		es.redactString(c.Registry, "registry")
+	case *schema.PhpPackagesConnection:
+		es.redactString(c.Repository, "repository")
+	case *schema.ElixirPackagesConnection:
+		es.redactString(c.Repository, "repository")
+	case *schema.DartPackagesConnection:
+		es.redactString(c.Repository, "repository")
 	case *schema.JVMPackagesConnection:
 		es.redactString(c.Maven.Credentials, "maven", "credentials")
 	case *schema.PagureConnection:
 		es.redactString(c.Token, "token")
+	case *schema.MercurialConnection:
+		es.redactString(c.Password, "password")
+	case *schema.SubversionConnection:
+		es.redactString(c.Password, "password")
 	case *schema.NpmPackagesConnection:
 		es.redactString(c.Credentials, "credentials")
 	case *schema.OtherExternalServiceConnection:
@@ -227,6 +239,18 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 	case *schema.RubyPackagesConnection:
 		o := oldCfg.(*schema.RubyPackagesConnection)
 		es.unredactString(c.Repository, o.Repository, "repository")
+	case *schema.DotnetPackagesConnection:
+		o := oldCfg.(*schema.DotnetPackagesConnection)
+		es.unredactString(c.Registry, o.Registry, "registry")
+	case *schema.PhpPackagesConnection:
+		o := oldCfg.(*schema.PhpPackagesConnection)
+		es.unredactString(c.Repository, o.Repository, "repository")
+	case *schema.ElixirPackagesConnection:
+		o := oldCfg.(*schema.ElixirPackagesConnection)
+		es.unredactString(c.Repository, o.Repository, "repository")
+	case *schema.DartPackagesConnection:
+		o := oldCfg.(*schema.DartPackagesConnection)
+		es.unredactString(c.Repository, o.Repository, "repository")
 	case *schema.JVMPackagesConnection:
 		o := oldCfg.(*schema.JVMPackagesConnection)
 		// credentials didn't change check if repositories did
@@ -256,6 +280,18 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 			return errCodeHostIdentityChanged{"url", "token"}
 		}
 		es.unredactString(c.Token, o.Token, "token")
+	case *schema.MercurialConnection:
+		o := oldCfg.(*schema.MercurialConnection)
+		if c.Password == RedactedSecret && c.Url != o.Url {
+			return errCodeHostIdentityChanged{"url", "password"}
+		}
+		es.unredactString(c.Password, o.Password, "password")
+	case *schema.SubversionConnection:
+		o := oldCfg.(*schema.SubversionConnection)
+		if c.Password == RedactedSecret && c.Url != o.Url {
+			return errCodeHostIdentityChanged{"url", "password"}
+		}
+		es.unredactString(c.Password, o.Password, "password")
 	case *schema.AzureDevOpsConnection:
 		o := oldCfg.(*schema.AzureDevOpsConnection)
 		if c.Token == RedactedSecret && c.Url != o.Url {
@@ -85,6 +85,16 @@ func TestExternalService_RedactedConfig(t *testing.T) {
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
 			out:  schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: RedactedSecret},
 		},
+		{
+			kind: extsvc.VariantMercurial.AsKind(),
+			in:   schema.MercurialConnection{Url: "https://hg.example.com", Username: "foo", Password: "bar"},
+			out:  schema.MercurialConnection{Url: "https://hg.example.com", Username: "foo", Password: RedactedSecret},
+		},
+		{
+			kind: extsvc.VariantSubversion.AsKind(),
+			in:   schema.SubversionConnection{Url: "https://svn.example.com", Username: "foo", Password: "bar"},
+			out:  schema.SubversionConnection{Url: "https://svn.example.com", Username: "foo", Password: RedactedSecret},
+		},
 		{
 			kind: extsvc.KindJVMPackages,
 			in:   schema.JVMPackagesConnection{Maven: schema.Maven{Credentials: "foobar", Dependencies: []string{"baz"}}},
@@ -294,6 +304,19 @@ func TestExternalService_UnredactConfig(t *testing.T) {
 			in:   schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: RedactedSecret},
 			out:  schema.PagureConnection{Url: "https://src.fedoraproject.org", Token: "bar"},
 		},
+		{
+			kind: extsvc.VariantMercurial.AsKind(),
+			old:  schema.MercurialConnection{Url: "https://hg.example.com", Password: "bar"},
+			in:   schema.MercurialConnection{Url: "https://hg.example.com", Password: RedactedSecret},
+			out:  schema.MercurialConnection{Url: "https://hg.example.com", Password: "bar"},
+		},
+		{
+			kind:    extsvc.VariantSubversion.AsKind(),
+			old:     schema.SubversionConnection{Url: "https://svn.example.com", Password: "bar"},
+			in:      schema.SubversionConnection{Url: "https://svn.evil.com", Password: RedactedSecret},
+			out:     schema.SubversionConnection{Url: "https://svn.evil.com", Password: "bar"},
+			wantErr: errCodeHostIdentityChanged{"url", "password"},
+		},
 		{
 			kind: extsvc.KindJVMPackages,
 			old:  schema.JVMPackagesConnection{Maven: schema.Maven{Credentials: "foobar", Dependencies: []string{"baz"}}},
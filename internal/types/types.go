@@ -562,12 +562,15 @@ const (
 	CloneStatusNotCloned CloneStatus = "not_cloned"
 	CloneStatusCloning   CloneStatus = "cloning"
 	CloneStatusCloned    CloneStatus = "cloned"
+	// CloneStatusArchived is set for repos that have been moved from gitserver
+	// disk into cold storage. They are rehydrated on the next access.
+	CloneStatusArchived CloneStatus = "archived"
 )
 
 func ParseCloneStatus(s string) CloneStatus {
 	cs := CloneStatus(s)
 	switch cs {
-	case CloneStatusNotCloned, CloneStatusCloning, CloneStatusCloned:
+	case CloneStatusNotCloned, CloneStatusCloning, CloneStatusCloned, CloneStatusArchived:
 		return cs
 	default:
 		return CloneStatusUnknown
@@ -602,6 +605,9 @@ type GitserverRepo struct {
 	// A log of the different types of corruption that was detected on this repo. The order of the log entries are
 	// stored from most recent to least recent and capped at 10 entries. See LogCorruption on Gitserverrepo store.
 	CorruptionLogs []RepoCorruptionLog
+	// A log of the git maintenance runs performed on this repo by the janitor. The order of the log entries are
+	// stored from most recent to least recent and capped at 10 entries. See LogMaintenance on Gitserverrepo store.
+	MaintenanceLogs []RepoMaintenanceLog
 }
 
 // RepoCorruptionLog represents a corruption event that has been detected on a repo.
@@ -612,6 +618,20 @@ type RepoCorruptionLog struct {
 	Reason string `json:"reason"`
 }
 
+// RepoMaintenanceLog represents a git maintenance run that has been performed on a repo.
+type RepoMaintenanceLog struct {
+	// When the maintenance run started
+	Timestamp time.Time `json:"time"`
+	// The maintenance strategy that was run, like "full" or "geometric"
+	Strategy string `json:"strategy"`
+	// Why maintenance was needed, like "packfiles" or "commit_graph"
+	Reason string `json:"reason"`
+	// How long the maintenance run took
+	Duration time.Duration `json:"duration"`
+	// Whether the maintenance run succeeded
+	Success bool `json:"success"`
+}
+
 // ExternalService is a connection to an external service.
 type ExternalService struct {
 	ID             int64
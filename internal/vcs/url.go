@@ -67,6 +67,8 @@ var schemes = map[string]struct{}{
 	"rsync":    {},
 	"file":     {},
 	"perforce": {},
+	"svn":      {},
+	"svn+ssh":  {},
 }
 
 func parseScheme(rawurl string) (*URL, error) {
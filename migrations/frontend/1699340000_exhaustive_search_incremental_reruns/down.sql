@@ -0,0 +1,3 @@
+ALTER TABLE exhaustive_search_repo_revision_jobs DROP COLUMN IF EXISTS commit_id;
+
+ALTER TABLE exhaustive_search_jobs DROP COLUMN IF EXISTS previous_job_id;
@@ -0,0 +1,3 @@
+ALTER TABLE exhaustive_search_jobs ADD COLUMN IF NOT EXISTS previous_job_id INTEGER REFERENCES exhaustive_search_jobs(id) ON DELETE SET NULL;
+
+ALTER TABLE exhaustive_search_repo_revision_jobs ADD COLUMN IF NOT EXISTS commit_id TEXT;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS cm_content_snapshots (
+    monitor_id bigint NOT NULL PRIMARY KEY REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    query text NOT NULL,
+    matches jsonb NOT NULL,
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE cm_content_snapshots IS 'The content search results of the last run of a code monitor with a content search query';
+COMMENT ON COLUMN cm_content_snapshots.query IS 'The query the snapshot was taken with. The snapshot is discarded if the query of the monitor changes';
+COMMENT ON COLUMN cm_content_snapshots.matches IS 'The matched lines of the last run';
+
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS content_changes jsonb;
+
+COMMENT ON COLUMN cm_trigger_jobs.content_changes IS 'The matched lines which were added or removed since the previous run of a content search query';
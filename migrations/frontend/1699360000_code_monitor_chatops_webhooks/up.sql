@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS cm_chatops_webhooks (
+    id bigserial PRIMARY KEY,
+    monitor bigint NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    provider text NOT NULL CHECK (provider IN ('teams', 'mattermost')),
+    url text NOT NULL,
+    enabled boolean NOT NULL,
+    include_results boolean NOT NULL DEFAULT false,
+    created_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    changed_by integer NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cm_chatops_webhooks_monitor ON cm_chatops_webhooks USING btree (monitor);
+
+COMMENT ON TABLE cm_chatops_webhooks IS 'Microsoft Teams and Mattermost webhook actions configured on code monitors';
+COMMENT ON COLUMN cm_chatops_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_chatops_webhooks.provider IS 'The chat tool the webhook belongs to, which determines the message format. One of teams or mattermost';
+COMMENT ON COLUMN cm_chatops_webhooks.url IS 'The incoming webhook URL we send the code monitor event to';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS chatops_webhook bigint REFERENCES cm_chatops_webhooks(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.chatops_webhook IS 'The ID of the cm_chatops_webhooks action to execute if this is a chat-ops webhook job. Mutually exclusive with email, webhook and slack_webhook';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN chatops_webhook IS NULL THEN 0 ELSE 1 END
+) = 1);
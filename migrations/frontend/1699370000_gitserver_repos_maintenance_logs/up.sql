@@ -0,0 +1,3 @@
+ALTER TABLE gitserver_repos ADD COLUMN IF NOT EXISTS maintenance_logs jsonb DEFAULT '[]'::jsonb NOT NULL;
+
+COMMENT ON COLUMN gitserver_repos.maintenance_logs IS 'Log of the git maintenance runs performed on the repo by the gitserver janitor - encoded as json';
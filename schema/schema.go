@@ -608,6 +608,24 @@ type CustomGitFetchMapping struct {
 	Fetch string `json:"fetch"`
 }
 
+// DartPackagesConnection description: Configuration for a connection to Dart packages
+type DartPackagesConnection struct {
+	// Dependencies description: An array of strings specifying Dart and Flutter packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured pub repository APIs.
+	RateLimit *DartRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the pub repository that serves the packages.
+	Repository string `json:"repository,omitempty"`
+}
+
+// DartRateLimit description: Rate limit applied when making background API requests to the configured pub repository APIs.
+type DartRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // DebugLog description: Turns on debug logging for specific debugging scenarios.
 type DebugLog struct {
 	// ExtsvcGitlab description: Log GitLab API requests.
@@ -637,6 +655,42 @@ type Dotcom struct {
 	// SrcCliVersionCache description: Configuration related to the src-cli version cache. This should only be used on sourcegraph.com.
 	SrcCliVersionCache *SrcCliVersionCache `json:"srcCliVersionCache,omitempty"`
 }
+
+// DotnetPackagesConnection description: Configuration for a connection to .NET packages
+type DotnetPackagesConnection struct {
+	// Dependencies description: An array of strings specifying NuGet packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured .NET repository APIs.
+	RateLimit *DotnetRateLimit `json:"rateLimit,omitempty"`
+	// Registry description: The URL of the NuGet package content (flat container) resource of the registry.
+	Registry string `json:"registry,omitempty"`
+}
+
+// DotnetRateLimit description: Rate limit applied when making background API requests to the configured .NET repository APIs.
+type DotnetRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
+// ElixirPackagesConnection description: Configuration for a connection to Elixir packages
+type ElixirPackagesConnection struct {
+	// Dependencies description: An array of strings specifying Hex packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured Hex repository APIs.
+	RateLimit *ElixirRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the Hex repository that serves the package tarballs.
+	Repository string `json:"repository,omitempty"`
+}
+
+// ElixirRateLimit description: Rate limit applied when making background API requests to the configured Hex repository APIs.
+type ElixirRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
 type EmailTemplate struct {
 	// Html description: Template for HTML body
 	Html string `json:"html"`
@@ -804,12 +858,24 @@ type ExcludedGitoliteRepo struct {
 	// Pattern description: Regular expression which matches against the name of a Gitolite repo to exclude from mirroring.
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedMercurialRepo struct {
+	// Name description: The name of a Mercurial repo ("my-repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Mercurial repo to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedOtherRepo struct {
 	// Name description: The name of a Other repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
 	// Pattern description: Regular expression which matches against the name of a Other repo to exclude from mirroring.
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedSubversionRepo struct {
+	// Name description: The name of a Subversion repo ("my-repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Subversion repo to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 
 // ExecutorsMultiqueue description: The configuration for multiqueue executors.
 type ExecutorsMultiqueue struct {
@@ -839,8 +905,14 @@ type ExperimentalFeatures struct {
 	BatchChangesEnablePerforce bool `json:"batchChanges.enablePerforce,omitempty"`
 	// CustomGitFetch description: JSON array of configuration that maps from Git clone URL domain/path to custom git fetch command. To enable this feature set environment variable `ENABLE_CUSTOM_GIT_FETCH` as `true` on gitserver.
 	CustomGitFetch []*CustomGitFetchMapping `json:"customGitFetch,omitempty"`
+	// DartPackages description: Allow adding Dart package host connections
+	DartPackages string `json:"dartPackages,omitempty"`
 	// DebugLog description: Turns on debug logging for specific debugging scenarios.
 	DebugLog *DebugLog `json:"debug.log,omitempty"`
+	// DotnetPackages description: Allow adding .NET package host connections
+	DotnetPackages string `json:"dotnetPackages,omitempty"`
+	// ElixirPackages description: Allow adding Elixir package host connections
+	ElixirPackages string `json:"elixirPackages,omitempty"`
 	// EnableGRPC description: Enables gRPC for communication between internal services
 	EnableGRPC *bool `json:"enableGRPC,omitempty"`
 	// EnableGithubInternalRepoVisibility description: Enable support for visibility of internal Github repositories
@@ -851,8 +923,16 @@ type ExperimentalFeatures struct {
 	EnableStorm bool `json:"enableStorm,omitempty"`
 	// EventLogging description: Enables user event logging inside of the Sourcegraph instance. This will allow admins to have greater visibility of user activity, such as frequently viewed pages, frequent searches, and more. These event logs (and any specific user actions) are only stored locally, and never leave this Sourcegraph instance.
 	EventLogging string `json:"eventLogging,omitempty"`
+	// GitLFS description: Configures fetching of Git LFS objects by gitserver. When enabled, the LFS objects referenced at HEAD of Git repositories are fetched from the code host after each clone and fetch, and served in place of their pointer files by file reads and archives, for the blob view and unindexed search. Indexed search is out of scope and searches pointer files.
+	GitLFS *GitLFS `json:"gitLFS,omitempty"`
+	// GitMaintenance description: Configures the git maintenance strategies the gitserver janitor runs on repositories. Policies are matched in order against the repository name and the repository size recorded by gitserver, and the first matching policy decides the strategy. Repositories matching no policy use `defaultStrategy`. Only applies when gitserver runs sg maintenance, that is with SRC_ENABLE_SG_MAINTENANCE=true and SRC_ENABLE_GC_AUTO=false.
+	GitMaintenance *GitMaintenance `json:"gitMaintenance,omitempty"`
+	// GitPartialClone description: JSON array of configuration that maps from Git clone URL domain/path prefixes to a partial clone blob size limit. Matching repositories are cloned without blobs larger than the limit, and gitserver fetches missing blobs from the code host on demand. The longest matching `domainPath` wins, so both a whole code host and single repositories can be configured. Changes only apply to repositories cloned (or recloned) afterwards.
+	GitPartialClone []*GitPartialCloneMapping `json:"gitPartialClone,omitempty"`
 	// GitServerPinnedRepos description: List of repositories pinned to specific gitserver instances. The specified repositories will remain at their pinned servers on scaling the cluster. If the specified pinned server differs from the current server that stores the repository, then it must be re-cloned to the specified server.
 	GitServerPinnedRepos map[string]string `json:"gitServerPinnedRepos,omitempty"`
+	// GitServerReplicationFactor description: The number of gitserver instances that store a copy of each repository, including its primary. Replicas keep their copy in sync by fetching from the primary, and read-only requests fail over to a replica when the primary is unavailable. Requires gRPC to be enabled for gitserver.
+	GitServerReplicationFactor int `json:"gitServerReplicationFactor,omitempty"`
 	// GoPackages description: Allow adding Go package host connections
 	GoPackages string `json:"goPackages,omitempty"`
 	// InsightsAlternateLoadingStrategy description: Use an in-memory strategy of loading Code Insights. Should only be used for benchmarking on large instances, not for customer use currently.
@@ -873,6 +953,8 @@ type ExperimentalFeatures struct {
 	Perforce string `json:"perforce,omitempty"`
 	// PerforceChangelistMapping description: Allow mapping of Perforce changelists to their commit SHAs in the DB
 	PerforceChangelistMapping string `json:"perforceChangelistMapping,omitempty"`
+	// PhpPackages description: Allow adding PHP package host connections
+	PhpPackages string `json:"phpPackages,omitempty"`
 	// PythonPackages description: Allow adding Python package code host connections
 	PythonPackages string `json:"pythonPackages,omitempty"`
 	// Ranking description: Experimental search result ranking options.
@@ -933,13 +1015,20 @@ func (v *ExperimentalFeatures) UnmarshalJSON(data []byte) error {
 	}
 	delete(m, "batchChanges.enablePerforce")
 	delete(m, "customGitFetch")
+	delete(m, "dartPackages")
 	delete(m, "debug.log")
+	delete(m, "dotnetPackages")
+	delete(m, "elixirPackages")
 	delete(m, "enableGRPC")
 	delete(m, "enableGithubInternalRepoVisibility")
 	delete(m, "enablePermissionsWebhooks")
 	delete(m, "enableStorm")
 	delete(m, "eventLogging")
+	delete(m, "gitLFS")
+	delete(m, "gitMaintenance")
+	delete(m, "gitPartialClone")
 	delete(m, "gitServerPinnedRepos")
+	delete(m, "gitServerReplicationFactor")
 	delete(m, "goPackages")
 	delete(m, "insightsAlternateLoadingStrategy")
 	delete(m, "insightsBackfillerV2")
@@ -950,6 +1039,7 @@ func (v *ExperimentalFeatures) UnmarshalJSON(data []byte) error {
 	delete(m, "passwordPolicy")
 	delete(m, "perforce")
 	delete(m, "perforceChangelistMapping")
+	delete(m, "phpPackages")
 	delete(m, "pythonPackages")
 	delete(m, "ranking")
 	delete(m, "rateLimitAnonymous")
@@ -1219,6 +1309,16 @@ type GitHubWebhook struct {
 	Secret string `json:"secret"`
 }
 
+// GitLFS description: Configures fetching of Git LFS objects by gitserver. When enabled, the LFS objects referenced at HEAD of Git repositories are fetched from the code host after each clone and fetch, and served in place of their pointer files by file reads and archives, for the blob view and unindexed search. Indexed search is out of scope and searches pointer files.
+type GitLFS struct {
+	// Enabled description: Whether gitserver fetches Git LFS objects.
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxObjectSize description: The size in bytes of the largest LFS object that is fetched.
+	MaxObjectSize int `json:"maxObjectSize,omitempty"`
+	// MaxRepoSize description: The maximum total size in bytes of the LFS objects stored for a repository. Objects that would exceed it are not fetched.
+	MaxRepoSize int `json:"maxRepoSize,omitempty"`
+}
+
 // GitLabAuthProvider description: Configures the GitLab OAuth authentication provider for SSO. In addition to specifying this configuration object, you must also create a OAuth App on your GitLab instance: https://docs.gitlab.com/ee/integration/oauth_provider.html. The application should have `api` and `read_user` scopes and the callback URL set to the concatenation of your Sourcegraph instance URL and "/.auth/gitlab/callback".
 type GitLabAuthProvider struct {
 	// AllowGroups description: Restricts new logins and signups (if allowSignup is true) to members of these GitLab groups. Existing sessions won't be invalidated. Make sure to inform the full path for groups or subgroups instead of their names. Leave empty or unset for no group restrictions.
@@ -1328,6 +1428,32 @@ type GitLabWebhook struct {
 	Secret string `json:"secret"`
 }
 
+// GitMaintenance description: Configures the git maintenance strategies the gitserver janitor runs on repositories. Policies are matched in order against the repository name and the repository size recorded by gitserver, and the first matching policy decides the strategy. Repositories matching no policy use `defaultStrategy`. Only applies when gitserver runs sg maintenance, that is with SRC_ENABLE_SG_MAINTENANCE=true and SRC_ENABLE_GC_AUTO=false.
+type GitMaintenance struct {
+	// DefaultStrategy description: The strategy used for repositories that match no policy.
+	DefaultStrategy string `json:"defaultStrategy,omitempty"`
+	// Policies description: Ordered list of maintenance policies. The first policy that matches a repository decides its strategy.
+	Policies []*GitMaintenancePolicy `json:"policies,omitempty"`
+}
+type GitMaintenancePolicy struct {
+	// MaxRepoSizeBytes description: The maximum size in bytes of matching repositories. No maximum if unset.
+	MaxRepoSizeBytes int `json:"maxRepoSizeBytes,omitempty"`
+	// MinRepoSizeBytes description: The minimum size in bytes of matching repositories.
+	MinRepoSizeBytes int `json:"minRepoSizeBytes,omitempty"`
+	// RepoPattern description: Regular expression that the repository name must match. Matches all repositories if empty.
+	RepoPattern string `json:"repoPattern,omitempty"`
+	// Strategy description: The maintenance strategy. "full" repacks all objects into a single packfile, "geometric" only combines packfiles until they form a geometric progression, "incremental" maintains a multi-pack-index and repacks small packfiles into a batch, and "commit-graph" only refreshes the commit-graph.
+	Strategy string `json:"strategy"`
+}
+
+// GitPartialCloneMapping description: Mapping from a Git clone URL domain/path prefix to the maximum size of blobs fetched during clone.
+type GitPartialCloneMapping struct {
+	// BlobSizeLimit description: Blobs larger than this size are omitted when cloning and fetching. A number of bytes with an optional k, m or g suffix, as accepted by `git clone --filter=blob:limit=<n>`.
+	BlobSizeLimit string `json:"blobSizeLimit"`
+	// DomainPath description: Git clone URL domain/path prefix, e.g. `github.com/sourcegraph` or `github.com/sourcegraph/monorepo`.
+	DomainPath string `json:"domainPath"`
+}
+
 // GitRecorder description: Record git operations that are executed on configured repositories.
 type GitRecorder struct {
 	// IgnoredGitCommands description: List of git commands that should be ignored and not recorded.
@@ -1509,6 +1635,24 @@ type MavenRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// MercurialConnection description: Configuration for a connection to a Mercurial server. The repositories are converted to Git repositories when they are mirrored.
+type MercurialConnection struct {
+	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
+	Exclude []*ExcludedMercurialRepo `json:"exclude,omitempty"`
+	// Password description: The password used to authenticate to the Mercurial server.
+	Password string `json:"password,omitempty"`
+	// Repos description: A list of Mercurial repository paths, relative to `url`, to mirror.
+	Repos []string `json:"repos"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for the repositories. In the pattern, the variable "{base}" is replaced with the host and path of `url`, and "{repo}" is replaced with the repository path taken from the `repos` field.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: URL of the Mercurial server that hosts the repositories. The paths in `repos` are resolved relative to this URL.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate to the Mercurial server.
+	Username string `json:"username,omitempty"`
+}
 type Mount struct {
 	// Mountpoint description: The path in the container to mount the path on the local machine to.
 	Mountpoint string `json:"mountpoint"`
@@ -1922,6 +2066,24 @@ type PhabricatorConnection struct {
 	Url string `json:"url,omitempty"`
 }
 
+// PhpPackagesConnection description: Configuration for a connection to PHP packages
+type PhpPackagesConnection struct {
+	// Dependencies description: An array of strings specifying Composer packages to mirror in Sourcegraph.
+	Dependencies []string `json:"dependencies,omitempty"`
+	// RateLimit description: Rate limit applied when making background API requests to the configured PHP repository APIs.
+	RateLimit *PhpRateLimit `json:"rateLimit,omitempty"`
+	// Repository description: The URL of the Composer repository that serves the package metadata.
+	Repository string `json:"repository,omitempty"`
+}
+
+// PhpRateLimit description: Rate limit applied when making background API requests to the configured PHP repository APIs.
+type PhpRateLimit struct {
+	// Enabled description: true if rate limiting is enabled.
+	Enabled bool `json:"enabled"`
+	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
+	RequestsPerHour float64 `json:"requestsPerHour"`
+}
+
 // PythonPackagesConnection description: Configuration for a connection to Python simple repository APIs compatible with PEP 503
 type PythonPackagesConnection struct {
 	// Dependencies description: An array of strings specifying Python packages to mirror in Sourcegraph.
@@ -2973,6 +3135,26 @@ type SubRepoPermissions struct {
 	UserCacheTTLSeconds int `json:"userCacheTTLSeconds,omitempty"`
 }
 
+// SubversionConnection description: Configuration for a connection to a Subversion server. The repositories are converted to Git repositories when they are mirrored.
+type SubversionConnection struct {
+	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
+	Exclude []*ExcludedSubversionRepo `json:"exclude,omitempty"`
+	// Layout description: The layout of the Subversion repositories. With the "standard" layout, trunk/, branches/ and tags/ are converted into the Git branches and tags of the repository, with trunk becoming the default branch. With the "flat" layout, the whole repository is converted into a single default branch.
+	Layout string `json:"layout,omitempty"`
+	// Password description: The password used to authenticate to the Subversion server.
+	Password string `json:"password,omitempty"`
+	// Repos description: A list of Subversion repository paths, relative to `url`, to mirror.
+	Repos []string `json:"repos"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for the repositories. In the pattern, the variable "{base}" is replaced with the host and path of `url`, and "{repo}" is replaced with the repository path taken from the `repos` field.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: URL of the Subversion server that hosts the repositories. The paths in `repos` are resolved relative to this URL.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate to the Subversion server.
+	Username string `json:"username,omitempty"`
+}
+
 // SymbolConfiguration description: Configure symbol generation
 type SymbolConfiguration struct {
 	// Engine description: Manually specify overrides for symbol generation engine per language
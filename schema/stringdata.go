@@ -70,6 +70,18 @@ var RustPackagesSchemaJSON string
 //go:embed ruby-packages.schema.json
 var RubyPackagesSchemaJSON string
 
+//go:embed dotnet-packages.schema.json
+var DotnetPackagesSchemaJSON string
+
+//go:embed php-packages.schema.json
+var PhpPackagesSchemaJSON string
+
+//go:embed elixir-packages.schema.json
+var ElixirPackagesSchemaJSON string
+
+//go:embed dart-packages.schema.json
+var DartPackagesSchemaJSON string
+
 // OtherExternalServiceSchemaJSON is the content of the file "other_external_service.schema.json".
 //
 //go:embed other_external_service.schema.json
@@ -80,6 +92,11 @@ var OtherExternalServiceSchemaJSON string
 //go:embed localgit.schema.json
 var LocalGitExternalServiceSchemaJSON string
 
+// MercurialSchemaJSON is the content of the file "mercurial.schema.json".
+//
+//go:embed mercurial.schema.json
+var MercurialSchemaJSON string
+
 // PerforceSchemaJSON is the content of the file "perforce.schema.json".
 //
 //go:embed perforce.schema.json
@@ -95,6 +112,11 @@ var PhabricatorSchemaJSON string
 //go:embed pagure.schema.json
 var PagureSchemaJSON string
 
+// SubversionSchemaJSON is the content of the file "subversion.schema.json".
+//
+//go:embed subversion.schema.json
+var SubversionSchemaJSON string
+
 // SettingsSchemaJSON is the content of the file "settings.schema.json".
 //
 //go:embed settings.schema.json